@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -57,11 +58,30 @@ var dbResetCmd = &cobra.Command{
 	},
 }
 
+var dbSeedCmd = &cobra.Command{
+	Use:   "seed [service-names...]",
+	Short: "Runs the seed scripts and seed programs for the given services' databases, or all databases if none are given.",
+
+	Run: func(command *cobra.Command, args []string) {
+		appRoot, _ := determineAppRoot()
+		ctx := context.Background()
+		daemon := setupDaemon(ctx)
+		stream, err := daemon.DBSeed(ctx, &daemonpb.DBSeedRequest{
+			AppRoot:  appRoot,
+			Services: args,
+		})
+		if err != nil {
+			fatal("seed databases: ", err)
+		}
+		os.Exit(streamCommandOutput(stream, nil))
+	},
+}
+
 var dbEnv string
 
 var dbShellCmd = &cobra.Command{
 	Use:   "shell SERVICE_NAME [--env=local]",
-	Short: "Connects to the database via psql shell",
+	Short: "Connects to the database via psql (or mysql, sqlite3) shell",
 	Long:  "Defaults to connecting to your local environment. Specify --env to connect to another environment.",
 	Args:  cobra.MaximumNArgs(1),
 
@@ -103,10 +123,16 @@ var dbShellCmd = &cobra.Command{
 			fatalf("could not connect to the database for service %s: %v", dbName, err)
 		}
 
-		// If we have the psql binary, use that.
+		// MySQL and SQLite databases use their own shells.
+		// Otherwise if we have the psql binary, use that.
 		// Otherwise fall back to docker.
 		var cmd *exec.Cmd
-		if p, err := exec.LookPath("psql"); err == nil {
+		if strings.HasPrefix(resp.Dsn, "mysql://") || strings.HasPrefix(resp.Dsn, "file:") {
+			cmd, err = engineShellCmd(resp.Dsn)
+			if err != nil {
+				fatal(err)
+			}
+		} else if p, err := exec.LookPath("psql"); err == nil {
 			cmd = exec.Command(p, resp.Dsn)
 		} else {
 			fmt.Fprintln(os.Stderr, "encore: no 'psql' executable found in $PATH; using docker to run 'psql' instead.\n\nNote: install psql to hide this message.")
@@ -127,11 +153,11 @@ var dbShellCmd = &cobra.Command{
 		cmd.Stdin = os.Stdin
 
 		if err := cmd.Start(); err != nil {
-			log.Fatal().Err(err).Msg("failed to start psql")
+			log.Fatal().Err(err).Msgf("failed to start %s", filepath.Base(cmd.Path))
 		}
 		signal.Ignore(os.Interrupt)
 		if err := cmd.Wait(); err != nil {
-			log.Fatal().Err(err).Msg("psql failed")
+			log.Fatal().Err(err).Msgf("%s failed", filepath.Base(cmd.Path))
 		}
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -222,12 +248,45 @@ var dbConnURICmd = &cobra.Command{
 	},
 }
 
+// engineShellCmd returns the command for opening a shell to a MySQL or SQLite database,
+// given its DSN as reported by the daemon.
+func engineShellCmd(dsn string) (*exec.Cmd, error) {
+	if path, ok := strings.CutPrefix(dsn, "file:"); ok {
+		p, err := exec.LookPath("sqlite3")
+		if err != nil {
+			return nil, fmt.Errorf("no 'sqlite3' executable found in $PATH; install it to open a shell to SQLite databases")
+		}
+		return exec.Command(p, path), nil
+	}
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mysql dsn: %v", err)
+	}
+	p, err := exec.LookPath("mysql")
+	if err != nil {
+		return nil, fmt.Errorf("no 'mysql' executable found in $PATH; install it to open a shell to MySQL databases")
+	}
+	passwd, _ := u.User.Password()
+	args := []string{
+		"--protocol=TCP",
+		"--host=" + u.Hostname(),
+		"--port=" + u.Port(),
+		"--user=" + u.User.Username(),
+		"--password=" + passwd,
+		strings.TrimPrefix(u.Path, "/"),
+	}
+	return exec.Command(p, args...), nil
+}
+
 func init() {
 	rootCmd.AddCommand(dbCmd)
 
 	dbResetCmd.Flags().BoolVar(&resetAll, "all", false, "Reset all services in the application")
 	dbCmd.AddCommand(dbResetCmd)
 
+	dbCmd.AddCommand(dbSeedCmd)
+
 	dbShellCmd.Flags().StringVarP(&dbEnv, "env", "e", "local", "Environment name to connect to (such as \"prod\")")
 	dbCmd.AddCommand(dbShellCmd)
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 
 	"github.com/spf13/cobra"
@@ -36,18 +37,80 @@ func init() {
 
 	dockerEjectCmd.Flags().BoolVarP(&p.Push, "push", "p", false, "push image to remote repository")
 	dockerEjectCmd.Flags().StringVar(&p.BaseImg, "base", "scratch", "base image to build from")
+	dockerEjectCmd.Flags().StringVar(&p.InfraConfig, "infra-config", "", "infrastructure config file to compute the runtime config from (see https://encore.dev/docs/how-to/migrate-away)")
+	dockerEjectCmd.Flags().StringVar(&p.RuntimeConfig, "runtime-config", "runtime-config.env", "path to write the runtime config computed from --infra-config to")
+	dockerEjectCmd.Flags().StringArrayVar(&p.ServiceGroups, "services", nil, "comma-separated list of services to build a separate image for (can be repeated; requires --infra-config)")
+	dockerEjectCmd.Flags().BoolVar(&p.SplitServices, "split-services", false, "build a separate image for each service (requires --infra-config)")
+	dockerEjectCmd.Flags().StringVar(&p.Output, "output", "", "write the image to disk instead of the local docker daemon (oci-layout or tarball)")
+	dockerEjectCmd.Flags().StringVar(&p.OutputPath, "output-path", "", "path to write the image to with --output (defaults to image.tar or image-oci)")
+	dockerEjectCmd.Flags().StringSliceVar(&p.Platforms, "platform", nil, "platforms to build the image for, such as linux/amd64,linux/arm64 (multiple platforms require --push or --output=oci-layout)")
+	dockerEjectCmd.Flags().BoolVar(&p.SBOM, "sbom", false, "add a software bill of materials listing the Go modules in the app to the image")
+
+	var k8sOutputDir, composeOutputDir string
+	k8sEjectCmd := &cobra.Command{
+		Use:   "k8s IMAGE_TAG",
+		Short: "k8s generates Kubernetes manifests for deploying your Encore application",
+		Long: `k8s generates Kubernetes manifests for deploying your Encore application,
+together with the PostgreSQL, Redis and NSQ servers it needs.
+
+IMAGE_TAG is the docker image of the application, as built by 'encore eject docker'.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			appRoot, _ := determineAppRoot()
+			outputDir := absPath(k8sOutputDir)
+			manifestEject(appRoot, outputDir, &daemonpb.ExportRequest{
+				Format: &daemonpb.ExportRequest_Kubernetes{
+					Kubernetes: &daemonpb.ManifestExportParams{ImageTag: args[0], OutputDir: outputDir},
+				},
+			})
+		},
+	}
+	k8sEjectCmd.Flags().StringVarP(&k8sOutputDir, "output", "o", "k8s", "directory to write the manifests to")
+
+	composeEjectCmd := &cobra.Command{
+		Use:   "compose IMAGE_TAG",
+		Short: "compose generates a Docker Compose file for deploying your Encore application",
+		Long: `compose generates a Docker Compose file for deploying your Encore application,
+together with the PostgreSQL, Redis and NSQ servers it needs.
+
+IMAGE_TAG is the docker image of the application, as built by 'encore eject docker'.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			appRoot, _ := determineAppRoot()
+			outputDir := absPath(composeOutputDir)
+			manifestEject(appRoot, outputDir, &daemonpb.ExportRequest{
+				Format: &daemonpb.ExportRequest_Compose{
+					Compose: &daemonpb.ManifestExportParams{ImageTag: args[0], OutputDir: outputDir},
+				},
+			})
+		},
+	}
+	composeEjectCmd.Flags().StringVarP(&composeOutputDir, "output", "o", "compose", "directory to write the compose file to")
+
 	rootCmd.AddCommand(ejectCmd)
 	ejectCmd.AddCommand(dockerEjectCmd)
+	ejectCmd.AddCommand(k8sEjectCmd)
+	ejectCmd.AddCommand(composeEjectCmd)
 }
 
 type ejectParams struct {
-	AppRoot    string
-	ImageTag   string
-	Push       bool
-	BaseImg    string
-	Goos       string
-	Goarch     string
-	CgoEnabled bool
+	AppRoot       string
+	ImageTag      string
+	Push          bool
+	BaseImg       string
+	InfraConfig   string
+	RuntimeConfig string
+	Goos          string
+	Goarch        string
+	CgoEnabled    bool
+
+	ServiceGroups []string
+	SplitServices bool
+
+	Output     string
+	OutputPath string
+	Platforms  []string
+	SBOM       bool
 }
 
 func dockerEject(p ejectParams) {
@@ -62,11 +125,36 @@ func dockerEject(p ejectParams) {
 
 	daemon := setupDaemon(ctx)
 	params := &daemonpb.DockerExportParams{
-		BaseImageTag: p.BaseImg,
+		BaseImageTag:  p.BaseImg,
+		ServiceGroups: p.ServiceGroups,
+		SplitServices: p.SplitServices,
+		Platforms:     p.Platforms,
+		Sbom:          p.SBOM,
 	}
+	if p.InfraConfig != "" {
+		path, err := filepath.Abs(p.InfraConfig)
+		if err != nil {
+			fatal(err)
+		}
+		params.InfraConfigPath = path
+		params.RuntimeConfigPath = absPath(p.RuntimeConfig)
+	}
+	switch p.Output {
+	case "":
+	case "oci-layout":
+		params.OutputFormat = daemonpb.DockerExportParams_OCI_LAYOUT
+		params.OutputPath = absPath(or(p.OutputPath, "image-oci"))
+	case "tarball":
+		params.OutputFormat = daemonpb.DockerExportParams_TARBALL
+		params.OutputPath = absPath(or(p.OutputPath, "image.tar"))
+	default:
+		fatalf("unknown output format %q: must be oci-layout or tarball", p.Output)
+	}
+	params.OutputTag = p.ImageTag
+
 	if p.Push {
 		params.PushDestinationTag = p.ImageTag
-	} else {
+	} else if p.Output == "" {
 		params.LocalDaemonTag = p.ImageTag
 	}
 
@@ -87,6 +175,33 @@ func dockerEject(p ejectParams) {
 	if code := streamCommandOutput(stream, convertJSONLogs()); code != 0 {
 		os.Exit(code)
 	}
+	if len(p.ServiceGroups) > 0 || p.SplitServices {
+		fmt.Printf(`
+Successfully ejected Encore application as one image per service group.
+Each image is tagged with the service group name added to the image name,
+and calls services in other images over HTTP at http://<service group name>:8080,
+unless configured otherwise in the infrastructure config.
+The runtime config of each group was written next to %s, with the group name added to the file name.
+It contains credentials, including the key authenticating calls between services,
+so provide it when running the group's container instead of adding it to the image.
+Provide any secrets not resolved by a secrets provider with the environment variable ENCORE_APP_SECRETS
+as documented here: https://encore.dev/docs/how-to/migrate-away.
+
+`, p.RuntimeConfig)
+		return
+	}
+	if p.InfraConfig != "" {
+		fmt.Printf(`
+Successfully ejected Encore application.
+The runtime config computed from the infrastructure config was written to %s.
+It contains credentials, so provide it when running the container (for example with docker run --env-file)
+instead of adding it to the image.
+Provide any secrets not resolved by a secrets provider with the environment variable ENCORE_APP_SECRETS
+as documented here: https://encore.dev/docs/how-to/migrate-away.
+
+`, p.RuntimeConfig)
+		return
+	}
 	fmt.Print(`
 Successfully ejected Encore application.
 To run the container, specify the environment variables ENCORE_RUNTIME_CONFIG and ENCORE_APP_SECRETS
@@ -96,6 +211,48 @@ as documented here: https://encore.dev/docs/how-to/migrate-away.
 
 }
 
+// manifestEject generates deployment manifests for the app into outputDir,
+// using the format given by req.
+func manifestEject(appRoot, outputDir string, req *daemonpb.ExportRequest) {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+
+	req.AppRoot = appRoot
+	req.Goos = "linux"
+	req.Goarch = runtime.GOARCH
+	req.Environ = os.Environ()
+
+	daemon := setupDaemon(ctx)
+	stream, err := daemon.Export(ctx, req)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "fatal: ", err)
+		os.Exit(1)
+	}
+	if code := streamCommandOutput(stream, convertJSONLogs()); code != 0 {
+		os.Exit(code)
+	}
+	fmt.Printf(`
+Successfully generated deployment files in %s.
+Fill in the values of your application's secrets before deploying,
+as documented here: https://encore.dev/docs/how-to/migrate-away.
+
+`, outputDir)
+}
+
+func absPath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		fatal(err)
+	}
+	return abs
+}
+
 func or(a, b string) string {
 	if a != "" {
 		return a
@@ -21,9 +21,10 @@ func init() {
 	rootCmd.AddCommand(genCmd)
 
 	var (
-		output  string
-		lang    string
-		envName string
+		output     string
+		lang       string
+		envName    string
+		apiVersion uint32
 	)
 
 	genClientCmd := &cobra.Command{
@@ -34,6 +35,9 @@ func init() {
 By default generates the API based on your primary production environment.
 Use '--env=local' to generate it based on your local development version of the app.
 
+By default all versions of versioned APIs are included in the client.
+Use '--api-version=N' to generate a client targeting a specific API version.
+
 Supported language codes are:
   typescript: A TypeScript client using the Fetch API
   javascript: A JavaScript client using the Fetch API
@@ -68,9 +72,10 @@ Supported language codes are:
 
 			daemon := setupDaemon(ctx)
 			resp, err := daemon.GenClient(ctx, &daemonpb.GenClientRequest{
-				AppId:   appID,
-				EnvName: envName,
-				Lang:    lang,
+				AppId:      appID,
+				EnvName:    envName,
+				Lang:       lang,
+				ApiVersion: apiVersion,
 			})
 			if err != nil {
 				fatal(err)
@@ -131,4 +136,6 @@ which may require the user-facing wrapper code to be manually generated.`,
 
 	genClientCmd.Flags().StringVarP(&envName, "env", "e", "", "The environment to fetch the API for (defaults to the primary environment)")
 	_ = genClientCmd.RegisterFlagCompletionFunc("env", cmdutil.AutoCompleteEnvSlug)
+
+	genClientCmd.Flags().Uint32Var(&apiVersion, "api-version", 0, "The API version to generate the client for (defaults to including all versions)")
 }
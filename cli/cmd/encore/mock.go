@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+var (
+	mockPort     int32
+	mockFixtures string
+)
+
+var mockCmd = &cobra.Command{
+	Use:   "mock [--port=4000] [--fixtures=<dir>]",
+	Short: "Serves a mock version of your application's API",
+	Long: `Serves a mock version of your application's API, based on the API schemas.
+
+Incoming requests are validated against the request schemas, and responses
+are generated from the response schemas. To return specific responses, pass
+--fixtures=<dir> with files named <dir>/<service>/<Endpoint>.json.`,
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		appRoot, _ := determineAppRoot()
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			<-interrupt
+			cancel()
+		}()
+
+		fixturesDir := mockFixtures
+		if fixturesDir != "" {
+			abs, err := filepath.Abs(fixturesDir)
+			if err != nil {
+				fatal(err)
+			}
+			fixturesDir = abs
+		}
+
+		daemon := setupDaemon(ctx)
+		stream, err := daemon.Mock(ctx, &daemonpb.MockRequest{
+			AppRoot:     appRoot,
+			Port:        mockPort,
+			FixturesDir: fixturesDir,
+		})
+		if err != nil {
+			fatal(err)
+		}
+		os.Exit(streamCommandOutput(stream, convertJSONLogs()))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(mockCmd)
+	mockCmd.Flags().Int32VarP(&mockPort, "port", "p", 4000, "Port to listen on")
+	mockCmd.Flags().StringVar(&mockFixtures, "fixtures", "", "Directory containing example responses, as <service>/<Endpoint>.json")
+}
@@ -37,13 +37,18 @@ func doArchiveOrUnarchive(groupID string, archive bool) {
 		cmdutil.Fatal("the id must begin with 'secgrp_'. Valid ids can be found with 'encore secret list <key>'.")
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+	var err error
+	if useLocal {
+		err = archiveLocalSecretGroup(groupID, archive)
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
 
-	err := platform.UpdateSecretGroup(ctx, platform.UpdateSecretGroupParams{
-		ID:       groupID,
-		Archived: &archive,
-	})
+		err = platform.UpdateSecretGroup(ctx, platform.UpdateSecretGroupParams{
+			ID:       groupID,
+			Archived: &archive,
+		})
+	}
 	if err != nil {
 		cmdutil.Fatal(err)
 	}
@@ -57,4 +62,7 @@ func doArchiveOrUnarchive(groupID string, archive bool) {
 func init() {
 	secretCmd.AddCommand(archiveSecretCmd)
 	secretCmd.AddCommand(unarchiveSecretCmd)
+	for _, cmd := range []*cobra.Command{archiveSecretCmd, unarchiveSecretCmd} {
+		cmd.Flags().BoolVar(&useLocal, "local", false, "To use the local secret store instead of the Encore platform")
+	}
 }
@@ -0,0 +1,168 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"encr.dev/cli/cmd/encore/cmdutil"
+	"encr.dev/cli/internal/platform"
+	"encr.dev/cli/internal/platform/gql"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+var diffEnv struct {
+	envType string
+	envName string
+}
+
+var diffSecretCmd = &cobra.Command{
+	Use:   "diff --type <type>|--env <name>",
+	Short: "Reports secrets used by the application that are not set for an environment",
+	Long: `Reports secrets used by the application that are not set for an environment,
+as well as secrets set for the environment that the application no longer uses.
+
+The command exits with a non-zero status if any secrets are missing.`,
+	Example: `
+Checking the production environment type in the local secret store:
+
+	$ encore secret diff --local --type=prod
+
+Checking a self-hosted environment named staging, which uses
+the values set for its name or for the production environment type:
+
+	$ encore secret diff --local --type=prod --env=staging`,
+	DisableFlagsInUseLine: true,
+	Args:                  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		diffSecrets()
+	},
+}
+
+func init() {
+	secretCmd.AddCommand(diffSecretCmd)
+	diffSecretCmd.Flags().StringVarP(&diffEnv.envType, "type", "t", "", "The environment type to check")
+	diffSecretCmd.Flags().StringVarP(&diffEnv.envName, "env", "e", "", "The environment name to check")
+	diffSecretCmd.Flags().BoolVar(&useLocal, "local", false, "To check the local secret store instead of the Encore platform")
+}
+
+func diffSecrets() {
+	envType, envName := diffEnv.envType, diffEnv.envName
+	if envType == "" && envName == "" {
+		cmdutil.Fatal("must specify an environment with --type and/or --env")
+	} else if envType != "" {
+		t, ok := validTypes[envType]
+		if !ok {
+			cmdutil.Fatalf("invalid environment type %q", envType)
+		}
+		envType = t
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	appRoot, _ := cmdutil.AppRoot()
+	daemon := cmdutil.ConnectDaemon(ctx)
+	resp, err := daemon.SecretsList(ctx, &daemonpb.SecretsListRequest{AppRoot: appRoot})
+	if err != nil {
+		cmdutil.Fatalf("unable to list the secrets used by the application: %v", err)
+	}
+
+	// Determine the secrets set for the environment.
+	// Specific environments are matched by id, which is the name for the local store.
+	var secrets []*gql.Secret
+	envID := envName
+	if useLocal {
+		secrets = listLocalSecrets(nil)
+	} else {
+		appSlug := cmdutil.AppSlug()
+		if envName != "" {
+			envs, err := platform.ListEnvs(ctx, appSlug)
+			if err != nil {
+				cmdutil.Fatalf("unable to list environments: %v", err)
+			}
+			envID = ""
+			for _, env := range envs {
+				if env.Slug == envName {
+					envID = env.ID
+					if envType == "" {
+						envType = validTypes[env.Type]
+					}
+				}
+			}
+			if envID == "" {
+				cmdutil.Fatalf("environment %q not found", envName)
+			}
+		}
+		secrets, err = platform.ListSecretGroups(ctx, appSlug, nil)
+		if err != nil {
+			cmdutil.Fatalf("unable to list secrets: %v", err)
+		}
+	}
+
+	set := make(map[string]bool)
+	for _, s := range secrets {
+		if isSetForEnv(s.Groups, envType, envID) {
+			set[s.Key] = true
+		}
+	}
+
+	used := make(map[string]bool)
+	var missing, unused []string
+	for _, key := range resp.Keys {
+		used[key] = true
+		if !set[key] {
+			missing = append(missing, key)
+		}
+	}
+	for _, s := range secrets {
+		if set[s.Key] && !used[s.Key] {
+			unused = append(unused, s.Key)
+		}
+	}
+
+	desc := envName
+	if desc == "" {
+		desc = envType + " environments"
+	}
+	if len(unused) > 0 {
+		fmt.Printf("Secrets set for %s that are not used by the application:\n", desc)
+		for _, key := range unused {
+			fmt.Printf("\t%s\n", key)
+		}
+	}
+	if len(missing) > 0 {
+		fmt.Printf("Secrets used by the application that are not set for %s:\n", desc)
+		for _, key := range missing {
+			fmt.Printf("\t%s\n", key)
+		}
+		os.Exit(1)
+	}
+	fmt.Printf("All secrets used by the application are set for %s.\n", desc)
+}
+
+// isSetForEnv reports whether any of the active groups provides
+// a value for the environment with the given type and id.
+func isSetForEnv(groups []*gql.SecretGroup, envType, envID string) bool {
+	for _, g := range groups {
+		if g.ArchivedAt != nil {
+			continue
+		}
+		for _, sel := range g.Selector {
+			switch sel := sel.(type) {
+			case *gql.SecretSelectorEnvType:
+				if envType != "" && sel.Kind == envType {
+					return true
+				}
+			case *gql.SecretSelectorSpecificEnv:
+				if envID != "" && sel.Env.ID == envID {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -23,17 +23,24 @@ var listSecretCmd = &cobra.Command{
 	Short:                 "Lists secrets, optionally for a specific key",
 	DisableFlagsInUseLine: true,
 	Run: func(cmd *cobra.Command, args []string) {
-		appSlug := cmdutil.AppSlug()
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-
 		var keys []string
 		if len(args) > 0 {
 			keys = args
 		}
-		secrets, err := platform.ListSecretGroups(ctx, appSlug, keys)
-		if err != nil {
-			cmdutil.Fatal(err)
+
+		var secrets []*gql.Secret
+		if useLocal {
+			secrets = listLocalSecrets(keys)
+		} else {
+			appSlug := cmdutil.AppSlug()
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			var err error
+			secrets, err = platform.ListSecretGroups(ctx, appSlug, keys)
+			if err != nil {
+				cmdutil.Fatal(err)
+			}
 		}
 
 		if keys == nil {
@@ -127,6 +134,7 @@ var listSecretCmd = &cobra.Command{
 
 func init() {
 	secretCmd.AddCommand(listSecretCmd)
+	listSecretCmd.Flags().BoolVar(&useLocal, "local", false, "To list the secrets in the local secret store instead of on the Encore platform")
 }
 
 type secretEnvDesc struct {
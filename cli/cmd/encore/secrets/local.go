@@ -0,0 +1,147 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+
+	"encr.dev/cli/cmd/encore/cmdutil"
+	"encr.dev/cli/internal/localsecret"
+	"encr.dev/cli/internal/platform/gql"
+)
+
+// useLocal is whether to use the app's local secret store
+// instead of the Encore platform.
+var useLocal bool
+
+var keySecretCmd = &cobra.Command{
+	Use:   "key",
+	Short: "Prints your public key for the local secret store",
+	Long: `Prints your public key for the local secret store, generating a new key pair if you don't have one.
+
+Share the public key with someone who has access to the local secret store,
+so they can give you access with 'encore secret grant <public-key>'.`,
+	DisableFlagsInUseLine: true,
+	Args:                  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		id, created, err := localsecret.LoadOrCreateIdentity()
+		if err != nil {
+			cmdutil.Fatal(err)
+		} else if created {
+			fmt.Fprintln(os.Stderr, "Generated a new secret key.")
+		}
+		fmt.Println(id.Recipient())
+	},
+}
+
+var grantSecretCmd = &cobra.Command{
+	Use:                   "grant <public-key>",
+	Short:                 "Gives the owner of a public key access to the local secret store",
+	DisableFlagsInUseLine: true,
+	Args:                  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		r, err := localsecret.ParseRecipient(args[0])
+		if err != nil {
+			cmdutil.Fatal(err)
+		}
+		store, id := openLocalStore()
+		if err := store.AddRecipient(id, r); err != nil {
+			cmdutil.Fatal(err)
+		} else if err := store.Save(); err != nil {
+			cmdutil.Fatal(err)
+		}
+		fmt.Printf("Successfully granted %s access to the local secret store.\n", r)
+	},
+}
+
+func init() {
+	secretCmd.AddCommand(keySecretCmd)
+	secretCmd.AddCommand(grantSecretCmd)
+}
+
+// openLocalStore opens the app's local secret store
+// and loads the user's identity, creating one if necessary.
+func openLocalStore() (*localsecret.Store, *localsecret.Identity) {
+	appRoot, _ := cmdutil.AppRoot()
+	store, err := localsecret.Open(appRoot)
+	if err != nil {
+		cmdutil.Fatalf("unable to open local secret store: %v", err)
+	}
+	id, created, err := localsecret.LoadOrCreateIdentity()
+	if err != nil {
+		cmdutil.Fatal(err)
+	} else if created {
+		fmt.Fprintf(os.Stderr, "Generated a new secret key with the public key %s.\n", id.Recipient())
+	}
+	return store, id
+}
+
+func setLocalSecret(key, plaintextValue string) {
+	sel := secretEnvs.LocalSelector()
+	store, id := openLocalStore()
+
+	_, created, err := store.Set(id, key, plaintextValue, sel)
+	if err != nil {
+		var ce *localsecret.ConflictError
+		if errors.As(err, &ce) {
+			cmdutil.Fatal(ce.Error())
+		}
+		cmdutil.Fatalf("unable to set secret: %v", err)
+	} else if err := store.Save(); err != nil {
+		cmdutil.Fatalf("unable to save local secret store: %v", err)
+	}
+
+	if created {
+		fmt.Printf("Successfully created local secret value for %s.\n", key)
+	} else {
+		fmt.Printf("Successfully updated local secret value for %s.\n", key)
+	}
+}
+
+// listLocalSecrets lists the secrets in the app's local secret store,
+// optionally filtered to the given keys. The secrets are converted to
+// the platform's representation, with specific environments referenced by name.
+func listLocalSecrets(keys []string) []*gql.Secret {
+	appRoot, _ := cmdutil.AppRoot()
+	store, err := localsecret.Open(appRoot)
+	if err != nil {
+		cmdutil.Fatalf("unable to open local secret store: %v", err)
+	}
+
+	var secrets []*gql.Secret
+	for _, s := range store.Secrets() {
+		if keys != nil && !slices.Contains(keys, s.Key) {
+			continue
+		}
+		secret := &gql.Secret{Key: s.Key}
+		for _, g := range s.Groups {
+			group := &gql.SecretGroup{ID: g.ID, Key: s.Key, ArchivedAt: g.ArchivedAt}
+			for _, sel := range g.Selector {
+				if name, ok := strings.CutPrefix(sel, "env:"); ok {
+					group.Selector = append(group.Selector, &gql.SecretSelectorSpecificEnv{Env: &gql.Env{ID: name, Name: name}})
+				} else {
+					group.Selector = append(group.Selector, &gql.SecretSelectorEnvType{Kind: strings.TrimPrefix(sel, "type:")})
+				}
+			}
+			secret.Groups = append(secret.Groups, group)
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets
+}
+
+func archiveLocalSecretGroup(groupID string, archive bool) error {
+	appRoot, _ := cmdutil.AppRoot()
+	store, err := localsecret.Open(appRoot)
+	if err != nil {
+		return err
+	}
+	if err := store.SetArchived(groupID, archive); err != nil {
+		return err
+	}
+	return store.Save()
+}
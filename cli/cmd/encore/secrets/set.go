@@ -38,7 +38,13 @@ Piping a secret from a file:
 	$ encore secret set --dev MySecret < my-secret.txt
 	Successfully created development secret MySecret.
 
-Note that this strips trailing newlines from the secret value.`,
+Note that this strips trailing newlines from the secret value.
+
+Use --local to store the secret in the application's encrypted local
+secret store instead of on the Encore platform. Specific environments
+are then referenced by name, such as --env=staging:
+
+	$ encore secret set --local --env=staging MySecret`,
 	Args:                  cobra.ExactArgs(1),
 	DisableFlagsInUseLine: true,
 	Run: func(cmd *cobra.Command, args []string) {
@@ -61,10 +67,15 @@ func init() {
 	setSecretCmd.Flags().BoolVarP(&secretEnvs.prodFlag, "prod", "p", false, "To set the secret for production use")
 	setSecretCmd.Flags().StringSliceVarP(&secretEnvs.envTypes, "type", "t", nil, "To set the secret for specific environment types")
 	setSecretCmd.Flags().StringSliceVarP(&secretEnvs.envNames, "env", "e", nil, "To set the secret for specific environment names")
+	setSecretCmd.Flags().BoolVar(&useLocal, "local", false, "To use the local secret store instead of the Encore platform")
 }
 
 func setSecret(key string) {
 	plaintextValue := readSecretValue()
+	if useLocal {
+		setLocalSecret(key, plaintextValue)
+		return
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -126,7 +137,22 @@ func setSecret(key string) {
 	fmt.Printf("Successfully created secret value for %s.\n", key)
 }
 
-func (s secretEnvSelector) ParseSelector(ctx context.Context, appSlug string) []gql.SecretSelector {
+// validTypes are the valid environment types for selectors, including aliases.
+var validTypes = map[string]string{
+	// Actual names
+	"development": "development",
+	"production":  "production",
+	"preview":     "preview",
+	"local":       "local",
+
+	// Aliases
+	"dev":       "development",
+	"prod":      "production",
+	"pr":        "preview",
+	"ephemeral": "preview",
+}
+
+func (s secretEnvSelector) validate() {
 	if s.devFlag && s.prodFlag {
 		cmdutil.Fatal("cannot specify both --dev and --prod")
 	} else if s.devFlag && (len(s.envTypes) > 0 || len(s.envNames) > 0) {
@@ -134,6 +160,50 @@ func (s secretEnvSelector) ParseSelector(ctx context.Context, appSlug string) []
 	} else if s.prodFlag && (len(s.envTypes) > 0 || len(s.envNames) > 0) {
 		cmdutil.Fatal("cannot combine --prod with --type/--env")
 	}
+}
+
+// types returns the selected environment types, without duplicates.
+func (s secretEnvSelector) types() []string {
+	if s.devFlag {
+		return []string{"development", "preview", "local"}
+	} else if s.prodFlag {
+		return []string{"production"}
+	}
+
+	var types []string
+	seenTypes := make(map[string]bool)
+	for _, t := range s.envTypes {
+		val, ok := validTypes[t]
+		if !ok {
+			cmdutil.Fatalf("invalid environment type %q", t)
+		}
+		if !seenTypes[val] {
+			seenTypes[val] = true
+			types = append(types, val)
+		}
+	}
+	return types
+}
+
+// LocalSelector returns the selector for the local secret store.
+// Specific environments are referenced by name.
+func (s secretEnvSelector) LocalSelector() []string {
+	s.validate()
+	var sel []string
+	for _, t := range s.types() {
+		sel = append(sel, "type:"+t)
+	}
+	for _, n := range s.envNames {
+		sel = append(sel, "env:"+n)
+	}
+	if len(sel) == 0 {
+		cmdutil.Fatal("must specify at least one environment with --type/--env (or --dev/--prod)")
+	}
+	return sel
+}
+
+func (s secretEnvSelector) ParseSelector(ctx context.Context, appSlug string) []gql.SecretSelector {
+	s.validate()
 
 	// Look up the environments
 	envMap := make(map[string]string) // name -> id
@@ -146,48 +216,15 @@ func (s secretEnvSelector) ParseSelector(ctx context.Context, appSlug string) []
 	}
 
 	var sel []gql.SecretSelector
-	if s.devFlag {
-		sel = append(sel,
-			&gql.SecretSelectorEnvType{Kind: "development"},
-			&gql.SecretSelectorEnvType{Kind: "preview"},
-			&gql.SecretSelectorEnvType{Kind: "local"},
-		)
-	} else if s.prodFlag {
-		sel = append(sel, &gql.SecretSelectorEnvType{Kind: "production"})
-	} else {
-		// Parse env types and env names
-		seenTypes := make(map[string]bool)
-		validTypes := map[string]string{
-			// Actual names
-			"development": "development",
-			"production":  "production",
-			"preview":     "preview",
-			"local":       "local",
-
-			// Aliases
-			"dev":       "development",
-			"prod":      "production",
-			"pr":        "preview",
-			"ephemeral": "preview",
-		}
-
-		for _, t := range s.envTypes {
-			val, ok := validTypes[t]
-			if !ok {
-				cmdutil.Fatalf("invalid environment type %q", t)
-			}
-			if !seenTypes[val] {
-				seenTypes[val] = true
-				sel = append(sel, &gql.SecretSelectorEnvType{Kind: val})
-			}
-		}
-		for _, n := range s.envNames {
-			envID, ok := envMap[n]
-			if !ok {
-				cmdutil.Fatalf("environment %q not found", n)
-			}
-			sel = append(sel, &gql.SecretSelectorSpecificEnv{Env: &gql.Env{ID: envID}})
+	for _, t := range s.types() {
+		sel = append(sel, &gql.SecretSelectorEnvType{Kind: t})
+	}
+	for _, n := range s.envNames {
+		envID, ok := envMap[n]
+		if !ok {
+			cmdutil.Fatalf("environment %q not found", n)
 		}
+		sel = append(sel, &gql.SecretSelectorSpecificEnv{Env: &gql.Env{ID: envID}})
 	}
 
 	if len(sel) == 0 {
@@ -349,6 +349,16 @@ func (i *Instance) GlobalCORS() (appfile.CORS, error) {
 
 }
 
+// Compression returns the API response compression configuration for the app.
+// It reports nil if response compression is not enabled.
+func (i *Instance) Compression() (*appfile.Compression, error) {
+	c, err := appfile.ResponseCompression(i.root)
+	if err != nil || c == nil || !c.Enabled {
+		return nil, err
+	}
+	return c, nil
+}
+
 func (i *Instance) Watch(fn WatchFunc) (WatchSubscriptionID, error) {
 	if err := i.beginWatch(); err != nil {
 		return 0, err
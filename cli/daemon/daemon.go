@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -112,7 +113,9 @@ func (s *Server) GenClient(ctx context.Context, params *daemonpb.GenClientReques
 	}
 
 	lang := clientgen.Lang(params.Lang)
-	code, err := clientgen.Client(lang, params.AppId, md)
+	code, err := clientgen.Client(lang, params.AppId, md, clientgen.GenOptions{
+		APIVersion: int(params.ApiVersion),
+	})
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -128,6 +131,27 @@ func (s *Server) SecretsRefresh(ctx context.Context, req *daemonpb.SecretsRefres
 	return &daemonpb.SecretsRefreshResponse{}, nil
 }
 
+// SecretsList lists the keys of the secrets used by the app.
+func (s *Server) SecretsList(ctx context.Context, req *daemonpb.SecretsListRequest) (*daemonpb.SecretsListResponse, error) {
+	result, err := s.parseApp(req.AppRoot, ".", false)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse app metadata: %v", err)
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, pkg := range result.Meta.Pkgs {
+		for _, key := range pkg.Secrets {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return &daemonpb.SecretsListResponse{Keys: keys}, nil
+}
+
 // Version reports the daemon version.
 func (s *Server) Version(context.Context, *empty.Empty) (*daemonpb.VersionResponse, error) {
 	configHash, err := version.ConfigHash()
@@ -967,6 +967,15 @@ const DBQueryTooltip: FunctionComponent<{
         {renderData([q.query], "max-h-96", "sql")}
       </div>
 
+      {q.node && (
+        <div className="mt-4">
+          <h4 className="text-gray-300 mb-2 font-sans text-xs font-semibold uppercase leading-3 tracking-wider">
+            Database Node
+          </h4>
+          <div className="text-gray-700 text-sm">{q.node}</div>
+        </div>
+      )}
+
       <div className="mt-4">
         <h4 className="text-gray-300 mb-2 font-sans text-xs font-semibold uppercase leading-3 tracking-wider">
           Error
@@ -85,6 +85,7 @@ export interface DBQuery {
   html_query: Base64EncodedBytes | null;
   err: Base64EncodedBytes | null;
   stack: Stack;
+  node?: string;
 }
 
 export interface Goroutine {
@@ -117,6 +117,7 @@ type DBQuery struct {
 	HTMLQuery []byte  `json:"html_query"`
 	Err       []byte  `json:"err"`
 	Stack     Stack   `json:"stack"`
+	Node      string  `json:"node,omitempty"`
 }
 
 type RPCCall struct {
@@ -602,6 +603,7 @@ func (tp *traceParser) parseQuery(q *tracepb.DBQuery, txid uint32) *DBQuery {
 		HTMLQuery: htmlQuery,
 		Err:       nullBytes(q.Err),
 		Stack:     tp.stack(q.Stack),
+		Node:      q.Node,
 	}
 }
 
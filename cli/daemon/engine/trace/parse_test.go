@@ -108,3 +108,39 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_DBQueryNode(t *testing.T) {
+	req := &model.Request{
+		Type:   model.RPCCall,
+		SpanID: model.SpanID{0, 0, 0, 0, 0, 0, 0, 1},
+		Start:  time.Now(),
+		Traced: true,
+		RPCData: &model.RPCData{
+			Desc:       &model.RPCDesc{Service: "service", Endpoint: "endpoint"},
+			HTTPMethod: "GET",
+			Path:       "/path",
+		},
+	}
+
+	log := &trace.Log{}
+	log.BeginRequest(req, 0)
+	log.DBQueryStart(trace.DBQueryStartParams{
+		Query:   "SELECT 1",
+		SpanID:  req.SpanID,
+		QueryID: 1,
+		Node:    "replica:db-replica:5432",
+	})
+	log.DBQueryEnd(1, nil)
+
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+	reqs, err := Parse(&logger, ID{}, log.GetAndClear(), trace.CurrentVersion, nil)
+	if err != nil {
+		t.Fatalf("failed to parse trace: %v", err)
+	}
+	if len(reqs) != 1 || len(reqs[0].Events) != 1 {
+		t.Fatalf("got %d requests, want 1 request with 1 event", len(reqs))
+	}
+	if q := reqs[0].Events[0].GetQuery(); q == nil || q.Node != "replica:db-replica:5432" {
+		t.Errorf("got query %v, want node replica:db-replica:5432", q)
+	}
+}
@@ -653,6 +653,9 @@ func (tp *traceParser) queryStart(ts uint64) error {
 	if tp.version >= 5 {
 		q.Stack = tp.stack(filterDB)
 	}
+	if tp.version >= 13 {
+		q.Node = tp.String()
+	}
 	tp.queryMap[qid] = q
 
 	if txid != 0 {
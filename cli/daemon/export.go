@@ -20,7 +20,13 @@ func (s *Server) Export(req *daemonpb.ExportRequest, stream daemonpb.Daemon_Expo
 	}
 
 	exitCode := 0
-	success, err := export.Docker(stream.Context(), app, req, log)
+	var success bool
+	switch req.Format.(type) {
+	case *daemonpb.ExportRequest_Kubernetes, *daemonpb.ExportRequest_Compose:
+		success, err = export.Manifests(stream.Context(), app, req, log)
+	default:
+		success, err = export.Docker(stream.Context(), app, req, log)
+	}
 	if err != nil {
 		exitCode = 1
 		if list, ok := err.(scanner.ErrorList); ok {
@@ -4,9 +4,15 @@ import (
 	"archive/tar"
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"io"
 	"net/http"
 	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -16,21 +22,33 @@ import (
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/daemon"
 	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
 	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/rs/zerolog"
 
+	"encore.dev/appruntime/exported/config"
 	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/export/infracfg"
 	"encr.dev/pkg/builder"
 	"encr.dev/pkg/builder/builderimpl"
 	"encr.dev/pkg/cueutil"
 	"encr.dev/pkg/vcs"
 	daemonpb "encr.dev/proto/encore/daemon"
+	meta "encr.dev/proto/encore/parser/meta/v1"
 )
 
 const (
 	appExePath = "/encore-app"
+
+	// migrationsDir is where the app's migrations are stored in the image,
+	// for use by the app's migrate command.
+	migrationsDir = "/encore/migrations"
+
+	// ociRefNameAnnotation is the OCI annotation for the tag of an image in an OCI layout.
+	ociRefNameAnnotation = "org.opencontainers.image.ref.name"
 )
 
 // Docker exports the app as a docker image.
@@ -45,14 +63,29 @@ func Docker(ctx context.Context, app *apps.Instance, req *daemonpb.ExportRequest
 		return false, errors.Wrap(err, "get experimental features")
 	}
 
+	platforms, err := imagePlatforms(req, params)
+	if err != nil {
+		return false, err
+	}
+	if req.CgoEnabled {
+		if err := checkCgoPlatforms(platforms, os.Getenv("CC")); err != nil {
+			return false, err
+		}
+	}
+	if params.OutputFormat != daemonpb.DockerExportParams_NONE && params.OutputPath == "" {
+		return false, errors.New("writing the image to disk requires an output path")
+	} else if params.OutputFormat == daemonpb.DockerExportParams_TARBALL && params.OutputTag == "" {
+		return false, errors.New("writing the image as a tarball requires an image tag")
+	}
+
 	vcsRevision := vcs.GetRevision(app.Root())
 	buildInfo := builder.BuildInfo{
 		BuildTags:          []string{"timetzdata"},
 		CgoEnabled:         req.CgoEnabled,
 		StaticLink:         true,
 		Debug:              false,
-		GOOS:               req.Goos,
-		GOARCH:             req.Goarch,
+		GOOS:               platforms[0].OS,
+		GOARCH:             platforms[0].Architecture,
 		KeepOutput:         false,
 		Revision:           vcsRevision.Revision,
 		UncommittedChanges: vcsRevision.Uncommitted,
@@ -70,77 +103,368 @@ func Docker(ctx context.Context, app *apps.Instance, req *daemonpb.ExportRequest
 		return false, err
 	}
 
-	log.Info().Msgf("compiling Encore application for %s/%s", req.Goos, req.Goarch)
-	result, err := bld.Compile(ctx, builder.CompileParams{
-		Build:       buildInfo,
-		App:         app,
-		Parse:       parse,
-		OpTracker:   nil, // TODO
-		Experiments: expSet,
-		WorkingDir:  ".",
-		CueMeta: &cueutil.Meta{
-			// Dummy data to satisfy config validation.
-			APIBaseURL: "http://localhost:0",
-			EnvName:    "encore-eject",
-			EnvType:    cueutil.EnvType_Development,
-			CloudType:  cueutil.CloudType_Local,
-		},
-	})
+	cueMeta := &cueutil.Meta{
+		// Dummy data to satisfy config validation.
+		APIBaseURL: "http://localhost:0",
+		EnvName:    "encore-eject",
+		EnvType:    cueutil.EnvType_Development,
+		CloudType:  cueutil.CloudType_Local,
+	}
 
-	if result != nil && result.Dir != "" {
-		defer os.RemoveAll(result.Dir)
+	splitImages := len(params.ServiceGroups) > 0 || params.SplitServices
+	if splitImages && params.InfraConfigPath == "" {
+		return false, errors.New("building one image per service group requires an infra config")
 	}
-	if err != nil {
-		log.Info().Err(err).Msg("compilation failed")
-		return false, errors.Wrap(err, "compilation failed")
+
+	// If we have an infra config, validate it against the app
+	// and compute the runtime config to provide to the images when deploying them.
+	// imageGroups are the service groups to build images for,
+	// where "" is an image hosting the whole application.
+	imageGroups := []string{""}
+	if path := params.InfraConfigPath; path != "" {
+		if params.RuntimeConfigPath == "" {
+			return false, errors.New("an infra config requires a path to write the runtime config to")
+		}
+		infraCfg, err := infracfg.Load(path)
+		if err != nil {
+			return false, err
+		}
+		if err := infraCfg.Validate(parse.Meta); err != nil {
+			return false, err
+		}
+		if missing := infraCfg.UnresolvedSecrets(parse.Meta); len(missing) > 0 {
+			log.Warn().Strs("secrets", missing).Msg("no secrets providers configured; secrets must be provided using ENCORE_APP_SECRETS")
+		}
+
+		var groups []*infracfg.ServiceGroup
+		if splitImages {
+			groups, err = infracfg.ServiceGroups(parse.Meta, params.ServiceGroups, params.SplitServices)
+			if err != nil {
+				return false, err
+			}
+			// The services authenticate calls to each other using a shared key.
+			if err := infraCfg.GenerateServiceAuthKey(); err != nil {
+				return false, err
+			}
+		}
+
+		rtCfg, err := infraCfg.RuntimeConfig(parse.Meta, app.PlatformID())
+		if err != nil {
+			return false, err
+		}
+
+		if splitImages {
+			// Each service group gets its own runtime config, including the
+			// service auth key, for deploying alongside the group's image.
+			imageGroups = nil
+			for _, g := range groups {
+				path := groupPath(params.RuntimeConfigPath, g.Name)
+				if err := writeRuntimeConfig(path, infraCfg.ServiceGroupConfig(rtCfg, groups, g)); err != nil {
+					return false, err
+				}
+				imageGroups = append(imageGroups, g.Name)
+				log.Info().Strs("services", g.Services).Msgf("building image for service group %s, with runtime config %s", g.Name, path)
+			}
+		} else {
+			if err := writeRuntimeConfig(params.RuntimeConfigPath, rtCfg); err != nil {
+				return false, err
+			}
+			log.Info().Msgf("wrote runtime config to %s", params.RuntimeConfigPath)
+		}
+		cueMeta = infraCfg.CueMeta()
 	}
 
-	img, err := buildDockerImage(ctx, log, req, result)
+	// Compile the application and build the image filesystem once per platform,
+	// and reuse them for the images of all service groups.
+	builds := make([]*platformBuild, len(platforms))
+	for i, platform := range platforms {
+		buildInfo.GOOS, buildInfo.GOARCH = platform.OS, platform.Architecture
+		log.Info().Msgf("compiling Encore application for %s", platform)
+		result, err := bld.Compile(ctx, builder.CompileParams{
+			Build:       buildInfo,
+			App:         app,
+			Parse:       parse,
+			OpTracker:   nil, // TODO
+			Experiments: expSet,
+			WorkingDir:  ".",
+			CueMeta:     cueMeta,
+		})
+
+		if result != nil && result.Dir != "" {
+			defer os.RemoveAll(result.Dir)
+		}
+		if err != nil {
+			log.Info().Err(err).Msg("compilation failed")
+			return false, errors.Wrap(err, "compilation failed")
+		}
+
+		baseImg, err := resolveBaseImage(ctx, log, params, platform)
+		if err != nil {
+			return false, errors.Wrap(err, "resolve base image")
+		}
+		layer, err := buildImageLayer(ctx, log, params, app.Root(), parse.Meta, result)
+		if err != nil {
+			return false, errors.Wrap(err, "build image layer")
+		}
+		builds[i] = &platformBuild{platform: platform, baseImg: baseImg, layer: layer}
+	}
+
+	imgs := make([]v1.Image, len(builds))
+	for i, b := range builds {
+		imgs[i], err = buildDockerImage(log, b)
+		if err != nil {
+			return false, errors.Wrap(err, "build docker image")
+		}
+	}
+
+	// The images of all service groups are identical; the runtime config
+	// selecting the services to host is provided when deploying them.
+	for _, group := range imageGroups {
+		if ok := publishDockerImage(ctx, log, params, platforms, imgs, group); !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// imagePlatforms returns the platforms to build images for.
+func imagePlatforms(req *daemonpb.ExportRequest, params *daemonpb.DockerExportParams) ([]*v1.Platform, error) {
+	if len(params.Platforms) == 0 {
+		return []*v1.Platform{{OS: req.Goos, Architecture: req.Goarch}}, nil
+	}
+
+	var platforms []*v1.Platform
+	seen := make(map[string]bool)
+	for _, s := range params.Platforms {
+		p, err := v1.ParsePlatform(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid platform %q", s)
+		} else if p.OS == "" || p.Architecture == "" {
+			return nil, errors.Newf("invalid platform %q: must be in the form os/arch[/variant]", s)
+		} else if seen[p.String()] {
+			return nil, errors.Newf("duplicate platform %q", s)
+		}
+		seen[p.String()] = true
+		platforms = append(platforms, p)
+	}
+
+	if len(platforms) > 1 {
+		if params.LocalDaemonTag != "" {
+			return nil, errors.New("multi-platform images can't be saved to the local docker daemon; push them or write them to disk instead")
+		} else if params.OutputFormat == daemonpb.DockerExportParams_TARBALL {
+			return nil, errors.New("tarballs can only contain a single platform; use the OCI layout format instead")
+		}
+	}
+	return platforms, nil
+}
+
+// checkCgoPlatforms reports an error if building with cgo for platforms
+// requires a C cross compiler, when none is configured with cc.
+// Without one, compiling cgo packages (such as the SQLite driver) for
+// another platform fails only after compiling everything else.
+func checkCgoPlatforms(platforms []*v1.Platform, cc string) error {
+	if cc != "" {
+		return nil
+	}
+	for _, p := range platforms {
+		if p.OS != runtime.GOOS || p.Architecture != runtime.GOARCH {
+			return errors.Newf("building with cgo for %s requires a C cross compiler from %s/%s: "+
+				"set CC for the Encore daemon, or build the image on a %s machine", p, runtime.GOOS, runtime.GOARCH, p)
+		}
+	}
+	return nil
+}
+
+// platformBuild is the application built for a single platform.
+type platformBuild struct {
+	platform *v1.Platform
+	baseImg  v1.Image
+
+	// layer is the image layer containing the application.
+	layer v1.Layer
+}
+
+// writeRuntimeConfig writes the runtime config cfg to path, as an env file
+// setting ENCORE_RUNTIME_CONFIG for use with `docker run --env-file` and the like.
+// The runtime config contains credentials, so the file is only readable by its owner.
+func writeRuntimeConfig(path string, cfg *config.Runtime) error {
+	data, err := json.Marshal(cfg)
 	if err != nil {
-		return false, errors.Wrap(err, "build docker image")
+		return errors.Wrap(err, "marshal runtime config")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrap(err, "create runtime config dir")
+	}
+	env := "ENCORE_RUNTIME_CONFIG=" + base64.StdEncoding.EncodeToString(data) + "\n"
+	if err := os.WriteFile(path, []byte(env), 0600); err != nil {
+		return errors.Wrap(err, "write runtime config")
+	}
+	return nil
+}
+
+// publishDockerImage saves the image to the local docker daemon,
+// pushes it to a registry and writes it to disk, as requested by params.
+// imgs are the images built for each of the platforms; if there are more than one
+// they are published as a multi-platform image index.
+// The image of a service group is tagged with the group name
+// added to the repository name.
+// It reports whether the image was published successfully.
+func publishDockerImage(ctx context.Context, log zerolog.Logger, params *daemonpb.DockerExportParams, platforms []*v1.Platform, imgs []v1.Image, group string) bool {
+	img := imgs[0]
+	var index v1.ImageIndex
+	if len(imgs) > 1 {
+		var err error
+		index, err = imageIndex(platforms, imgs)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to build image index")
+			return false
+		}
 	}
 
 	if params.LocalDaemonTag != "" {
-		tag, err := name.NewTag(params.LocalDaemonTag, name.WeakValidation)
+		tag, err := name.NewTag(groupTag(params.LocalDaemonTag, group), name.WeakValidation)
 		if err != nil {
 			log.Error().Err(err).Msg("invalid image tag")
-			return false, nil
+			return false
 		}
-		log.Info().Msg("saving image to local docker daemon")
+		log.Info().Msgf("saving image %s to local docker daemon", tag)
 
 		_, err = daemon.Write(tag, img, daemon.WithUnbufferedOpener())
 		if err != nil {
 			log.Error().Err(err).Msg("unable to save docker image")
-			return false, nil
+			return false
 		}
 		log.Info().Msg("successfully saved local docker image")
 	}
 
 	if params.PushDestinationTag != "" {
-		tag, err := name.NewTag(params.PushDestinationTag, name.WeakValidation)
+		tag, err := name.NewTag(groupTag(params.PushDestinationTag, group), name.WeakValidation)
 		if err != nil {
 			log.Error().Err(err).Msg("invalid image tag")
-			return false, nil
+			return false
 		}
-		log.Info().Msg("pushing image to docker registry")
-		if err := pushDockerImage(ctx, log, img, tag); err != nil {
+		log.Info().Msgf("pushing image %s to docker registry", tag)
+		if err := pushDockerImage(ctx, log, img, index, tag); err != nil {
 			log.Error().Err(err).Msg("unable to push docker image")
-			return false, nil
+			return false
 		}
 	}
 
-	return true, nil
+	if params.OutputFormat != daemonpb.DockerExportParams_NONE {
+		if err := writeDockerImage(log, params, img, index, group); err != nil {
+			log.Error().Err(err).Msg("unable to write docker image")
+			return false
+		}
+	}
+	return true
 }
 
-// buildDockerImage builds a docker image.
-func buildDockerImage(ctx context.Context, log zerolog.Logger, req *daemonpb.ExportRequest, res *builder.CompileResult) (v1.Image, error) {
-	baseImg, err := resolveBaseImage(ctx, log, req.GetDocker())
+// imageIndex combines the images built for each platform into a multi-platform image index.
+func imageIndex(platforms []*v1.Platform, imgs []v1.Image) (v1.ImageIndex, error) {
+	// Use the index media type matching the images' manifests.
+	mt, err := imgs[0].MediaType()
 	if err != nil {
-		return nil, errors.Wrap(err, "resolve base image")
+		return nil, errors.Wrap(err, "get media type")
+	}
+	indexType := types.DockerManifestList
+	if mt == types.OCIManifestSchema1 {
+		indexType = types.OCIImageIndex
+	}
+
+	adds := make([]mutate.IndexAddendum, len(imgs))
+	for i, img := range imgs {
+		adds[i] = mutate.IndexAddendum{
+			Add:        img,
+			Descriptor: v1.Descriptor{Platform: platforms[i]},
+		}
+	}
+	return mutate.AppendManifests(mutate.IndexMediaType(empty.Index, indexType), adds...), nil
+}
+
+// writeDockerImage writes the image, or the image index if it's non-nil,
+// to disk in the format requested by params.
+// The image of a service group is written to a path with the group name added.
+func writeDockerImage(log zerolog.Logger, params *daemonpb.DockerExportParams, img v1.Image, index v1.ImageIndex, group string) error {
+	path := groupPath(params.OutputPath, group)
+	switch params.OutputFormat {
+	case daemonpb.DockerExportParams_OCI_LAYOUT:
+		log.Info().Msgf("writing image to OCI layout %s", path)
+		var opts []layout.Option
+		if params.OutputTag != "" {
+			opts = append(opts, layout.WithAnnotations(map[string]string{
+				ociRefNameAnnotation: groupTag(params.OutputTag, group),
+			}))
+		}
+		lp, err := layout.Write(path, empty.Index)
+		if err != nil {
+			return errors.Wrap(err, "create OCI layout")
+		}
+		if index != nil {
+			err = lp.AppendIndex(index, opts...)
+		} else {
+			err = lp.AppendImage(img, opts...)
+		}
+		if err != nil {
+			return errors.Wrap(err, "write OCI layout")
+		}
+
+	case daemonpb.DockerExportParams_TARBALL:
+		log.Info().Msgf("writing image to tarball %s", path)
+		tag, err := name.NewTag(groupTag(params.OutputTag, group), name.WeakValidation)
+		if err != nil {
+			return errors.Wrap(err, "invalid image tag")
+		}
+		if err := tarball.WriteToFile(path, tag, img); err != nil {
+			return errors.Wrap(err, "write tarball")
+		}
+
+	default:
+		return errors.Newf("unsupported output format %v", params.OutputFormat)
+	}
+
+	log.Info().Msg("successfully wrote docker image")
+	return nil
+}
+
+// groupPath returns the path to write the image of a service group to,
+// by adding the group name to the path before its extension.
+// For example "app.tar" becomes "app-billing.tar".
+func groupPath(path, group string) string {
+	if group == "" {
+		return path
+	}
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "-" + group + ext
+}
+
+// groupTag returns the image tag for the image of a service group,
+// by adding the group name to the repository name of tag.
+// For example "registry/app:v1" becomes "registry/app-billing:v1".
+func groupTag(tag, group string) string {
+	if group == "" {
+		return tag
 	}
+	repo, version := tag, ""
+	if i := strings.LastIndex(tag, ":"); i > strings.LastIndex(tag, "/") {
+		repo, version = tag[:i], tag[i:]
+	}
+	return repo + "-" + group + version
+}
 
-	log.Info().Msg("building docker image")
-	opener, err := buildImageFilesystem(ctx, res)
+// buildImageLayer builds the image layer containing the compiled application.
+func buildImageLayer(ctx context.Context, log zerolog.Logger, params *daemonpb.DockerExportParams, appRoot string, md *meta.Data, res *builder.CompileResult) (v1.Layer, error) {
+	var sbom []byte
+	if params.Sbom {
+		log.Info().Msg("generating software bill of materials")
+		var err error
+		sbom, err = generateSBOM(res.Exe, time.Now())
+		if err != nil {
+			return nil, errors.Wrap(err, "generate sbom")
+		}
+	}
+
+	opener, err := buildImageFilesystem(ctx, appRoot, md, res, sbom)
 	if err != nil {
 		return nil, errors.Wrap(err, "build image fs")
 	}
@@ -156,11 +480,16 @@ func buildDockerImage(ctx context.Context, log zerolog.Logger, req *daemonpb.Exp
 	if err != nil {
 		return nil, errors.Wrap(err, "create tarball layer")
 	}
+	return layer, nil
+}
 
+// buildDockerImage builds a docker image from the application built for a platform.
+func buildDockerImage(log zerolog.Logger, b *platformBuild) (v1.Image, error) {
+	log.Info().Msgf("building docker image for %s", b.platform)
 	created := v1.Time{Time: time.Now()}
 
-	img, err := mutate.Append(baseImg, mutate.Addendum{
-		Layer: layer,
+	img, err := mutate.Append(b.baseImg, mutate.Addendum{
+		Layer: b.layer,
 		History: v1.History{
 			Author:    "encore-app",
 			Created:   created,
@@ -181,8 +510,9 @@ func buildDockerImage(ctx context.Context, log zerolog.Logger, req *daemonpb.Exp
 	cfg.Config.Cmd = nil
 	cfg.Author = "encore.dev"
 	cfg.Created = created
-	cfg.Architecture = req.Goarch
-	cfg.OS = req.Goos
+	cfg.OS = b.platform.OS
+	cfg.Architecture = b.platform.Architecture
+	cfg.Variant = b.platform.Variant
 
 	img, err = mutate.ConfigFile(img, cfg)
 	if err != nil {
@@ -193,7 +523,7 @@ func buildDockerImage(ctx context.Context, log zerolog.Logger, req *daemonpb.Exp
 	return img, nil
 }
 
-func resolveBaseImage(ctx context.Context, log zerolog.Logger, p *daemonpb.DockerExportParams) (v1.Image, error) {
+func resolveBaseImage(ctx context.Context, log zerolog.Logger, p *daemonpb.DockerExportParams, platform *v1.Platform) (v1.Image, error) {
 	baseImgTag := p.BaseImageTag
 	if baseImgTag == "" || baseImgTag == "scratch" {
 		return empty.Image, nil
@@ -207,10 +537,17 @@ func resolveBaseImage(ctx context.Context, log zerolog.Logger, p *daemonpb.Docke
 	}
 
 	img, err := daemon.Image(baseImgRef)
+	if err == nil {
+		// The local image may have been built for another platform.
+		var cfg *v1.ConfigFile
+		if cfg, err = img.ConfigFile(); err == nil && (cfg.OS != platform.OS || cfg.Architecture != platform.Architecture) {
+			err = errors.Newf("local image is built for %s/%s", cfg.OS, cfg.Architecture)
+		}
+	}
 	if err != nil {
-		log.Info().Msg("could not get image from local daemon, fetching it remotely")
+		log.Info().Msgf("could not get image for %s from local daemon, fetching it remotely", platform)
 		keychain := authn.DefaultKeychain
-		img, err = remote.Image(baseImgRef, remote.WithAuthFromKeychain(keychain), remote.WithContext(ctx))
+		img, err = remote.Image(baseImgRef, remote.WithAuthFromKeychain(keychain), remote.WithContext(ctx), remote.WithPlatform(*platform))
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to fetch image")
 		}
@@ -231,7 +568,9 @@ func resolveBaseImage(ctx context.Context, log zerolog.Logger, p *daemonpb.Docke
 	return img, nil
 }
 
-func buildImageFilesystem(ctx context.Context, res *builder.CompileResult) (opener tarball.Opener, err error) {
+// buildImageFilesystem builds the filesystem of the image layer as a tarball.
+// If sbom is non-nil it's added to the image at sbomPath.
+func buildImageFilesystem(ctx context.Context, appRoot string, md *meta.Data, res *builder.CompileResult, sbom []byte) (opener tarball.Opener, err error) {
 	tarFile, err := os.CreateTemp("", "docker-img")
 	if err != nil {
 		return nil, errors.Wrap(err, "mktemp")
@@ -269,6 +608,25 @@ func buildImageFilesystem(ctx context.Context, res *builder.CompileResult) (open
 		}
 	}
 
+	if err := addMigrations(tw, appRoot, md); err != nil {
+		return nil, errors.Wrap(err, "add migrations")
+	}
+
+	if sbom != nil {
+		err := tw.WriteHeader(&tar.Header{
+			Name:     sbomPath,
+			Typeflag: tar.TypeReg,
+			Size:     int64(len(sbom)),
+			Mode:     0444,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "add sbom to tar")
+		}
+		if _, err := tw.Write(sbom); err != nil {
+			return nil, errors.Wrap(err, "write sbom")
+		}
+	}
+
 	// Download ca certs
 	const certsDest = "/etc/ssl/certs/ca-certificates.crt" // from https://go.dev/src/crypto/x509/root_linux.go
 	if err := addCACerts(ctx, tw, certsDest); err != nil {
@@ -285,6 +643,32 @@ func buildImageFilesystem(ctx context.Context, res *builder.CompileResult) (open
 	return opener, nil
 }
 
+// addMigrations adds the database migrations of each service to migrationsDir,
+// in a subdirectory named after the service's database.
+func addMigrations(tw *tar.Writer, appRoot string, md *meta.Data) error {
+	for _, svc := range md.Svcs {
+		for _, m := range svc.Migrations {
+			data, err := os.ReadFile(filepath.Join(appRoot, svc.RelPath, "migrations", m.Filename))
+			if err != nil {
+				return errors.Wrap(err, "read migration")
+			}
+			err = tw.WriteHeader(&tar.Header{
+				Name:     path.Join(migrationsDir, svc.Name, m.Filename),
+				Typeflag: tar.TypeReg,
+				Size:     int64(len(data)),
+				Mode:     0444,
+			})
+			if err != nil {
+				return errors.Wrap(err, "add file to tar")
+			}
+			if _, err := tw.Write(data); err != nil {
+				return errors.Wrap(err, "write migration")
+			}
+		}
+	}
+	return nil
+}
+
 // addCACerts downloads CA Certs from Mozilla's official source.
 func addCACerts(ctx context.Context, tw *tar.Writer, dest string) error {
 	const mozillaRootStoreWebsiteTrustBitEnabledURL = "https://ccadb-public.secure.force.com/mozilla/IncludedRootsPEMTxt?TrustBitsInclude=Websites"
@@ -329,10 +713,16 @@ func addCACerts(ctx context.Context, tw *tar.Writer, dest string) error {
 	return nil
 }
 
-func pushDockerImage(ctx context.Context, log zerolog.Logger, img v1.Image, destination name.Tag) error {
+// pushDockerImage pushes the image, or the image index if it's non-nil, to the registry.
+func pushDockerImage(ctx context.Context, log zerolog.Logger, img v1.Image, index v1.ImageIndex, destination name.Tag) error {
 	log.Info().Msg("pushing docker image to container registry")
 	keychain := authn.DefaultKeychain
-	if err := remote.Write(destination, img, remote.WithAuthFromKeychain(keychain), remote.WithContext(ctx)); err != nil {
+	opts := []remote.Option{remote.WithAuthFromKeychain(keychain), remote.WithContext(ctx)}
+	if index != nil {
+		if err := remote.WriteIndex(destination, index, opts...); err != nil {
+			return errors.WithStack(err)
+		}
+	} else if err := remote.Write(destination, img, opts...); err != nil {
 		return errors.WithStack(err)
 	}
 	log.Info().Msg("successfully pushed docker image")
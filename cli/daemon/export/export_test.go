@@ -0,0 +1,187 @@
+package export
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/rs/zerolog"
+
+	"encore.dev/appruntime/exported/config"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+func TestImagePlatforms(t *testing.T) {
+	c := qt.New(t)
+	req := &daemonpb.ExportRequest{Goos: "linux", Goarch: "amd64"}
+
+	// Without platforms the request's platform is used.
+	platforms, err := imagePlatforms(req, &daemonpb.DockerExportParams{})
+	c.Assert(err, qt.IsNil)
+	c.Assert(platforms, qt.DeepEquals, []*v1.Platform{{OS: "linux", Architecture: "amd64"}})
+
+	platforms, err = imagePlatforms(req, &daemonpb.DockerExportParams{
+		Platforms: []string{"linux/amd64", "linux/arm64/v8"},
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(platforms, qt.DeepEquals, []*v1.Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm64", Variant: "v8"},
+	})
+
+	_, err = imagePlatforms(req, &daemonpb.DockerExportParams{Platforms: []string{"linux"}})
+	c.Assert(err, qt.ErrorMatches, `invalid platform "linux": .*`)
+	_, err = imagePlatforms(req, &daemonpb.DockerExportParams{Platforms: []string{"linux/amd64", "linux/amd64"}})
+	c.Assert(err, qt.ErrorMatches, `duplicate platform "linux/amd64"`)
+
+	// Multi-platform images can't be saved to the docker daemon or as tarballs.
+	multi := []string{"linux/amd64", "linux/arm64"}
+	_, err = imagePlatforms(req, &daemonpb.DockerExportParams{Platforms: multi, LocalDaemonTag: "app:v1"})
+	c.Assert(err, qt.ErrorMatches, `multi-platform images can't be saved to the local docker daemon.*`)
+	_, err = imagePlatforms(req, &daemonpb.DockerExportParams{Platforms: multi, OutputFormat: daemonpb.DockerExportParams_TARBALL})
+	c.Assert(err, qt.ErrorMatches, `tarballs can only contain a single platform.*`)
+}
+
+func TestWriteDockerImage(t *testing.T) {
+	c := qt.New(t)
+	dir := c.TempDir()
+	log := zerolog.Nop()
+
+	amd64, err := random.Image(64, 1)
+	c.Assert(err, qt.IsNil)
+	arm64, err := random.Image(64, 1)
+	c.Assert(err, qt.IsNil)
+	platforms := []*v1.Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64"}}
+
+	// Write a multi-platform image index as an OCI layout.
+	index, err := imageIndex(platforms, []v1.Image{amd64, arm64})
+	c.Assert(err, qt.IsNil)
+	err = writeDockerImage(log, &daemonpb.DockerExportParams{
+		OutputFormat: daemonpb.DockerExportParams_OCI_LAYOUT,
+		OutputPath:   filepath.Join(dir, "oci"),
+		OutputTag:    "registry/app:v1",
+	}, amd64, index, "billing")
+	c.Assert(err, qt.IsNil)
+
+	lp, err := layout.FromPath(filepath.Join(dir, "oci-billing"))
+	c.Assert(err, qt.IsNil)
+	root, err := lp.ImageIndex()
+	c.Assert(err, qt.IsNil)
+	rootManifest, err := root.IndexManifest()
+	c.Assert(err, qt.IsNil)
+	c.Assert(rootManifest.Manifests, qt.HasLen, 1)
+	c.Assert(rootManifest.Manifests[0].Annotations[ociRefNameAnnotation], qt.Equals, "registry/app-billing:v1")
+
+	written, err := root.ImageIndex(rootManifest.Manifests[0].Digest)
+	c.Assert(err, qt.IsNil)
+	writtenManifest, err := written.IndexManifest()
+	c.Assert(err, qt.IsNil)
+	c.Assert(writtenManifest.Manifests, qt.HasLen, 2)
+	c.Assert(writtenManifest.Manifests[1].Platform, qt.DeepEquals, platforms[1])
+
+	// Write a single image as a tarball.
+	path := filepath.Join(dir, "app.tar")
+	err = writeDockerImage(log, &daemonpb.DockerExportParams{
+		OutputFormat: daemonpb.DockerExportParams_TARBALL,
+		OutputPath:   path,
+		OutputTag:    "registry/app:v1",
+	}, amd64, nil, "")
+	c.Assert(err, qt.IsNil)
+	img, err := tarball.ImageFromPath(path, nil)
+	c.Assert(err, qt.IsNil)
+	got, err := img.Digest()
+	c.Assert(err, qt.IsNil)
+	want, err := amd64.Digest()
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.Equals, want)
+}
+
+func TestCheckCgoPlatforms(t *testing.T) {
+	c := qt.New(t)
+	host := &v1.Platform{OS: runtime.GOOS, Architecture: runtime.GOARCH}
+	other := &v1.Platform{OS: "linux", Architecture: "riscv64"}
+	if runtime.GOARCH == other.Architecture {
+		other.Architecture = "s390x"
+	}
+
+	c.Assert(checkCgoPlatforms([]*v1.Platform{host}, ""), qt.IsNil)
+	c.Assert(checkCgoPlatforms([]*v1.Platform{host, other}, ""), qt.ErrorMatches, `building with cgo for linux/\w+ requires a C cross compiler .*`)
+	c.Assert(checkCgoPlatforms([]*v1.Platform{host, other}, "aarch64-linux-gnu-gcc"), qt.IsNil)
+}
+
+func TestWriteRuntimeConfig(t *testing.T) {
+	c := qt.New(t)
+	path := filepath.Join(c.TempDir(), "config", "runtime-config.env")
+	cfg := &config.Runtime{AppID: "app", EnvName: "production"}
+	c.Assert(writeRuntimeConfig(path, cfg), qt.IsNil)
+
+	fi, err := os.Stat(path)
+	c.Assert(err, qt.IsNil)
+	c.Assert(fi.Mode().Perm(), qt.Equals, os.FileMode(0600))
+
+	data, err := os.ReadFile(path)
+	c.Assert(err, qt.IsNil)
+	value, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "ENCORE_RUNTIME_CONFIG=")
+	c.Assert(ok, qt.IsTrue)
+	got := config.ParseRuntime(value, "")
+	c.Assert(got.AppID, qt.Equals, "app")
+	c.Assert(got.EnvName, qt.Equals, "production")
+}
+
+func TestGroupPath(t *testing.T) {
+	c := qt.New(t)
+	c.Assert(groupPath("out/app.tar", ""), qt.Equals, "out/app.tar")
+	c.Assert(groupPath("out/app.tar", "billing"), qt.Equals, "out/app-billing.tar")
+	c.Assert(groupPath("out/oci", "billing"), qt.Equals, "out/oci-billing")
+}
+
+func TestBuildSBOM(t *testing.T) {
+	c := qt.New(t)
+	info := &debug.BuildInfo{
+		Path: "encore.app",
+		Main: debug.Module{Path: "encore.app", Version: "(devel)"},
+		Deps: []*debug.Module{
+			{Path: "encore.dev", Version: "v1.13.4"},
+			{Path: "github.com/jackc/pgx/v5", Version: "v5.2.0", Replace: &debug.Module{Path: "github.com/example/pgx/v5", Version: "v5.2.1"}},
+		},
+	}
+	data, err := buildSBOM(info, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+	c.Assert(err, qt.IsNil)
+
+	var doc spdxDocument
+	c.Assert(json.Unmarshal(data, &doc), qt.IsNil)
+	c.Assert(doc.SPDXVersion, qt.Equals, "SPDX-2.3")
+	c.Assert(doc.Name, qt.Equals, "encore.app")
+	c.Assert(doc.CreationInfo.Created, qt.Equals, "2023-01-02T03:04:05Z")
+
+	// The app itself has no purl since it's a local module.
+	c.Assert(doc.Packages, qt.HasLen, 3)
+	c.Assert(doc.Packages[0].Name, qt.Equals, "encore.app")
+	c.Assert(doc.Packages[0].ExternalRefs, qt.HasLen, 0)
+
+	// Replaced modules are listed as their replacement.
+	var locators []string
+	for _, pkg := range doc.Packages[1:] {
+		locators = append(locators, pkg.ExternalRefs[0].ReferenceLocator)
+	}
+	c.Assert(locators, qt.DeepEquals, []string{
+		"pkg:golang/encore.dev@v1.13.4",
+		"pkg:golang/github.com/example/pgx/v5@v5.2.1",
+	})
+	c.Assert(doc.Relationships, qt.HasLen, 3)
+	c.Assert(doc.Relationships[1], qt.DeepEquals, spdxRelationship{
+		ElementID:        "SPDXRef-Package-main",
+		RelationshipType: "DEPENDS_ON",
+		RelatedElement:   "SPDXRef-Package-0",
+	})
+}
@@ -0,0 +1,654 @@
+// Package infracfg implements the infrastructure config file used when
+// ejecting an Encore application to run on self-hosted infrastructure.
+//
+// The infrastructure config describes where each database, pubsub topic,
+// cache cluster and secret used by the application lives, and is translated
+// into the runtime config the application reads on startup.
+package infracfg
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"encore.dev/appruntime/exported/config"
+	"encr.dev/pkg/cueutil"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// Config is the infrastructure config for a self-hosted application.
+type Config struct {
+	// EnvName is the name of the environment. It defaults to "self-hosted".
+	EnvName string `json:"env_name,omitempty"`
+
+	// EnvType is the type of environment; one of "production", "development",
+	// "ephemeral" or "test". It defaults to "production".
+	EnvType string `json:"env_type,omitempty"`
+
+	// Cloud is the cloud the application runs in; one of "aws", "gcp", "azure" or "local".
+	// It defaults to "local".
+	Cloud string `json:"cloud,omitempty"`
+
+	// APIBaseURL is the base URL the application's API is reachable at.
+	APIBaseURL string `json:"api_base_url"`
+
+	SQLServers  []*SQLServer        `json:"sql_servers,omitempty"`
+	PubSub      []*PubSubProvider   `json:"pubsub,omitempty"`
+	Redis       []*RedisServer      `json:"redis,omitempty"`
+	Metrics     *config.Metrics     `json:"metrics,omitempty"`
+	Secrets     *Secrets            `json:"secrets,omitempty"`
+	LiveConfig  *LiveConfig         `json:"live_config,omitempty"`
+	CORS        *config.CORS        `json:"cors,omitempty"`
+	Compression *config.Compression `json:"compression,omitempty"`
+
+	// Services describe how to reach services deployed separately,
+	// keyed by the Encore service name. Only used when building
+	// one image per service group.
+	Services map[string]*Service `json:"services,omitempty"`
+
+	// ServiceAuthKey is the base64-encoded key used to authenticate calls
+	// between services deployed separately. If empty, a key is generated
+	// when building one image per service group.
+	ServiceAuthKey string `json:"service_auth_key,omitempty"`
+}
+
+// SQLServer describes a SQL server and the databases on it.
+type SQLServer struct {
+	// Host is the host to connect to.
+	// Valid formats are "hostname", "hostname:port", and "/path/to/unix.socket".
+	// For SQLite it is the directory containing the database files.
+	Host string `json:"host"`
+
+	// Engine is the database engine of the server: "postgres" (the default), "mysql" or "sqlite".
+	// It must match the engine of the databases on the server.
+	Engine string `json:"engine,omitempty"`
+
+	ServerCACert string `json:"server_ca_cert,omitempty"`
+	ClientCert   string `json:"client_cert,omitempty"`
+	ClientKey    string `json:"client_key,omitempty"`
+
+	// Databases are the databases on this server, keyed by the Encore database name.
+	Databases map[string]*SQLDatabase `json:"databases"`
+
+	// Replicas are the hosts of read replicas of this server, in the same format as Host.
+	// They are connected to using the same certificates and credentials as the server.
+	Replicas []string `json:"replicas,omitempty"`
+
+	// MaxReplicaLag is the maximum replication lag for a replica to be used,
+	// like "30s". It defaults to 10s.
+	MaxReplicaLag string `json:"max_replica_lag,omitempty"`
+}
+
+// SQLDatabase describes a database on a SQL server.
+type SQLDatabase struct {
+	// Name is the name of the database on the server.
+	// It defaults to the Encore database name.
+	Name string `json:"name,omitempty"`
+
+	User     string `json:"user"`
+	Password string `json:"password,omitempty"`
+
+	// PasswordSecret is the name of a secret holding the password,
+	// resolved through the configured secrets providers.
+	PasswordSecret string `json:"password_secret,omitempty"`
+
+	MinConnections int `json:"min_connections,omitempty"`
+	MaxConnections int `json:"max_connections,omitempty"`
+}
+
+// PubSubProvider describes a pubsub provider and the topics it hosts.
+// Exactly one of NSQ, GCP, AWS and Azure must be set.
+type PubSubProvider struct {
+	NSQ   *config.NSQProvider             `json:"nsq,omitempty"`
+	GCP   *config.GCPPubsubProvider       `json:"gcp,omitempty"`
+	AWS   *config.AWSPubsubProvider       `json:"aws,omitempty"`
+	Azure *config.AzureServiceBusProvider `json:"azure,omitempty"`
+
+	// Topics are the topics hosted by this provider, keyed by the Encore topic name.
+	Topics map[string]*PubSubTopic `json:"topics"`
+}
+
+// PubSubTopic describes a topic and its subscriptions.
+type PubSubTopic struct {
+	// Name is the name of the topic as known by the provider.
+	// It defaults to the Encore topic name.
+	Name string `json:"name,omitempty"`
+
+	// ProjectID is the GCP project the topic exists in, for the GCP provider.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Subscriptions are the subscriptions to the topic, keyed by the Encore subscription name.
+	Subscriptions map[string]*PubSubSubscription `json:"subscriptions"`
+}
+
+// PubSubSubscription describes a subscription to a topic.
+type PubSubSubscription struct {
+	// Name is the name of the subscription as known by the provider.
+	// It defaults to the Encore subscription name.
+	Name string `json:"name,omitempty"`
+
+	// PushOnly specifies that messages are delivered over HTTP push
+	// instead of the application pulling them.
+	PushOnly bool `json:"push_only,omitempty"`
+}
+
+// RedisServer describes a Redis server and the cache clusters using it.
+type RedisServer struct {
+	Host         string `json:"host"`
+	User         string `json:"user,omitempty"`
+	Password     string `json:"password,omitempty"`
+	EnableTLS    bool   `json:"enable_tls,omitempty"`
+	ServerCACert string `json:"server_ca_cert,omitempty"`
+	ClientCert   string `json:"client_cert,omitempty"`
+	ClientKey    string `json:"client_key,omitempty"`
+
+	// Databases are the cache clusters using this server, keyed by the Encore cache cluster name.
+	Databases map[string]*RedisDatabase `json:"databases"`
+}
+
+// RedisDatabase describes the Redis database used by a cache cluster.
+type RedisDatabase struct {
+	Database       int    `json:"database,omitempty"`
+	KeyPrefix      string `json:"key_prefix,omitempty"`
+	MinConnections int    `json:"min_connections,omitempty"`
+	MaxConnections int    `json:"max_connections,omitempty"`
+}
+
+// Secrets describes where the application's secrets are stored.
+type Secrets struct {
+	// Providers are the secrets providers to resolve secrets from, in order.
+	Providers []*config.SecretsProvider `json:"providers"`
+
+	// RefreshInterval is how often rotatable secrets are refreshed,
+	// as a duration string such as "5m".
+	RefreshInterval string `json:"refresh_interval,omitempty"`
+}
+
+// LiveConfig describes where to fetch live updates to config values from.
+// Exactly one of Path and URL must be set.
+type LiveConfig struct {
+	// Path is the path to a JSON file containing the config values, keyed by service name.
+	Path string `json:"path,omitempty"`
+
+	// URL is the URL of a config service serving the same JSON document.
+	URL string `json:"url,omitempty"`
+
+	// PollInterval is how often to check for changes,
+	// as a duration string such as "30s".
+	PollInterval string `json:"poll_interval,omitempty"`
+}
+
+// Load reads and parses the infrastructure config file at path.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read infra config")
+	}
+	return Parse(data)
+}
+
+// Parse parses an infrastructure config.
+// Unknown fields are reported as errors to catch typos early.
+func Parse(data []byte) (*Config, error) {
+	var cfg Config
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return nil, errors.Wrap(err, "parse infra config")
+	}
+	cfg.setDefaults()
+	return &cfg, nil
+}
+
+func (c *Config) setDefaults() {
+	if c.EnvName == "" {
+		c.EnvName = "self-hosted"
+	}
+	if c.EnvType == "" {
+		c.EnvType = string(cueutil.EnvType_Production)
+	}
+	if c.Cloud == "" {
+		c.Cloud = string(cueutil.CloudType_Local)
+	}
+}
+
+// CueMeta returns the metadata to use when computing the application's
+// config files for this environment.
+func (c *Config) CueMeta() *cueutil.Meta {
+	return &cueutil.Meta{
+		APIBaseURL: c.APIBaseURL,
+		EnvName:    c.EnvName,
+		EnvType:    cueutil.EnvType(c.EnvType),
+		CloudType:  cueutil.CloudType(c.Cloud),
+	}
+}
+
+// Validate validates the config against the application's metadata.
+// It reports an error if any infrastructure used by the application
+// is not configured, or if the config references infrastructure
+// the application does not use.
+func (c *Config) Validate(md *meta.Data) error {
+	var problems []string
+	addf := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	switch cueutil.EnvType(c.EnvType) {
+	case cueutil.EnvType_Production, cueutil.EnvType_Development, cueutil.EnvType_Ephemeral, cueutil.EnvType_Test:
+	default:
+		addf("invalid env_type %q", c.EnvType)
+	}
+	switch cueutil.CloudType(c.Cloud) {
+	case cueutil.CloudType_AWS, cueutil.CloudType_GCP, cueutil.CloudType_Azure, cueutil.CloudType_Local:
+	default:
+		addf("invalid cloud %q", c.Cloud)
+	}
+	if c.APIBaseURL == "" {
+		addf("api_base_url must be set")
+	}
+
+	// SQL databases
+	appDBs := make(map[string]bool)
+	for _, name := range databases(md) {
+		appDBs[name] = true
+	}
+	dbEngines := make(map[string]string)
+	for _, svc := range md.Svcs {
+		if len(svc.Migrations) > 0 {
+			dbEngines[svc.Name] = strings.ToLower(svc.SqlEngine.String())
+		}
+	}
+	seenDBs := make(map[string]bool)
+	for i, srv := range c.SQLServers {
+		if srv.Host == "" {
+			addf("sql_servers[%d]: host must be set", i)
+		}
+		for j, host := range srv.Replicas {
+			if host == "" {
+				addf("sql_servers[%d]: replicas[%d] must not be empty", i, j)
+			}
+		}
+		if srv.MaxReplicaLag != "" {
+			if _, err := time.ParseDuration(srv.MaxReplicaLag); err != nil {
+				addf("sql_servers[%d]: invalid max_replica_lag %q", i, srv.MaxReplicaLag)
+			}
+		}
+		engine := srv.Engine
+		if engine == "" {
+			engine = "postgres"
+		}
+		switch engine {
+		case "postgres", "mysql", "sqlite":
+		default:
+			addf("sql_servers[%d]: unknown engine %q: must be one of postgres, mysql or sqlite", i, srv.Engine)
+		}
+		if engine != "postgres" && len(srv.Replicas) > 0 {
+			addf("sql_servers[%d]: replicas are only supported for postgres", i)
+		}
+		for _, name := range sortedKeys(srv.Databases) {
+			if seenDBs[name] {
+				addf("sql database %s: configured more than once", name)
+			} else if !appDBs[name] {
+				addf("sql database %s: not used by the application", name)
+			} else if want, ok := dbEngines[name]; ok && want != engine {
+				addf("sql database %s: uses engine %s but is configured on a %s server", name, want, engine)
+			}
+			seenDBs[name] = true
+			if db := srv.Databases[name]; db.Password != "" && db.PasswordSecret != "" {
+				addf("sql database %s: only one of password and password_secret may be set", name)
+			}
+		}
+	}
+	for _, name := range databases(md) {
+		if !seenDBs[name] {
+			addf("sql database %s: not configured", name)
+		}
+	}
+
+	// Pubsub topics
+	appTopics := make(map[string]*meta.PubSubTopic)
+	for _, t := range md.PubsubTopics {
+		appTopics[t.Name] = t
+	}
+	seenTopics := make(map[string]bool)
+	for i, p := range c.PubSub {
+		if n := countSet(p.NSQ != nil, p.GCP != nil, p.AWS != nil, p.Azure != nil); n != 1 {
+			addf("pubsub[%d]: exactly one of nsq, gcp, aws and azure must be set", i)
+		}
+		for _, name := range sortedKeys(p.Topics) {
+			topic := p.Topics[name]
+			appTopic := appTopics[name]
+			if seenTopics[name] {
+				addf("pubsub topic %s: configured more than once", name)
+				continue
+			} else if appTopic == nil {
+				addf("pubsub topic %s: not used by the application", name)
+				continue
+			}
+			seenTopics[name] = true
+
+			appSubs := make(map[string]bool)
+			for _, s := range appTopic.Subscriptions {
+				appSubs[s.Name] = true
+				if _, ok := topic.Subscriptions[s.Name]; !ok {
+					addf("pubsub subscription %s/%s: not configured", name, s.Name)
+				}
+			}
+			for _, sub := range sortedKeys(topic.Subscriptions) {
+				if !appSubs[sub] {
+					addf("pubsub subscription %s/%s: not used by the application", name, sub)
+				}
+			}
+		}
+	}
+	for _, t := range md.PubsubTopics {
+		if !seenTopics[t.Name] {
+			addf("pubsub topic %s: not configured", t.Name)
+		}
+	}
+
+	// Cache clusters
+	appClusters := make(map[string]bool)
+	for _, cl := range md.CacheClusters {
+		appClusters[cl.Name] = true
+	}
+	seenClusters := make(map[string]bool)
+	for i, srv := range c.Redis {
+		if srv.Host == "" {
+			addf("redis[%d]: host must be set", i)
+		}
+		for _, name := range sortedKeys(srv.Databases) {
+			if seenClusters[name] {
+				addf("cache cluster %s: configured more than once", name)
+			} else if !appClusters[name] {
+				addf("cache cluster %s: not used by the application", name)
+			}
+			seenClusters[name] = true
+		}
+	}
+	for _, cl := range md.CacheClusters {
+		if !seenClusters[cl.Name] {
+			addf("cache cluster %s: not configured", cl.Name)
+		}
+	}
+
+	// Services
+	appSvcs := make(map[string]bool)
+	for _, svc := range md.Svcs {
+		appSvcs[svc.Name] = true
+	}
+	for _, name := range sortedKeys(c.Services) {
+		if !appSvcs[name] {
+			addf("service %s: not part of the application", name)
+		}
+	}
+	if c.ServiceAuthKey != "" {
+		if _, err := base64.StdEncoding.DecodeString(c.ServiceAuthKey); err != nil {
+			addf("service_auth_key: invalid base64 encoding")
+		}
+	}
+
+	// Secrets
+	if s := c.Secrets; s != nil && s.RefreshInterval != "" {
+		if _, err := time.ParseDuration(s.RefreshInterval); err != nil {
+			addf("secrets: invalid refresh_interval %q", s.RefreshInterval)
+		}
+	}
+
+	// Live config
+	if lc := c.LiveConfig; lc != nil {
+		if (lc.Path == "") == (lc.URL == "") {
+			addf("live_config: exactly one of path and url must be set")
+		}
+		if lc.PollInterval != "" {
+			if _, err := time.ParseDuration(lc.PollInterval); err != nil {
+				addf("live_config: invalid poll_interval %q", lc.PollInterval)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.Newf("invalid infra config:\n\t%s", strings.Join(problems, "\n\t"))
+	}
+	return nil
+}
+
+// UnresolvedSecrets reports the secrets used by the application
+// that are not resolved by any secrets provider in the config,
+// and therefore must be provided through ENCORE_APP_SECRETS.
+func (c *Config) UnresolvedSecrets(md *meta.Data) []string {
+	if c.Secrets != nil && len(c.Secrets.Providers) > 0 {
+		return nil
+	}
+	return secretKeys(md)
+}
+
+// RuntimeConfig computes the runtime config for the application.
+// The config must have been validated against md.
+func (c *Config) RuntimeConfig(md *meta.Data, appSlug string) (*config.Runtime, error) {
+	rt := &config.Runtime{
+		AppSlug:     appSlug,
+		APIBaseURL:  c.APIBaseURL,
+		EnvName:     c.EnvName,
+		EnvType:     c.EnvType,
+		EnvCloud:    c.Cloud,
+		DeployedAt:  time.Now().UTC(),
+		Metrics:     c.Metrics,
+		CORS:        c.CORS,
+		Compression: c.Compression,
+	}
+
+	for _, srv := range c.SQLServers {
+		serverID := len(rt.SQLServers)
+		rt.SQLServers = append(rt.SQLServers, &config.SQLServer{
+			Host:         srv.Host,
+			Engine:       srv.Engine,
+			ServerCACert: srv.ServerCACert,
+			ClientCert:   srv.ClientCert,
+			ClientKey:    srv.ClientKey,
+		})
+
+		var replicas []*config.SQLReplica
+		for _, host := range srv.Replicas {
+			replicas = append(replicas, &config.SQLReplica{ServerID: len(rt.SQLServers)})
+			rt.SQLServers = append(rt.SQLServers, &config.SQLServer{
+				Host:         host,
+				ServerCACert: srv.ServerCACert,
+				ClientCert:   srv.ClientCert,
+				ClientKey:    srv.ClientKey,
+			})
+		}
+		var maxLag time.Duration
+		if srv.MaxReplicaLag != "" {
+			d, err := time.ParseDuration(srv.MaxReplicaLag)
+			if err != nil {
+				return nil, fmt.Errorf("invalid max_replica_lag %q: %v", srv.MaxReplicaLag, err)
+			}
+			maxLag = d
+		}
+
+		for _, name := range sortedKeys(srv.Databases) {
+			db := srv.Databases[name]
+			rt.SQLDatabases = append(rt.SQLDatabases, &config.SQLDatabase{
+				ServerID:       serverID,
+				EncoreName:     name,
+				DatabaseName:   or(db.Name, name),
+				User:           db.User,
+				Password:       db.Password,
+				PasswordSecret: db.PasswordSecret,
+				MinConnections: db.MinConnections,
+				MaxConnections: db.MaxConnections,
+				Replicas:       replicas,
+				MaxReplicaLag:  maxLag,
+			})
+		}
+	}
+
+	appTopics := make(map[string]*meta.PubSubTopic)
+	for _, t := range md.PubsubTopics {
+		appTopics[t.Name] = t
+	}
+	for _, p := range c.PubSub {
+		providerID := len(rt.PubsubProviders)
+		rt.PubsubProviders = append(rt.PubsubProviders, &config.PubsubProvider{
+			NSQ:   p.NSQ,
+			GCP:   p.GCP,
+			AWS:   p.AWS,
+			Azure: p.Azure,
+		})
+		if rt.PubsubTopics == nil {
+			rt.PubsubTopics = make(map[string]*config.PubsubTopic)
+		}
+		for name, topic := range p.Topics {
+			topicCfg := &config.PubsubTopic{
+				EncoreName:    name,
+				ProviderID:    providerID,
+				ProviderName:  or(topic.Name, name),
+				OrderingKey:   appTopics[name].GetOrderingKey(),
+				Subscriptions: make(map[string]*config.PubsubSubscription),
+			}
+			if p.GCP != nil {
+				topicCfg.GCP = &config.PubsubTopicGCPData{ProjectID: topic.ProjectID}
+			}
+			for subName, sub := range topic.Subscriptions {
+				subCfg := &config.PubsubSubscription{
+					ID:           or(sub.Name, subName),
+					EncoreName:   subName,
+					ProviderName: or(sub.Name, subName),
+					PushOnly:     sub.PushOnly,
+				}
+				if p.GCP != nil {
+					subCfg.GCP = &config.PubsubSubscriptionGCPData{ProjectID: topic.ProjectID}
+				}
+				topicCfg.Subscriptions[subName] = subCfg
+			}
+			rt.PubsubTopics[name] = topicCfg
+		}
+	}
+
+	for _, srv := range c.Redis {
+		serverID := len(rt.RedisServers)
+		rt.RedisServers = append(rt.RedisServers, &config.RedisServer{
+			Host:         srv.Host,
+			User:         srv.User,
+			Password:     srv.Password,
+			EnableTLS:    srv.EnableTLS,
+			ServerCACert: srv.ServerCACert,
+			ClientCert:   srv.ClientCert,
+			ClientKey:    srv.ClientKey,
+		})
+		for _, name := range sortedKeys(srv.Databases) {
+			db := srv.Databases[name]
+			rt.RedisDatabases = append(rt.RedisDatabases, &config.RedisDatabase{
+				ServerID:       serverID,
+				EncoreName:     name,
+				Database:       db.Database,
+				KeyPrefix:      db.KeyPrefix,
+				MinConnections: db.MinConnections,
+				MaxConnections: db.MaxConnections,
+			})
+		}
+	}
+
+	if c.ServiceAuthKey != "" {
+		key, err := base64.StdEncoding.DecodeString(c.ServiceAuthKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "decode service auth key")
+		}
+		rt.AuthKeys = []config.EncoreAuthKey{{KeyID: 1, Data: key}}
+	}
+
+	if s := c.Secrets; s != nil {
+		rt.SecretsProviders = s.Providers
+		if s.RefreshInterval != "" {
+			d, err := time.ParseDuration(s.RefreshInterval)
+			if err != nil {
+				return nil, errors.Wrap(err, "parse secrets refresh interval")
+			}
+			rt.SecretsRefreshInterval = d
+		}
+	}
+
+	if lc := c.LiveConfig; lc != nil {
+		rt.LiveConfig = &config.LiveConfig{Path: lc.Path, URL: lc.URL}
+		if lc.PollInterval != "" {
+			d, err := time.ParseDuration(lc.PollInterval)
+			if err != nil {
+				return nil, errors.Wrap(err, "parse live config poll interval")
+			}
+			rt.LiveConfig.PollInterval = d
+		}
+	}
+
+	return rt, nil
+}
+
+// databases returns the names of the SQL databases used by the application, sorted.
+func databases(md *meta.Data) []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, svc := range md.Svcs {
+		if len(svc.Migrations) > 0 {
+			add(svc.Name)
+		}
+		for _, db := range svc.Databases {
+			add(db)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// secretKeys returns the keys of the secrets used by the application, sorted.
+func secretKeys(md *meta.Data) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, pkg := range md.Pkgs {
+		for _, key := range pkg.Secrets {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func countSet(vals ...bool) (n int) {
+	for _, v := range vals {
+		if v {
+			n++
+		}
+	}
+	return n
+}
+
+func or(a, b string) string {
+	if a != "" {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,172 @@
+package infracfg
+
+import (
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"encore.dev/appruntime/exported/config"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+func testMeta() *meta.Data {
+	return &meta.Data{
+		Svcs: []*meta.Service{
+			{Name: "todo", Migrations: []*meta.DBMigration{{Filename: "1_init.up.sql", Number: 1}}},
+			{Name: "email"},
+		},
+		Pkgs: []*meta.Package{
+			{RelPath: "email", Secrets: []string{"SendGridKey"}},
+		},
+		PubsubTopics: []*meta.PubSubTopic{{
+			Name:          "signups",
+			OrderingKey:   "UserID",
+			Subscriptions: []*meta.PubSubTopic_Subscription{{Name: "send-welcome-email", ServiceName: "email"}},
+		}},
+		CacheClusters: []*meta.CacheCluster{{Name: "sessions"}},
+	}
+}
+
+const validConfig = `{
+	"api_base_url": "https://api.example.com",
+	"sql_servers": [{
+		"host": "db:5432",
+		"databases": {"todo": {"name": "todo_prod", "user": "todo", "password_secret": "TodoDBPassword"}},
+		"replicas": ["db-replica:5432"],
+		"max_replica_lag": "30s"
+	}],
+	"pubsub": [{
+		"nsq": {"host": "nsq:4150"},
+		"topics": {"signups": {"subscriptions": {"send-welcome-email": {}}}}
+	}],
+	"redis": [{
+		"host": "redis:6379",
+		"databases": {"sessions": {"key_prefix": "sessions/"}}
+	}],
+	"secrets": {
+		"providers": [{"env": {"prefix": "SECRET_"}}],
+		"refresh_interval": "1m"
+	},
+	"live_config": {"path": "/etc/app/live.json", "poll_interval": "10s"}
+}`
+
+func TestConfig(t *testing.T) {
+	c := qt.New(t)
+	md := testMeta()
+
+	cfg, err := Parse([]byte(validConfig))
+	c.Assert(err, qt.IsNil)
+	c.Assert(cfg.Validate(md), qt.IsNil)
+	c.Assert(cfg.UnresolvedSecrets(md), qt.HasLen, 0)
+
+	cueMeta := cfg.CueMeta()
+	c.Assert(cueMeta.EnvName, qt.Equals, "self-hosted")
+	c.Assert(string(cueMeta.EnvType), qt.Equals, "production")
+	c.Assert(cueMeta.APIBaseURL, qt.Equals, "https://api.example.com")
+
+	rt, err := cfg.RuntimeConfig(md, "my-app")
+	c.Assert(err, qt.IsNil)
+	c.Assert(rt.EnvName, qt.Equals, "self-hosted")
+	c.Assert(rt.SQLServers, qt.DeepEquals, []*config.SQLServer{{Host: "db:5432"}, {Host: "db-replica:5432"}})
+	c.Assert(rt.SQLDatabases, qt.DeepEquals, []*config.SQLDatabase{{
+		EncoreName:     "todo",
+		DatabaseName:   "todo_prod",
+		User:           "todo",
+		PasswordSecret: "TodoDBPassword",
+		Replicas:       []*config.SQLReplica{{ServerID: 1}},
+		MaxReplicaLag:  30 * time.Second,
+	}})
+	c.Assert(rt.PubsubTopics["signups"], qt.DeepEquals, &config.PubsubTopic{
+		EncoreName:   "signups",
+		ProviderName: "signups",
+		OrderingKey:  "UserID",
+		Subscriptions: map[string]*config.PubsubSubscription{
+			"send-welcome-email": {ID: "send-welcome-email", EncoreName: "send-welcome-email", ProviderName: "send-welcome-email"},
+		},
+	})
+	c.Assert(rt.RedisDatabases, qt.DeepEquals, []*config.RedisDatabase{{EncoreName: "sessions", KeyPrefix: "sessions/"}})
+	c.Assert(rt.SecretsProviders, qt.HasLen, 1)
+	c.Assert(rt.SecretsRefreshInterval.String(), qt.Equals, "1m0s")
+	c.Assert(rt.LiveConfig, qt.DeepEquals, &config.LiveConfig{Path: "/etc/app/live.json", PollInterval: 10 * time.Second})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	c := qt.New(t)
+
+	cfg, err := Parse([]byte(`{
+		"env_type": "staging",
+		"sql_servers": [
+			{"host": "db", "databases": {"other": {"user": "u"}}, "replicas": [""], "max_replica_lag": "a bit"},
+			{"host": "/data", "engine": "sqlite", "databases": {"todo": {}}, "replicas": ["/replica"]},
+			{"host": "db2", "engine": "oracle"}
+		],
+		"pubsub": [{"topics": {"signups": {"subscriptions": {"unknown": {}}}}}],
+		"live_config": {"poll_interval": "often"}
+	}`))
+	c.Assert(err, qt.IsNil)
+	err = cfg.Validate(testMeta())
+	c.Assert(err, qt.ErrorMatches, `invalid infra config:
+	invalid env_type "staging"
+	api_base_url must be set
+	sql_servers\[0\]: replicas\[0\] must not be empty
+	sql_servers\[0\]: invalid max_replica_lag "a bit"
+	sql database other: not used by the application
+	sql_servers\[1\]: replicas are only supported for postgres
+	sql database todo: uses engine postgres but is configured on a sqlite server
+	sql_servers\[2\]: unknown engine "oracle": must be one of postgres, mysql or sqlite
+	pubsub\[0\]: exactly one of nsq, gcp, aws and azure must be set
+	pubsub subscription signups/send-welcome-email: not configured
+	pubsub subscription signups/unknown: not used by the application
+	cache cluster sessions: not configured
+	live_config: exactly one of path and url must be set
+	live_config: invalid poll_interval "often"`)
+	c.Assert(cfg.UnresolvedSecrets(testMeta()), qt.DeepEquals, []string{"SendGridKey"})
+
+	_, err = Parse([]byte(`{"sql_server": []}`))
+	c.Assert(err, qt.ErrorMatches, `parse infra config: json: unknown field "sql_server"`)
+}
+
+func TestServiceGroups(t *testing.T) {
+	c := qt.New(t)
+	md := testMeta()
+	md.Svcs = append(md.Svcs, &meta.Service{Name: "billing"})
+
+	groups, err := ServiceGroups(md, []string{"todo,email", "billing"}, false)
+	c.Assert(err, qt.IsNil)
+	c.Assert(groups, qt.DeepEquals, []*ServiceGroup{
+		{Name: "todo-email", Services: []string{"todo", "email"}},
+		{Name: "billing", Services: []string{"billing"}},
+	})
+
+	groups, err = ServiceGroups(md, nil, true)
+	c.Assert(err, qt.IsNil)
+	c.Assert(groups, qt.HasLen, 3)
+
+	_, err = ServiceGroups(md, []string{"todo,unknown", "todo"}, false)
+	c.Assert(err, qt.ErrorMatches, `invalid service groups:
+	service unknown: not found in the application
+	service todo: part of more than one service group
+	service email: not part of any service group
+	service billing: not part of any service group`)
+
+	cfg, err := Parse([]byte(validConfig))
+	c.Assert(err, qt.IsNil)
+	cfg.Services = map[string]*Service{"billing": {URL: "https://billing.internal"}}
+	c.Assert(cfg.GenerateServiceAuthKey(), qt.IsNil)
+	c.Assert(cfg.Validate(md), qt.IsNil)
+
+	groups, err = ServiceGroups(md, nil, true)
+	c.Assert(err, qt.IsNil)
+	rt, err := cfg.RuntimeConfig(md, "my-app")
+	c.Assert(err, qt.IsNil)
+	c.Assert(rt.AuthKeys, qt.HasLen, 1)
+
+	todo := cfg.ServiceGroupConfig(rt, groups, groups[0])
+	c.Assert(todo.HostedServices, qt.DeepEquals, []string{"todo"})
+	c.Assert(todo.ServiceDiscovery, qt.DeepEquals, map[string]*config.ServiceDiscovery{
+		"email":   {URL: "http://email:8080"},
+		"billing": {URL: "https://billing.internal"},
+	})
+	c.Assert(rt.HostedServices, qt.IsNil)
+}
@@ -0,0 +1,132 @@
+package infracfg
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"encore.dev/appruntime/exported/config"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// defaultServicePort is the port ejected applications listen on
+// unless the PORT environment variable is set.
+const defaultServicePort = 8080
+
+// Service describes how to reach a service deployed separately
+// from the rest of the application.
+type Service struct {
+	// URL is the base URL of the deployment hosting the service.
+	// It defaults to "http://<service group name>:8080".
+	URL string `json:"url"`
+}
+
+// ServiceGroup is a group of services deployed together, as a single image.
+type ServiceGroup struct {
+	// Name is the name of the group, used to name its image.
+	Name string
+
+	// Services are the names of the services in the group.
+	Services []string
+}
+
+// ServiceGroups computes the service groups to build images for.
+//
+// Each entry in groups is a comma-separated list of service names.
+// If split is true each service gets its own group instead.
+// Every service in the application must belong to exactly one group.
+func ServiceGroups(md *meta.Data, groups []string, split bool) ([]*ServiceGroup, error) {
+	if split {
+		if len(groups) > 0 {
+			return nil, errors.New("service groups cannot be combined with splitting all services")
+		}
+		result := make([]*ServiceGroup, 0, len(md.Svcs))
+		for _, svc := range md.Svcs {
+			result = append(result, &ServiceGroup{Name: svc.Name, Services: []string{svc.Name}})
+		}
+		return result, nil
+	}
+
+	appSvcs := make(map[string]bool, len(md.Svcs))
+	for _, svc := range md.Svcs {
+		appSvcs[svc.Name] = true
+	}
+
+	var problems []string
+	addf := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	var result []*ServiceGroup
+	seen := make(map[string]bool)
+	for _, g := range groups {
+		group := &ServiceGroup{}
+		for _, svc := range strings.Split(g, ",") {
+			svc = strings.TrimSpace(svc)
+			if svc == "" {
+				continue
+			} else if !appSvcs[svc] {
+				addf("service %s: not found in the application", svc)
+			} else if seen[svc] {
+				addf("service %s: part of more than one service group", svc)
+			}
+			seen[svc] = true
+			group.Services = append(group.Services, svc)
+		}
+		if len(group.Services) == 0 {
+			addf("empty service group %q", g)
+			continue
+		}
+		group.Name = strings.Join(group.Services, "-")
+		result = append(result, group)
+	}
+	for _, svc := range md.Svcs {
+		if !seen[svc.Name] {
+			addf("service %s: not part of any service group", svc.Name)
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, errors.Newf("invalid service groups:\n\t%s", strings.Join(problems, "\n\t"))
+	}
+	return result, nil
+}
+
+// GenerateServiceAuthKey sets ServiceAuthKey to a newly generated key
+// if it is not already set.
+func (c *Config) GenerateServiceAuthKey() error {
+	if c.ServiceAuthKey != "" {
+		return nil
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return errors.Wrap(err, "generate service auth key")
+	}
+	c.ServiceAuthKey = base64.StdEncoding.EncodeToString(key)
+	return nil
+}
+
+// ServiceGroupConfig returns a copy of the runtime config rt for the
+// deployment of the given service group. The deployment hosts the services
+// in the group, and calls the services in other groups over HTTP.
+func (c *Config) ServiceGroupConfig(rt *config.Runtime, groups []*ServiceGroup, group *ServiceGroup) *config.Runtime {
+	cfg := *rt
+	cfg.HostedServices = group.Services
+	cfg.ServiceDiscovery = make(map[string]*config.ServiceDiscovery)
+	for _, g := range groups {
+		if g == group {
+			continue
+		}
+		for _, svc := range g.Services {
+			url := fmt.Sprintf("http://%s:%d", g.Name, defaultServicePort)
+			if s := c.Services[svc]; s != nil && s.URL != "" {
+				url = s.URL
+			}
+			cfg.ServiceDiscovery[svc] = &config.ServiceDiscovery{URL: url}
+		}
+	}
+	return &cfg
+}
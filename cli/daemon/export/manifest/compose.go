@@ -0,0 +1,151 @@
+package manifest
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"gopkg.in/yaml.v3"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+// composeSecretsPrefix is the prefix of the environment variables
+// providing the application's secrets.
+const composeSecretsPrefix = "SECRET_"
+
+// Compose generates a Docker Compose file for deploying the application,
+// along with the files it references.
+func Compose(a *App) ([]*File, error) {
+	rtCfg, err := a.runtimeConfig(&config.SecretsProvider{
+		Env: &config.EnvSecretsProvider{Prefix: composeSecretsPrefix},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*File
+	file := &composeFile{Services: make(map[string]*composeService)}
+	app := &composeService{
+		Image:       a.Image,
+		Ports:       []string{strconv.Itoa(appPort) + ":" + strconv.Itoa(appPort)},
+		Environment: map[string]string{"ENCORE_RUNTIME_CONFIG": rtCfg},
+		EnvFile:     []string{"secrets.env"},
+		DependsOn:   make(map[string]composeDependency),
+		Restart:     "unless-stopped",
+	}
+	file.Services[a.Name] = app
+
+	// The application's secrets are read from secrets.env.
+	var secrets strings.Builder
+	secrets.WriteString("# Fill in the values of the application's secrets before deploying.\n")
+	for _, key := range a.Secrets {
+		secrets.WriteString(composeSecretsPrefix + key + "=\n")
+	}
+	files = append(files, &File{Path: "secrets.env", Data: []byte(secrets.String())})
+
+	// PostgreSQL and the database migrations.
+	if len(a.Databases) > 0 {
+		// The generated password is read from .env, which Docker Compose
+		// uses to substitute variables in the compose file.
+		files = append(files,
+			&File{Path: ".env", Data: []byte("POSTGRES_PASSWORD=" + a.PostgresPassword + "\n")},
+			&File{Path: "postgres-init/create-databases.sql", Data: a.createDatabasesSQL()},
+		)
+		file.Services[postgresHost] = &composeService{
+			Image: postgresImage,
+			Environment: map[string]string{
+				"POSTGRES_USER":     postgresUser,
+				"POSTGRES_PASSWORD": "${POSTGRES_PASSWORD}",
+			},
+			Volumes: []string{
+				"postgres-data:/var/lib/postgresql/data",
+				"./postgres-init:/docker-entrypoint-initdb.d:ro",
+			},
+			Healthcheck: &composeHealthcheck{
+				Test:     []string{"CMD", "pg_isready", "-U", postgresUser},
+				Interval: "5s",
+				Retries:  10,
+			},
+			Restart: "unless-stopped",
+		}
+		file.Volumes = map[string]struct{}{"postgres-data": {}}
+		app.Environment[composeSecretsPrefix+postgresPasswordSecret] = "${POSTGRES_PASSWORD}"
+		app.DependsOn[postgresHost] = composeDependency{Condition: "service_healthy"}
+
+		// Apply the migrations before the application starts,
+		// using the migrate command of the application's image.
+		if a.hasMigrations() {
+			env := make(map[string]string, len(app.Environment))
+			for k, v := range app.Environment {
+				env[k] = v
+			}
+			name := a.Name + "-migrate"
+			file.Services[name] = &composeService{
+				Image:       a.Image,
+				Command:     []string{"migrate"},
+				Environment: env,
+				EnvFile:     app.EnvFile,
+				DependsOn:   map[string]composeDependency{postgresHost: {Condition: "service_healthy"}},
+			}
+			app.DependsOn[name] = composeDependency{Condition: "service_completed_successfully"}
+		}
+	}
+
+	// Redis.
+	if len(a.CacheClusters) > 0 {
+		file.Services[redisHost] = &composeService{Image: redisImage, Restart: "unless-stopped"}
+		app.DependsOn[redisHost] = composeDependency{Condition: "service_started"}
+	}
+
+	// NSQ.
+	if len(a.Topics) > 0 {
+		file.Services[nsqdHost] = &composeService{
+			Image:   nsqImage,
+			Command: []string{"/nsqd", "--broadcast-address=" + nsqdHost},
+			Restart: "unless-stopped",
+		}
+		app.DependsOn[nsqdHost] = composeDependency{Condition: "service_started"}
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(file); err != nil {
+		return nil, errors.Wrap(err, "encode compose file")
+	} else if err := enc.Close(); err != nil {
+		return nil, errors.Wrap(err, "encode compose file")
+	}
+	files = append([]*File{{Path: "docker-compose.yml", Data: buf.Bytes()}}, files...)
+	return files, nil
+}
+
+// The types below are the subset of the Compose file format used by the generated file.
+
+type composeFile struct {
+	Services map[string]*composeService `yaml:"services"`
+	Volumes  map[string]struct{}        `yaml:"volumes,omitempty"`
+}
+
+type composeService struct {
+	Image       string                       `yaml:"image"`
+	Command     []string                     `yaml:"command,omitempty"`
+	Ports       []string                     `yaml:"ports,omitempty"`
+	Environment map[string]string            `yaml:"environment,omitempty"`
+	EnvFile     []string                     `yaml:"env_file,omitempty"`
+	Volumes     []string                     `yaml:"volumes,omitempty"`
+	DependsOn   map[string]composeDependency `yaml:"depends_on,omitempty"`
+	Healthcheck *composeHealthcheck          `yaml:"healthcheck,omitempty"`
+	Restart     string                       `yaml:"restart,omitempty"`
+}
+
+type composeDependency struct {
+	Condition string `yaml:"condition"`
+}
+
+type composeHealthcheck struct {
+	Test     []string `yaml:"test"`
+	Interval string   `yaml:"interval,omitempty"`
+	Retries  int      `yaml:"retries,omitempty"`
+}
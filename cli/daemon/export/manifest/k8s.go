@@ -0,0 +1,373 @@
+package manifest
+
+import (
+	"bytes"
+
+	"github.com/cockroachdb/errors"
+	"gopkg.in/yaml.v3"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+// k8sSecretsDir is the directory the application's secrets
+// are mounted at in the application's containers.
+const k8sSecretsDir = "/var/run/secrets/encore"
+
+// Kubernetes generates Kubernetes manifests for deploying the application.
+func Kubernetes(a *App) ([]*File, error) {
+	rtCfg, err := a.runtimeConfig(&config.SecretsProvider{
+		Files: &config.FileSecretsProvider{Dir: k8sSecretsDir},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secretsName := a.Name + "-secrets"
+	configName := a.Name + "-config"
+	postgresPassword := envVar{
+		Name:      "POSTGRES_PASSWORD",
+		ValueFrom: &envVarSource{SecretKeyRef: &keySelector{Name: secretsName, Key: postgresPasswordSecret}},
+	}
+
+	var files []*File
+	add := func(path, header string, objs ...*k8sObject) error {
+		var buf bytes.Buffer
+		buf.WriteString(header)
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		for _, obj := range objs {
+			if err := enc.Encode(obj); err != nil {
+				return errors.Wrapf(err, "encode %s", path)
+			}
+		}
+		if err := enc.Close(); err != nil {
+			return errors.Wrapf(err, "encode %s", path)
+		}
+		files = append(files, &File{Path: path, Data: buf.Bytes()})
+		return nil
+	}
+
+	// Secrets and runtime config.
+	secrets := make(map[string]string)
+	for _, key := range a.Secrets {
+		secrets[key] = ""
+	}
+	if a.PostgresPassword != "" {
+		secrets[postgresPasswordSecret] = a.PostgresPassword
+	}
+	err = add("secrets.yaml", "# Fill in the values of the application's secrets before deploying.\n", &k8sObject{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata:   a.objectMeta(secretsName),
+		StringData: secrets,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = add("config.yaml", "", &k8sObject{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Metadata:   a.objectMeta(configName),
+		Data:       map[string]string{"ENCORE_RUNTIME_CONFIG": rtCfg},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// PostgreSQL.
+	if len(a.Databases) > 0 {
+		initName := a.Name + "-postgres-init"
+		err = add("postgres.yaml", "",
+			&k8sObject{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Metadata:   a.objectMeta(initName),
+				Data:       map[string]string{"create-databases.sql": string(a.createDatabasesSQL())},
+			},
+			&k8sObject{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Metadata:   a.objectMeta(postgresHost),
+				Spec: &workloadSpec{
+					Replicas:    1,
+					ServiceName: postgresHost,
+					Selector:    a.selector(postgresHost),
+					Template: podTemplate{
+						Metadata: a.objectMeta(postgresHost),
+						Spec: podSpec{
+							Containers: []*container{{
+								Name:  postgresHost,
+								Image: postgresImage,
+								Ports: []containerPort{{ContainerPort: postgresPort}},
+								Env: []envVar{
+									{Name: "POSTGRES_USER", Value: postgresUser},
+									postgresPassword,
+									{Name: "PGDATA", Value: "/var/lib/postgresql/data/pgdata"},
+								},
+								VolumeMounts: []volumeMount{
+									{Name: "data", MountPath: "/var/lib/postgresql/data"},
+									{Name: "init", MountPath: "/docker-entrypoint-initdb.d", ReadOnly: true},
+								},
+								ReadinessProbe: &probe{
+									Exec:          &execAction{Command: []string{"pg_isready", "-U", postgresUser}},
+									PeriodSeconds: 5,
+								},
+							}},
+							Volumes: []*volume{{Name: "init", ConfigMap: &nameRef{Name: initName}}},
+						},
+					},
+					VolumeClaimTemplates: []*k8sObject{{
+						Metadata: objectMeta{Name: "data"},
+						Spec: &pvcSpec{
+							AccessModes: []string{"ReadWriteOnce"},
+							Resources:   resourceRequirements{Requests: map[string]string{"storage": "1Gi"}},
+						},
+					}},
+				},
+			},
+			a.service(postgresHost, postgresPort),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Redis.
+	if len(a.CacheClusters) > 0 {
+		err = add("redis.yaml", "",
+			a.deployment(redisHost, &container{
+				Name:  redisHost,
+				Image: redisImage,
+				Ports: []containerPort{{ContainerPort: redisPort}},
+			}),
+			a.service(redisHost, redisPort),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// NSQ.
+	if len(a.Topics) > 0 {
+		err = add("nsq.yaml", "",
+			a.deployment(nsqdHost, &container{
+				Name:  nsqdHost,
+				Image: nsqImage,
+				Args:  []string{"/nsqd", "--broadcast-address=" + nsqdHost},
+				Ports: []containerPort{{ContainerPort: nsqdPort}},
+			}),
+			a.service(nsqdHost, nsqdPort),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The application itself.
+	envFrom := []envFromSource{{ConfigMapRef: &nameRef{Name: configName}}}
+	secretsMount := []volumeMount{{Name: "secrets", MountPath: k8sSecretsDir, ReadOnly: true}}
+	healthz := &probe{HTTPGet: &httpGetAction{Path: healthzPath, Port: appPort}, PeriodSeconds: 10}
+	app := a.deployment(a.Name, &container{
+		Name:           a.Name,
+		Image:          a.Image,
+		Ports:          []containerPort{{ContainerPort: appPort}},
+		EnvFrom:        envFrom,
+		VolumeMounts:   secretsMount,
+		ReadinessProbe: healthz,
+		LivenessProbe:  healthz,
+	})
+	podSpec := &app.Spec.(*workloadSpec).Template.Spec
+	podSpec.Volumes = []*volume{{Name: "secrets", Secret: &secretVolume{SecretName: secretsName}}}
+
+	// Apply the database migrations before the application starts,
+	// using the migrate command of the application's image.
+	// Concurrent runs from multiple replicas are serialized by the migrate command.
+	if a.hasMigrations() {
+		podSpec.InitContainers = []*container{{
+			Name:         "migrate",
+			Image:        a.Image,
+			Args:         []string{"migrate"},
+			EnvFrom:      envFrom,
+			VolumeMounts: secretsMount,
+		}}
+	}
+	if err := add("app.yaml", "", app, a.service(a.Name, appPort)); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// objectMeta returns the metadata for the resource with the given name.
+func (a *App) objectMeta(name string) objectMeta {
+	return objectMeta{
+		Name: name,
+		Labels: map[string]string{
+			"app.kubernetes.io/name":    name,
+			"app.kubernetes.io/part-of": a.Name,
+		},
+	}
+}
+
+func (a *App) selector(name string) labelSelector {
+	return labelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": name}}
+}
+
+// deployment returns a single-replica deployment running c.
+func (a *App) deployment(name string, c *container) *k8sObject {
+	return &k8sObject{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Metadata:   a.objectMeta(name),
+		Spec: &workloadSpec{
+			Replicas: 1,
+			Selector: a.selector(name),
+			Template: podTemplate{
+				Metadata: a.objectMeta(name),
+				Spec:     podSpec{Containers: []*container{c}},
+			},
+		},
+	}
+}
+
+// service returns a service exposing port of the pods with the given name.
+func (a *App) service(name string, port int) *k8sObject {
+	return &k8sObject{
+		APIVersion: "v1",
+		Kind:       "Service",
+		Metadata:   a.objectMeta(name),
+		Spec: &serviceSpec{
+			Selector: map[string]string{"app.kubernetes.io/name": name},
+			Ports:    []servicePort{{Port: port, TargetPort: port}},
+		},
+	}
+}
+
+// The types below are the subset of the Kubernetes API used by the manifests.
+
+type k8sObject struct {
+	APIVersion string            `yaml:"apiVersion,omitempty"`
+	Kind       string            `yaml:"kind,omitempty"`
+	Metadata   objectMeta        `yaml:"metadata"`
+	Spec       any               `yaml:"spec,omitempty"`
+	Data       map[string]string `yaml:"data,omitempty"`
+	StringData map[string]string `yaml:"stringData,omitempty"`
+}
+
+type objectMeta struct {
+	Name   string            `yaml:"name"`
+	Labels map[string]string `yaml:"labels,omitempty"`
+}
+
+// workloadSpec is the spec of a Deployment or StatefulSet.
+type workloadSpec struct {
+	Replicas             int           `yaml:"replicas"`
+	ServiceName          string        `yaml:"serviceName,omitempty"`
+	Selector             labelSelector `yaml:"selector"`
+	Template             podTemplate   `yaml:"template"`
+	VolumeClaimTemplates []*k8sObject  `yaml:"volumeClaimTemplates,omitempty"`
+}
+
+type labelSelector struct {
+	MatchLabels map[string]string `yaml:"matchLabels"`
+}
+
+type podTemplate struct {
+	Metadata objectMeta `yaml:"metadata"`
+	Spec     podSpec    `yaml:"spec"`
+}
+
+type podSpec struct {
+	InitContainers []*container `yaml:"initContainers,omitempty"`
+	Containers     []*container `yaml:"containers"`
+	Volumes        []*volume    `yaml:"volumes,omitempty"`
+}
+
+type container struct {
+	Name           string          `yaml:"name"`
+	Image          string          `yaml:"image"`
+	Args           []string        `yaml:"args,omitempty"`
+	Ports          []containerPort `yaml:"ports,omitempty"`
+	Env            []envVar        `yaml:"env,omitempty"`
+	EnvFrom        []envFromSource `yaml:"envFrom,omitempty"`
+	VolumeMounts   []volumeMount   `yaml:"volumeMounts,omitempty"`
+	ReadinessProbe *probe          `yaml:"readinessProbe,omitempty"`
+	LivenessProbe  *probe          `yaml:"livenessProbe,omitempty"`
+}
+
+type containerPort struct {
+	ContainerPort int `yaml:"containerPort"`
+}
+
+type envVar struct {
+	Name      string        `yaml:"name"`
+	Value     string        `yaml:"value,omitempty"`
+	ValueFrom *envVarSource `yaml:"valueFrom,omitempty"`
+}
+
+type envVarSource struct {
+	SecretKeyRef *keySelector `yaml:"secretKeyRef,omitempty"`
+}
+
+type keySelector struct {
+	Name string `yaml:"name"`
+	Key  string `yaml:"key"`
+}
+
+type envFromSource struct {
+	ConfigMapRef *nameRef `yaml:"configMapRef,omitempty"`
+}
+
+type nameRef struct {
+	Name string `yaml:"name"`
+}
+
+type volumeMount struct {
+	Name      string `yaml:"name"`
+	MountPath string `yaml:"mountPath"`
+	ReadOnly  bool   `yaml:"readOnly,omitempty"`
+}
+
+type volume struct {
+	Name      string        `yaml:"name"`
+	ConfigMap *nameRef      `yaml:"configMap,omitempty"`
+	Secret    *secretVolume `yaml:"secret,omitempty"`
+}
+
+type secretVolume struct {
+	SecretName string `yaml:"secretName"`
+}
+
+type probe struct {
+	HTTPGet       *httpGetAction `yaml:"httpGet,omitempty"`
+	Exec          *execAction    `yaml:"exec,omitempty"`
+	PeriodSeconds int            `yaml:"periodSeconds,omitempty"`
+}
+
+type httpGetAction struct {
+	Path string `yaml:"path"`
+	Port int    `yaml:"port"`
+}
+
+type execAction struct {
+	Command []string `yaml:"command"`
+}
+
+type serviceSpec struct {
+	Selector map[string]string `yaml:"selector"`
+	Ports    []servicePort     `yaml:"ports"`
+}
+
+type servicePort struct {
+	Port       int `yaml:"port"`
+	TargetPort int `yaml:"targetPort"`
+}
+
+type pvcSpec struct {
+	AccessModes []string             `yaml:"accessModes"`
+	Resources   resourceRequirements `yaml:"resources"`
+}
+
+type resourceRequirements struct {
+	Requests map[string]string `yaml:"requests"`
+}
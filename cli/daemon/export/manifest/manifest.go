@@ -0,0 +1,274 @@
+// Package manifest generates deployment manifests for ejected applications,
+// as Kubernetes manifests or a Docker Compose file.
+//
+// The manifests deploy the application's image together with the infrastructure
+// it needs, derived from the application's metadata: a PostgreSQL server hosting
+// its databases, a Redis server for its cache clusters, and an NSQ daemon for
+// its Pub/Sub topics. Database migrations are applied before the application starts,
+// using the migrate command of the application's image.
+package manifest
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"encore.dev/appruntime/exported/config"
+	"encr.dev/cli/daemon/export/infracfg"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+const (
+	// appPort is the port the application listens on.
+	appPort = 8080
+
+	// healthzPath is the path of the application's health check route.
+	healthzPath = "/__encore/healthz"
+
+	postgresImage = "postgres:15"
+	redisImage    = "redis:7"
+	nsqImage      = "nsqio/nsq:v1.2.1"
+
+	// The hostnames of the infrastructure services.
+	postgresHost = "postgres"
+	redisHost    = "redis"
+	nsqdHost     = "nsqd"
+
+	postgresUser = "encore"
+	postgresPort = 5432
+	redisPort    = 6379
+	nsqdPort     = 4150
+
+	// postgresPasswordSecret is the name of the secret holding
+	// the password of the PostgreSQL server.
+	postgresPasswordSecret = "EncorePostgresPassword"
+)
+
+// File is a generated file.
+type File struct {
+	// Path is the path of the file, relative to the output directory.
+	Path string
+	Data []byte
+}
+
+// App describes an application and the infrastructure it needs.
+type App struct {
+	// Name is the name of the application, used to name its resources.
+	Name string
+
+	// Image is the docker image of the application.
+	Image string
+
+	Databases     []*Database
+	CacheClusters []string
+	Topics        []*Topic
+
+	// Secrets are the keys of the secrets used by the application.
+	Secrets []string
+
+	// PostgresPassword is the generated password of the PostgreSQL server.
+	PostgresPassword string
+
+	md *meta.Data
+}
+
+// Database is a SQL database used by the application.
+type Database struct {
+	Name string
+
+	// Migrations are the filenames of the database's migrations.
+	Migrations []string
+}
+
+// Topic is a Pub/Sub topic used by the application.
+type Topic struct {
+	Name          string
+	Subscriptions []string
+}
+
+// Describe describes the application with the given metadata,
+// to be deployed using the given docker image.
+func Describe(name, image string, md *meta.Data) (*App, error) {
+	app := &App{
+		Name:  resourceName(name),
+		Image: image,
+		md:    md,
+	}
+	if app.Name == "" {
+		return nil, errors.Newf("invalid app name %q", name)
+	}
+
+	dbs := make(map[string]*Database)
+	for _, svc := range md.Svcs {
+		if len(svc.Migrations) == 0 {
+			continue
+		}
+		db := &Database{Name: svc.Name}
+		for _, m := range svc.Migrations {
+			db.Migrations = append(db.Migrations, m.Filename)
+		}
+		dbs[svc.Name] = db
+	}
+	for _, svc := range md.Svcs {
+		for _, name := range svc.Databases {
+			if _, ok := dbs[name]; !ok {
+				dbs[name] = &Database{Name: name}
+			}
+		}
+	}
+	for _, name := range sortedKeys(dbs) {
+		app.Databases = append(app.Databases, dbs[name])
+	}
+
+	for _, cl := range md.CacheClusters {
+		app.CacheClusters = append(app.CacheClusters, cl.Name)
+	}
+	sort.Strings(app.CacheClusters)
+
+	for _, t := range md.PubsubTopics {
+		topic := &Topic{Name: t.Name}
+		for _, s := range t.Subscriptions {
+			topic.Subscriptions = append(topic.Subscriptions, s.Name)
+		}
+		sort.Strings(topic.Subscriptions)
+		app.Topics = append(app.Topics, topic)
+	}
+	sort.Slice(app.Topics, func(i, j int) bool { return app.Topics[i].Name < app.Topics[j].Name })
+
+	seen := make(map[string]bool)
+	for _, pkg := range md.Pkgs {
+		for _, key := range pkg.Secrets {
+			if !seen[key] {
+				seen[key] = true
+				app.Secrets = append(app.Secrets, key)
+			}
+		}
+	}
+	sort.Strings(app.Secrets)
+
+	if len(app.Databases) > 0 {
+		pw := make([]byte, 16)
+		if _, err := rand.Read(pw); err != nil {
+			return nil, errors.Wrap(err, "generate postgres password")
+		}
+		app.PostgresPassword = hex.EncodeToString(pw)
+	}
+
+	return app, nil
+}
+
+// infraConfig returns the infrastructure config for the application,
+// resolving secrets using the given secrets provider.
+func (a *App) infraConfig(secrets *config.SecretsProvider) *infracfg.Config {
+	cfg := &infracfg.Config{
+		EnvName:    "self-hosted",
+		EnvType:    "production",
+		Cloud:      "local",
+		APIBaseURL: "http://" + a.Name + ":" + strconv.Itoa(appPort),
+		Secrets:    &infracfg.Secrets{Providers: []*config.SecretsProvider{secrets}},
+	}
+
+	if len(a.Databases) > 0 {
+		srv := &infracfg.SQLServer{
+			Host:      postgresHost + ":" + strconv.Itoa(postgresPort),
+			Databases: make(map[string]*infracfg.SQLDatabase),
+		}
+		for _, db := range a.Databases {
+			srv.Databases[db.Name] = &infracfg.SQLDatabase{
+				User:           postgresUser,
+				PasswordSecret: postgresPasswordSecret,
+			}
+		}
+		cfg.SQLServers = []*infracfg.SQLServer{srv}
+	}
+
+	if len(a.Topics) > 0 {
+		p := &infracfg.PubSubProvider{
+			NSQ:    &config.NSQProvider{Host: nsqdHost + ":" + strconv.Itoa(nsqdPort)},
+			Topics: make(map[string]*infracfg.PubSubTopic),
+		}
+		for _, t := range a.Topics {
+			topic := &infracfg.PubSubTopic{Subscriptions: make(map[string]*infracfg.PubSubSubscription)}
+			for _, s := range t.Subscriptions {
+				topic.Subscriptions[s] = &infracfg.PubSubSubscription{}
+			}
+			p.Topics[t.Name] = topic
+		}
+		cfg.PubSub = []*infracfg.PubSubProvider{p}
+	}
+
+	if len(a.CacheClusters) > 0 {
+		srv := &infracfg.RedisServer{
+			Host:      redisHost + ":" + strconv.Itoa(redisPort),
+			Databases: make(map[string]*infracfg.RedisDatabase),
+		}
+		for _, name := range a.CacheClusters {
+			srv.Databases[name] = &infracfg.RedisDatabase{KeyPrefix: name + "/"}
+		}
+		cfg.Redis = []*infracfg.RedisServer{srv}
+	}
+
+	return cfg
+}
+
+// runtimeConfig computes the base64-encoded runtime config for the application,
+// resolving secrets using the given secrets provider.
+func (a *App) runtimeConfig(secrets *config.SecretsProvider) (string, error) {
+	cfg := a.infraConfig(secrets)
+	if err := cfg.Validate(a.md); err != nil {
+		return "", err
+	}
+	rt, err := cfg.RuntimeConfig(a.md, a.Name)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(rt)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal runtime config")
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// createDatabasesSQL returns a SQL script creating the application's databases.
+func (a *App) createDatabasesSQL() []byte {
+	var b strings.Builder
+	for _, db := range a.Databases {
+		b.WriteString(`CREATE DATABASE "` + db.Name + `";` + "\n")
+	}
+	return []byte(b.String())
+}
+
+// hasMigrations reports whether any of the application's databases have migrations.
+func (a *App) hasMigrations() bool {
+	for _, db := range a.Databases {
+		if len(db.Migrations) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// resourceName converts name into a valid Kubernetes resource name
+// and Docker Compose service name.
+func resourceName(name string) string {
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	return strings.Trim(name, "-")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,144 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"gopkg.in/yaml.v3"
+
+	"encore.dev/appruntime/exported/config"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+func testApp(c *qt.C) *App {
+	md := &meta.Data{
+		Svcs: []*meta.Service{
+			{Name: "todo", RelPath: "todo", Migrations: []*meta.DBMigration{{Filename: "1_init.up.sql", Number: 1}}},
+			{Name: "email"},
+		},
+		Pkgs: []*meta.Package{
+			{RelPath: "email", Secrets: []string{"SendGridKey"}},
+		},
+		PubsubTopics: []*meta.PubSubTopic{{
+			Name:          "signups",
+			Subscriptions: []*meta.PubSubTopic_Subscription{{Name: "send-welcome-email", ServiceName: "email"}},
+		}},
+		CacheClusters: []*meta.CacheCluster{{Name: "sessions"}},
+	}
+
+	app, err := Describe("My App", "registry/my-app:v1", md)
+	c.Assert(err, qt.IsNil)
+	return app
+}
+
+func TestDescribe(t *testing.T) {
+	c := qt.New(t)
+	app := testApp(c)
+	c.Assert(app.Name, qt.Equals, "my-app")
+	c.Assert(app.Databases, qt.HasLen, 1)
+	c.Assert(app.Databases[0].Migrations, qt.DeepEquals, []string{"1_init.up.sql"})
+	c.Assert(app.CacheClusters, qt.DeepEquals, []string{"sessions"})
+	c.Assert(app.Topics, qt.DeepEquals, []*Topic{{Name: "signups", Subscriptions: []string{"send-welcome-email"}}})
+	c.Assert(app.Secrets, qt.DeepEquals, []string{"SendGridKey"})
+	c.Assert(app.PostgresPassword, qt.HasLen, 32)
+}
+
+func TestKubernetes(t *testing.T) {
+	c := qt.New(t)
+	app := testApp(c)
+
+	files, err := Kubernetes(app)
+	c.Assert(err, qt.IsNil)
+	byPath := make(map[string][]map[string]any)
+	for _, f := range files {
+		byPath[f.Path] = decodeAll(c, f.Data)
+	}
+	c.Assert(sortedKeys(byPath), qt.DeepEquals, []string{
+		"app.yaml", "config.yaml", "nsq.yaml", "postgres.yaml", "redis.yaml", "secrets.yaml",
+	})
+
+	secrets := byPath["secrets.yaml"][0]["stringData"].(map[string]any)
+	c.Assert(secrets["SendGridKey"], qt.Equals, "")
+	c.Assert(secrets[postgresPasswordSecret], qt.Equals, app.PostgresPassword)
+
+	rt := decodeRuntimeConfig(c, byPath["config.yaml"][0]["data"].(map[string]any)["ENCORE_RUNTIME_CONFIG"].(string))
+	c.Assert(rt.SQLServers[0].Host, qt.Equals, "postgres:5432")
+	c.Assert(rt.SQLDatabases[0].PasswordSecret, qt.Equals, postgresPasswordSecret)
+	c.Assert(rt.SecretsProviders[0].Files.Dir, qt.Equals, k8sSecretsDir)
+
+	// The application is probed on the health check route,
+	// and migrations are applied by an init container running the app's migrate command.
+	deploy := byPath["app.yaml"][0]
+	c.Assert(deploy["kind"], qt.Equals, "Deployment")
+	pod := deploy["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)
+	ctr := pod["containers"].([]any)[0].(map[string]any)
+	c.Assert(ctr["readinessProbe"].(map[string]any)["httpGet"].(map[string]any)["path"], qt.Equals, healthzPath)
+	initCtr := pod["initContainers"].([]any)[0].(map[string]any)
+	c.Assert(initCtr["image"], qt.Equals, app.Image)
+	c.Assert(initCtr["args"], qt.DeepEquals, []any{"migrate"})
+	c.Assert(initCtr["envFrom"], qt.DeepEquals, ctr["envFrom"])
+}
+
+func TestCompose(t *testing.T) {
+	c := qt.New(t)
+	app := testApp(c)
+
+	files, err := Compose(app)
+	c.Assert(err, qt.IsNil)
+	byPath := make(map[string][]byte)
+	for _, f := range files {
+		byPath[f.Path] = f.Data
+	}
+	c.Assert(sortedKeys(byPath), qt.DeepEquals, []string{
+		".env", "docker-compose.yml", "postgres-init/create-databases.sql", "secrets.env",
+	})
+	c.Assert(string(byPath[".env"]), qt.Equals, "POSTGRES_PASSWORD="+app.PostgresPassword+"\n")
+	c.Assert(string(byPath["postgres-init/create-databases.sql"]), qt.Equals, `CREATE DATABASE "todo";`+"\n")
+	c.Assert(string(byPath["secrets.env"]), qt.Contains, "SECRET_SendGridKey=\n")
+
+	var file composeFile
+	c.Assert(yaml.Unmarshal(byPath["docker-compose.yml"], &file), qt.IsNil)
+	c.Assert(sortedKeys(file.Services), qt.DeepEquals, []string{"my-app", "my-app-migrate", "nsqd", "postgres", "redis"})
+
+	svc := file.Services["my-app"]
+	c.Assert(svc.DependsOn["my-app-migrate"].Condition, qt.Equals, "service_completed_successfully")
+	c.Assert(svc.Environment["SECRET_"+postgresPasswordSecret], qt.Equals, "${POSTGRES_PASSWORD}")
+	rt := decodeRuntimeConfig(c, svc.Environment["ENCORE_RUNTIME_CONFIG"])
+	c.Assert(rt.SecretsProviders[0].Env.Prefix, qt.Equals, composeSecretsPrefix)
+	c.Assert(rt.RedisServers[0].Host, qt.Equals, "redis:6379")
+	c.Assert(rt.PubsubProviders[0].NSQ.Host, qt.Equals, "nsqd:4150")
+
+	// Migrations are applied by the app's migrate command, configured like the app.
+	migrate := file.Services["my-app-migrate"]
+	c.Assert(migrate.Image, qt.Equals, app.Image)
+	c.Assert(migrate.Command, qt.DeepEquals, []string{"migrate"})
+	c.Assert(migrate.Environment, qt.DeepEquals, svc.Environment)
+	c.Assert(migrate.EnvFile, qt.DeepEquals, svc.EnvFile)
+}
+
+func decodeAll(c *qt.C, data []byte) []map[string]any {
+	var docs []map[string]any
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc map[string]any
+		if err := dec.Decode(&doc); errors.Is(err, io.EOF) {
+			return docs
+		} else {
+			c.Assert(err, qt.IsNil)
+		}
+		docs = append(docs, doc)
+	}
+}
+
+func decodeRuntimeConfig(c *qt.C, s string) *config.Runtime {
+	data, err := base64.StdEncoding.DecodeString(s)
+	c.Assert(err, qt.IsNil)
+	var rt config.Runtime
+	c.Assert(json.Unmarshal(data, &rt), qt.IsNil)
+	return &rt
+}
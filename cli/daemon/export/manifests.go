@@ -0,0 +1,85 @@
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog"
+
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/export/manifest"
+	"encr.dev/pkg/builder"
+	"encr.dev/pkg/builder/builderimpl"
+	"encr.dev/pkg/vcs"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+// Manifests exports deployment manifests for the app,
+// as Kubernetes manifests or a Docker Compose file.
+func Manifests(ctx context.Context, app *apps.Instance, req *daemonpb.ExportRequest, log zerolog.Logger) (success bool, err error) {
+	var (
+		params   *daemonpb.ManifestExportParams
+		generate func(*manifest.App) ([]*manifest.File, error)
+	)
+	switch f := req.Format.(type) {
+	case *daemonpb.ExportRequest_Kubernetes:
+		params, generate = f.Kubernetes, manifest.Kubernetes
+	case *daemonpb.ExportRequest_Compose:
+		params, generate = f.Compose, manifest.Compose
+	default:
+		return false, errors.Newf("unsupported format: %T", req.Format)
+	}
+
+	expSet, err := app.Experiments(req.Environ)
+	if err != nil {
+		return false, errors.Wrap(err, "get experimental features")
+	}
+
+	vcsRevision := vcs.GetRevision(app.Root())
+	bld := builderimpl.Resolve(expSet)
+	parse, err := bld.Parse(ctx, builder.ParseParams{
+		Build: builder.BuildInfo{
+			BuildTags:          []string{"timetzdata"},
+			CgoEnabled:         req.CgoEnabled,
+			GOOS:               req.Goos,
+			GOARCH:             req.Goarch,
+			Revision:           vcsRevision.Revision,
+			UncommittedChanges: vcsRevision.Uncommitted,
+		},
+		App:         app,
+		Experiments: expSet,
+		WorkingDir:  ".",
+		ParseTests:  false,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	name := app.PlatformID()
+	if name == "" {
+		name = filepath.Base(app.Root())
+	}
+	desc, err := manifest.Describe(name, params.ImageTag, parse.Meta)
+	if err != nil {
+		return false, err
+	}
+	files, err := generate(desc)
+	if err != nil {
+		return false, err
+	}
+
+	for _, f := range files {
+		dst := filepath.Join(params.OutputDir, filepath.FromSlash(f.Path))
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return false, errors.Wrap(err, "create output dir")
+		}
+		// The files may contain secrets, so don't make them world-readable.
+		if err := os.WriteFile(dst, f.Data, 0600); err != nil {
+			return false, errors.Wrap(err, "write file")
+		}
+		log.Info().Msgf("wrote %s", dst)
+	}
+	return true, nil
+}
@@ -0,0 +1,138 @@
+package export
+
+import (
+	"crypto/rand"
+	"debug/buildinfo"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// sbomPath is where the software bill of materials is stored in the image.
+const sbomPath = "/encore/sbom.spdx.json"
+
+// generateSBOM generates a software bill of materials in the SPDX format,
+// listing the Go modules compiled into the executable exe.
+func generateSBOM(exe string, created time.Time) ([]byte, error) {
+	info, err := buildinfo.ReadFile(exe)
+	if err != nil {
+		return nil, errors.Wrap(err, "read build info")
+	}
+	return buildSBOM(info, created)
+}
+
+// buildSBOM builds an SPDX document listing the Go modules in info.
+func buildSBOM(info *debug.BuildInfo, created time.Time) ([]byte, error) {
+	nonce := make([]byte, 8)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "generate document namespace")
+	}
+
+	name := info.Main.Path
+	if name == "" {
+		name = info.Path
+	}
+	doc := &spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              name,
+		DocumentNamespace: "https://encore.dev/spdx/" + name + "-" + hex.EncodeToString(nonce),
+		CreationInfo: spdxCreationInfo{
+			Created:  created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: encore"},
+		},
+	}
+
+	mainID := "SPDXRef-Package-main"
+	doc.Packages = append(doc.Packages, spdxModule(mainID, name, info.Main.Version))
+	doc.Relationships = append(doc.Relationships, spdxRelationship{
+		ElementID:        doc.SPDXID,
+		RelationshipType: "DESCRIBES",
+		RelatedElement:   mainID,
+	})
+
+	for i, dep := range info.Deps {
+		// Use the replacement module if the module was replaced,
+		// since that's what was compiled into the binary.
+		mod := dep
+		if dep.Replace != nil {
+			mod = dep.Replace
+		}
+		id := fmt.Sprintf("SPDXRef-Package-%d", i)
+		doc.Packages = append(doc.Packages, spdxModule(id, mod.Path, mod.Version))
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			ElementID:        mainID,
+			RelationshipType: "DEPENDS_ON",
+			RelatedElement:   id,
+		})
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal sbom")
+	}
+	return data, nil
+}
+
+// spdxModule describes the Go module with the given path and version.
+func spdxModule(id, path, version string) spdxPackage {
+	pkg := spdxPackage{
+		Name:             path,
+		SPDXID:           id,
+		VersionInfo:      version,
+		DownloadLocation: "NOASSERTION",
+	}
+	// Local modules such as the app itself have the version "(devel)",
+	// which doesn't identify anything to download.
+	if version != "" && version != "(devel)" {
+		pkg.ExternalRefs = []spdxExternalRef{{
+			ReferenceCategory: "PACKAGE-MANAGER",
+			ReferenceType:     "purl",
+			ReferenceLocator:  "pkg:golang/" + path + "@" + version,
+		}}
+	}
+	return pkg
+}
+
+// The types below are the subset of the SPDX 2.3 JSON format used by the SBOM.
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	ElementID        string `json:"spdxElementId"`
+	RelationshipType string `json:"relationshipType"`
+	RelatedElement   string `json:"relatedSpdxElement"`
+}
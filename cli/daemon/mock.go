@@ -0,0 +1,61 @@
+package daemon
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"encr.dev/cli/daemon/mock"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+// Mock serves a mock version of the app's API, synthesized from the app's metadata.
+func (s *Server) Mock(params *daemonpb.MockRequest, stream daemonpb.Daemon_MockServer) error {
+	ctx := stream.Context()
+	slog := &streamLog{stream: stream, buffered: false}
+	log := newStreamLogger(slog)
+
+	parse, err := s.parseApp(params.AppRoot, ".", false)
+	if err != nil {
+		log.Error().Msg(err.Error())
+		streamExit(stream, 1)
+		return nil
+	}
+
+	srv, err := mock.NewServer(parse.Meta, params.FixturesDir, log)
+	if err != nil {
+		log.Error().Err(err).Msg("could not create mock server")
+		streamExit(stream, 1)
+		return nil
+	}
+
+	ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", "127.0.0.1:"+strconv.Itoa(int(params.Port)))
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+
+	err = stream.Send(&daemonpb.CommandMessage{Msg: &daemonpb.CommandMessage_Output{
+		Output: &daemonpb.CommandOutput{
+			Stdout: []byte(fmt.Sprintf("mock: serving mock API on http://localhost:%d\n", port)),
+		},
+	}})
+	if err != nil {
+		ln.Close()
+		return err
+	}
+
+	httpSrv := &http.Server{Handler: srv}
+	go func() {
+		<-ctx.Done()
+		httpSrv.Close()
+	}()
+	if err := httpSrv.Serve(ln); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
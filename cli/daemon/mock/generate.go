@@ -0,0 +1,179 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"encr.dev/parser/encoding"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+// maxDepth is the maximum nesting depth of generated values.
+// Values nested deeper than this are generated as null,
+// which also guards against infinitely recursive types.
+const maxDepth = 5
+
+// generator synthesizes example values from schema types.
+type generator struct {
+	md  *meta.Data
+	rnd *rand.Rand
+}
+
+func newGenerator(md *meta.Data, key string) *generator {
+	return &generator{md: md, rnd: seededRand(key)}
+}
+
+// value generates an example value for typ.
+// The name is used to make generated strings more descriptive.
+func (g *generator) value(typ *schema.Type, name string, depth int) any {
+	return g.valueScoped(typ, name, depth, nil)
+}
+
+func (g *generator) valueScoped(typ *schema.Type, name string, depth int, typeArgs []*schema.Type) any {
+	if typ == nil || depth > maxDepth {
+		return nil
+	}
+
+	switch t := typ.Typ.(type) {
+	case *schema.Type_Builtin:
+		return g.builtin(t.Builtin, name)
+
+	case *schema.Type_Named:
+		decl := g.md.Decls[t.Named.Id]
+		args := make([]*schema.Type, len(t.Named.TypeArguments))
+		for i, arg := range t.Named.TypeArguments {
+			args[i] = resolveTypeParam(arg, typeArgs)
+		}
+		return g.valueScoped(decl.Type, name, depth+1, args)
+
+	case *schema.Type_TypeParameter:
+		if idx := int(t.TypeParameter.ParamIdx); idx < len(typeArgs) {
+			return g.valueScoped(typeArgs[idx], name, depth, nil)
+		}
+		return nil
+
+	case *schema.Type_Pointer:
+		return g.valueScoped(t.Pointer.Base, name, depth, typeArgs)
+
+	case *schema.Type_Config:
+		return g.valueScoped(t.Config.Elem, name, depth, typeArgs)
+
+	case *schema.Type_List:
+		if b, ok := t.List.Elem.Typ.(*schema.Type_Builtin); ok && b.Builtin == schema.Builtin_UINT8 {
+			// []byte is encoded as base64.
+			return g.builtin(schema.Builtin_BYTES, name)
+		}
+		n := 1 + g.rnd.Intn(3)
+		list := make([]any, n)
+		for i := range list {
+			list[i] = g.valueScoped(t.List.Elem, name, depth+1, typeArgs)
+		}
+		return list
+
+	case *schema.Type_Map:
+		obj := make(orderedObject, 0, 2)
+		for i := 0; i < 1+g.rnd.Intn(2); i++ {
+			key := formatValue(g.valueScoped(t.Map.Key, "key", depth+1, typeArgs))
+			obj = append(obj, objectField{Key: key, Value: g.valueScoped(t.Map.Value, name, depth+1, typeArgs)})
+		}
+		return obj
+
+	case *schema.Type_Struct:
+		obj := make(orderedObject, 0, len(t.Struct.Fields))
+		for _, f := range t.Struct.Fields {
+			if f.JsonName == "-" || encoding.IgnoreField(f) {
+				continue
+			}
+			key := f.Name
+			if f.JsonName != "" {
+				key = f.JsonName
+			}
+			obj = append(obj, objectField{Key: key, Value: g.valueScoped(f.Typ, f.Name, depth+1, typeArgs)})
+		}
+		return obj
+
+	default:
+		return nil
+	}
+}
+
+// resolveTypeParam resolves typ against the type arguments in scope,
+// if it is a reference to a type parameter.
+func resolveTypeParam(typ *schema.Type, typeArgs []*schema.Type) *schema.Type {
+	if ref, ok := typ.Typ.(*schema.Type_TypeParameter); ok {
+		if idx := int(ref.TypeParameter.ParamIdx); idx < len(typeArgs) {
+			return typeArgs[idx]
+		}
+	}
+	return typ
+}
+
+func (g *generator) builtin(b schema.Builtin, name string) any {
+	switch b {
+	case schema.Builtin_BOOL:
+		return g.rnd.Intn(2) == 1
+	case schema.Builtin_INT, schema.Builtin_INT8, schema.Builtin_INT16, schema.Builtin_INT32, schema.Builtin_INT64,
+		schema.Builtin_UINT, schema.Builtin_UINT8, schema.Builtin_UINT16, schema.Builtin_UINT32, schema.Builtin_UINT64:
+		return g.rnd.Intn(100)
+	case schema.Builtin_FLOAT32, schema.Builtin_FLOAT64:
+		return json.Number(fmt.Sprintf("%.2f", g.rnd.Float64()*100))
+	case schema.Builtin_STRING:
+		if name == "" {
+			name = "string"
+		}
+		return fmt.Sprintf("%s-%d", name, g.rnd.Intn(1000))
+	case schema.Builtin_BYTES:
+		return []byte(fmt.Sprintf("%s-%d", name, g.rnd.Intn(1000)))
+	case schema.Builtin_TIME:
+		return epoch.Add(time.Duration(g.rnd.Intn(365*24)) * time.Hour).Format(time.RFC3339)
+	case schema.Builtin_UUID:
+		var u [16]byte
+		g.rnd.Read(u[:])
+		u[6] = (u[6] & 0x0f) | 0x40 // version 4
+		u[8] = (u[8] & 0x3f) | 0x80 // variant 10
+		return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:])
+	case schema.Builtin_USER_ID:
+		return fmt.Sprintf("user-%d", g.rnd.Intn(1000))
+	case schema.Builtin_JSON, schema.Builtin_ANY:
+		return orderedObject{}
+	default:
+		return nil
+	}
+}
+
+// orderedObject is a JSON object that preserves the order of its fields,
+// so that generated responses follow the order of the struct definitions.
+type orderedObject []objectField
+
+type objectField struct {
+	Key   string
+	Value any
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(f.Key)
+		if err != nil {
+			return nil, err
+		}
+		val, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,350 @@
+// Package mock implements a mock API server for Encore apps.
+//
+// The mock server serves every endpoint described by an app's metadata,
+// validating incoming requests against the request schemas and responding
+// with either user-provided fixtures or example data synthesized from
+// the response schemas. It is useful for frontend development when running
+// the real backend is not possible or desirable.
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"encore.dev/beta/errs"
+	"encr.dev/parser/encoding"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// Server is a mock API server. It implements http.Handler.
+type Server struct {
+	md          *meta.Data
+	fixturesDir string
+	log         zerolog.Logger
+	auth        *encoding.AuthEncoding
+	endpoints   []*endpoint
+}
+
+// endpoint describes a single API endpoint served by the mock server.
+type endpoint struct {
+	svc *meta.Service
+	rpc *meta.RPC
+	enc *encoding.RPCEncoding
+
+	// versions are the sorted versions of the API, for versioned endpoints.
+	// Different versions of the same API share the same path.
+	versions []int
+}
+
+// selected reports whether the endpoint is the version of its API selected
+// when calling it without a version prefix, given the requested version
+// from the X-API-Version header (or 0 if not specified).
+//
+// It matches the runtime's behavior: the latest version less than or equal
+// to the requested version is used, defaulting to the first version.
+func (ep *endpoint) selected(requested int) bool {
+	if ep.rpc.Version == 0 {
+		return true
+	} else if requested == 0 {
+		return int(ep.rpc.Version) == ep.versions[0]
+	}
+	for i := len(ep.versions) - 1; i >= 0; i-- {
+		if v := ep.versions[i]; v <= requested {
+			return int(ep.rpc.Version) == v
+		}
+	}
+	return false
+}
+
+// NewServer creates a new mock server for the app described by md.
+//
+// If fixturesDir is non-empty, responses are read from the file
+// "<fixturesDir>/<service>/<Endpoint>.json" when it exists,
+// and synthesized from the response schema otherwise.
+func NewServer(md *meta.Data, fixturesDir string, log zerolog.Logger) (*Server, error) {
+	s := &Server{
+		md:          md,
+		fixturesDir: fixturesDir,
+		log:         log,
+	}
+
+	if md.AuthHandler != nil {
+		auth, err := encoding.DescribeAuth(md, md.AuthHandler.Params, nil)
+		if err != nil {
+			return nil, fmt.Errorf("describe auth handler: %v", err)
+		}
+		s.auth = auth
+	}
+
+	for _, svc := range md.Svcs {
+		for _, rpc := range svc.Rpcs {
+			// Private endpoints can't be called from outside the app.
+			if rpc.AccessType == meta.RPC_PRIVATE {
+				continue
+			}
+			enc, err := encoding.DescribeRPC(md, rpc, nil)
+			if err != nil {
+				return nil, fmt.Errorf("describe endpoint %s.%s: %v", svc.Name, rpc.Name, err)
+			}
+			s.endpoints = append(s.endpoints, &endpoint{svc: svc, rpc: rpc, enc: enc})
+		}
+	}
+
+	// Compute the versions of each versioned API.
+	versions := make(map[string][]int)
+	apiKey := func(rpc *meta.RPC) string {
+		return strings.Join(rpc.HttpMethods, ",") + " " + pathKey(rpc.Path)
+	}
+	for _, ep := range s.endpoints {
+		if v := ep.rpc.Version; v > 0 {
+			key := apiKey(ep.rpc)
+			versions[key] = append(versions[key], int(v))
+		}
+	}
+	for _, ep := range s.endpoints {
+		if ep.rpc.Version > 0 {
+			ep.versions = versions[apiKey(ep.rpc)]
+			sort.Ints(ep.versions)
+		}
+	}
+	return s, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Allow the mock server to be used directly from frontends
+	// running on a different origin.
+	h := w.Header()
+	if origin := req.Header.Get("Origin"); origin != "" {
+		h.Set("Access-Control-Allow-Origin", origin)
+		h.Set("Access-Control-Allow-Credentials", "true")
+		h.Add("Vary", "Origin")
+	}
+	if req.Method == http.MethodOptions && req.Header.Get("Access-Control-Request-Method") != "" {
+		h.Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS")
+		if hdrs := req.Header.Get("Access-Control-Request-Headers"); hdrs != "" {
+			h.Set("Access-Control-Allow-Headers", hdrs)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var version int
+	if hdr := req.Header.Get("X-API-Version"); hdr != "" {
+		v, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(hdr), "v"))
+		if err != nil || v < 1 {
+			errs.HTTPError(w, errs.B().Code(errs.InvalidArgument).Msgf("invalid X-API-Version header: %q", hdr).Err())
+			return
+		}
+		version = v
+	}
+
+	ep, pathParams := s.match(req.Method, req.URL.Path, version)
+	if ep == nil {
+		s.log.Info().Str("method", req.Method).Str("path", req.URL.Path).Msg("no matching endpoint")
+		errs.HTTPError(w, errs.B().Code(errs.NotFound).Msgf("no endpoint found for %s %s", req.Method, req.URL.Path).Err())
+		return
+	}
+
+	logger := s.log.With().Str("service", ep.svc.Name).Str("endpoint", ep.rpc.Name).Logger()
+	if err := s.validateRequest(ep, req, pathParams); err != nil {
+		logger.Info().Err(err).Msg("invalid request")
+		errs.HTTPError(w, err)
+		return
+	}
+
+	status, err := s.writeResponse(w, ep)
+	if err != nil {
+		logger.Error().Err(err).Msg("could not write response")
+		return
+	}
+	logger.Info().Str("method", req.Method).Str("path", req.URL.Path).Int("code", status).Msg("served mock response")
+}
+
+// match finds the endpoint matching the given method and path.
+// If multiple endpoints match, the one with the most literal segments wins.
+// Versioned endpoints match either with their version as a path prefix,
+// or without it if they are the version selected by the requested version.
+// It reports nil if no endpoint matches.
+func (s *Server) match(method, path string, version int) (*endpoint, []string) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	var (
+		best         *endpoint
+		bestParams   []string
+		bestLiterals = -1
+	)
+	for _, ep := range s.endpoints {
+		if !hasMethod(ep.rpc.HttpMethods, method) {
+			continue
+		}
+
+		var (
+			params   []string
+			literals int
+			ok       bool
+		)
+		if v := ep.rpc.Version; v > 0 && parts[0] == "v"+strconv.Itoa(int(v)) {
+			params, literals, ok = matchPath(ep.rpc.Path, parts[1:])
+			literals++
+		} else if ep.selected(version) {
+			params, literals, ok = matchPath(ep.rpc.Path, parts)
+		}
+		if ok && literals > bestLiterals {
+			best, bestParams, bestLiterals = ep, params, literals
+		}
+	}
+	return best, bestParams
+}
+
+// pathKey returns a string representation of path.
+func pathKey(path *meta.Path) string {
+	var b strings.Builder
+	for _, seg := range path.GetSegments() {
+		b.WriteByte('/')
+		switch seg.Type {
+		case meta.PathSegment_PARAM:
+			b.WriteByte(':')
+		case meta.PathSegment_WILDCARD:
+			b.WriteByte('*')
+		}
+		b.WriteString(seg.Value)
+	}
+	return b.String()
+}
+
+// matchPath reports whether the path segments match the given path,
+// along with the values of the path parameters and the number of literal segments.
+func matchPath(path *meta.Path, parts []string) (params []string, literals int, ok bool) {
+	segs := path.GetSegments()
+	for i, seg := range segs {
+		switch seg.Type {
+		case meta.PathSegment_LITERAL:
+			if i >= len(parts) || parts[i] != seg.Value {
+				return nil, 0, false
+			}
+			literals++
+		case meta.PathSegment_PARAM:
+			if i >= len(parts) || parts[i] == "" {
+				return nil, 0, false
+			}
+			params = append(params, parts[i])
+		case meta.PathSegment_WILDCARD:
+			if i > len(parts) {
+				return nil, 0, false
+			}
+			params = append(params, strings.Join(parts[i:], "/"))
+			return params, literals, true
+		}
+	}
+	if len(parts) != len(segs) {
+		return nil, 0, false
+	}
+	return params, literals, true
+}
+
+func hasMethod(methods []string, method string) bool {
+	for _, m := range methods {
+		if m == "*" || strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	// HEAD requests are served by GET endpoints.
+	if method == http.MethodHead {
+		return hasMethod(methods, http.MethodGet)
+	}
+	return false
+}
+
+// writeResponse writes the mock response for ep to w.
+// It reports the HTTP status code written.
+func (s *Server) writeResponse(w http.ResponseWriter, ep *endpoint) (int, error) {
+	if s.fixturesDir != "" {
+		path := filepath.Join(s.fixturesDir, ep.svc.Name, ep.rpc.Name+".json")
+		if data, err := os.ReadFile(path); err == nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, err = w.Write(data)
+			return http.StatusOK, err
+		} else if !os.IsNotExist(err) {
+			errs.HTTPError(w, errs.B().Code(errs.Internal).Msgf("could not read fixture: %v", err).Err())
+			return http.StatusInternalServerError, nil
+		}
+	}
+
+	resp := ep.enc.ResponseEncoding
+	if ep.rpc.Proto == meta.RPC_RAW || ep.rpc.ResponseSchema == nil || resp == nil {
+		w.WriteHeader(http.StatusOK)
+		return http.StatusOK, nil
+	}
+
+	g := newGenerator(s.md, ep.svc.Name+"."+ep.rpc.Name)
+	for _, p := range resp.HeaderParameters {
+		if v := g.value(p.Type, p.SrcName, 0); v != nil {
+			w.Header().Set(p.Name, formatValue(v))
+		}
+	}
+	body := make(orderedObject, 0, len(resp.BodyParameters))
+	for _, p := range resp.BodyParameters {
+		body = append(body, objectField{Key: p.Name, Value: g.value(p.Type, p.SrcName, 0)})
+	}
+
+	data, err := json.MarshalIndent(body, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(data)
+	return http.StatusOK, err
+}
+
+// formatValue formats a generated value for use in a header.
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	default:
+		data, _ := json.Marshal(v)
+		return string(bytes.Trim(data, `"`))
+	}
+}
+
+// readBody reads the request body, reporting nil if it is empty.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	data, err := io.ReadAll(io.LimitReader(req.Body, 10<<20))
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+	return data, nil
+}
+
+// seededRand returns a deterministic random source for the given key,
+// so that the same endpoint always returns the same mock data.
+func seededRand(key string) *rand.Rand {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+// epoch is the base time used for generated time values.
+var epoch = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
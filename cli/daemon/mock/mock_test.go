@@ -0,0 +1,186 @@
+package mock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/rs/zerolog"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+func builtin(b schema.Builtin) *schema.Type {
+	return &schema.Type{Typ: &schema.Type_Builtin{Builtin: b}}
+}
+
+func testMeta() *meta.Data {
+	params := &schema.Type{Typ: &schema.Type_Struct{Struct: &schema.Struct{Fields: []*schema.Field{
+		{Name: "Name", Typ: builtin(schema.Builtin_STRING)},
+		{Name: "Count", JsonName: "count", Typ: builtin(schema.Builtin_INT32), Tags: []*schema.Tag{{Key: "json", Name: "count"}}},
+		{Name: "Note", Typ: builtin(schema.Builtin_STRING), Optional: true},
+	}}}}
+	resp := &schema.Type{Typ: &schema.Type_Struct{Struct: &schema.Struct{Fields: []*schema.Field{
+		{Name: "ID", Typ: builtin(schema.Builtin_UUID)},
+		{Name: "Tags", Typ: &schema.Type{Typ: &schema.Type_List{List: &schema.List{Elem: builtin(schema.Builtin_STRING)}}}},
+	}}}}
+
+	return &meta.Data{
+		Decls: []*schema.Decl{
+			{Id: 0, Name: "Params", Type: params},
+			{Id: 1, Name: "Response", Type: resp},
+		},
+		Svcs: []*meta.Service{{
+			Name: "svc",
+			Rpcs: []*meta.RPC{
+				{
+					Name:           "Create",
+					ServiceName:    "svc",
+					AccessType:     meta.RPC_PUBLIC,
+					RequestSchema:  &schema.Type{Typ: &schema.Type_Named{Named: &schema.Named{Id: 0}}},
+					ResponseSchema: &schema.Type{Typ: &schema.Type_Named{Named: &schema.Named{Id: 1}}},
+					HttpMethods:    []string{"POST"},
+					Path: &meta.Path{Segments: []*meta.PathSegment{
+						{Type: meta.PathSegment_LITERAL, Value: "items"},
+					}},
+				},
+				{
+					Name:           "Get",
+					ServiceName:    "svc",
+					AccessType:     meta.RPC_PUBLIC,
+					ResponseSchema: &schema.Type{Typ: &schema.Type_Named{Named: &schema.Named{Id: 1}}},
+					HttpMethods:    []string{"GET"},
+					Path: &meta.Path{Segments: []*meta.PathSegment{
+						{Type: meta.PathSegment_LITERAL, Value: "items"},
+						{Type: meta.PathSegment_PARAM, Value: "id", ValueType: meta.PathSegment_INT},
+					}},
+				},
+				{
+					Name:        "Private",
+					ServiceName: "svc",
+					AccessType:  meta.RPC_AUTH,
+					HttpMethods: []string{"GET"},
+					Path: &meta.Path{Segments: []*meta.PathSegment{
+						{Type: meta.PathSegment_LITERAL, Value: "private"},
+					}},
+				},
+				{
+					Name:        "Internal",
+					ServiceName: "svc",
+					AccessType:  meta.RPC_PRIVATE,
+					HttpMethods: []string{"GET"},
+					Path: &meta.Path{Segments: []*meta.PathSegment{
+						{Type: meta.PathSegment_LITERAL, Value: "internal"},
+					}},
+				},
+				{
+					Name:        "Status",
+					ServiceName: "svc",
+					AccessType:  meta.RPC_PUBLIC,
+					HttpMethods: []string{"GET"},
+					Version:     1,
+					Path: &meta.Path{Segments: []*meta.PathSegment{
+						{Type: meta.PathSegment_LITERAL, Value: "status"},
+					}},
+				},
+				{
+					Name:        "StatusV2",
+					ServiceName: "svc",
+					AccessType:  meta.RPC_PUBLIC,
+					HttpMethods: []string{"GET"},
+					Version:     2,
+					Path: &meta.Path{Segments: []*meta.PathSegment{
+						{Type: meta.PathSegment_LITERAL, Value: "status"},
+					}},
+				},
+			},
+		}},
+	}
+}
+
+func TestServer(t *testing.T) {
+	c := qt.New(t)
+	fixtures := c.TempDir()
+	c.Assert(os.MkdirAll(filepath.Join(fixtures, "svc"), 0755), qt.IsNil)
+	c.Assert(os.WriteFile(filepath.Join(fixtures, "svc", "Create.json"), []byte(`{"ID":"fixture"}`), 0644), qt.IsNil)
+	c.Assert(os.WriteFile(filepath.Join(fixtures, "svc", "Status.json"), []byte(`{"version":1}`), 0644), qt.IsNil)
+	c.Assert(os.WriteFile(filepath.Join(fixtures, "svc", "StatusV2.json"), []byte(`{"version":2}`), 0644), qt.IsNil)
+
+	srv, err := NewServer(testMeta(), fixtures, zerolog.Nop())
+	c.Assert(err, qt.IsNil)
+
+	tests := []struct {
+		method, path, body string
+		header             http.Header
+		wantCode           int
+		wantBody           string // if non-empty
+	}{
+		{method: "POST", path: "/items", body: `{"Name": "foo", "count": 5}`, wantCode: 200, wantBody: `{"ID":"fixture"}`},
+		{method: "POST", path: "/items", body: `{"Name": 5}`, wantCode: 400},
+		{method: "POST", path: "/items", body: `{"count": 1.5}`, wantCode: 400},
+		{method: "POST", path: "/items", body: `{"Name": "foo", "count": 1000000000000}`, wantCode: 400},
+		{method: "POST", path: "/items", body: `{"Name": "foo"}`, wantCode: 400},
+		{method: "POST", path: "/items", body: `{"Name": "foo", "count": 5, "Note": "bar"}`, wantCode: 200},
+		{method: "POST", path: "/items", wantCode: 400},
+		{method: "GET", path: "/items/5", wantCode: 200},
+		{method: "GET", path: "/items/foo", wantCode: 400},
+		{method: "GET", path: "/items/5/bar", wantCode: 404},
+		{method: "DELETE", path: "/items/5", wantCode: 404},
+		{method: "GET", path: "/private", wantCode: 401},
+		{method: "GET", path: "/private", header: http.Header{"Authorization": {"Bearer token"}}, wantCode: 200},
+		{method: "GET", path: "/internal", wantCode: 404},
+		{method: "GET", path: "/status", wantCode: 200, wantBody: `{"version":1}`},
+		{method: "GET", path: "/status", header: http.Header{"X-Api-Version": {"2"}}, wantCode: 200, wantBody: `{"version":2}`},
+		{method: "GET", path: "/status", header: http.Header{"X-Api-Version": {"3"}}, wantCode: 200, wantBody: `{"version":2}`},
+		{method: "GET", path: "/status", header: http.Header{"X-Api-Version": {"foo"}}, wantCode: 400},
+		{method: "GET", path: "/v1/status", wantCode: 200, wantBody: `{"version":1}`},
+		{method: "GET", path: "/v2/status", wantCode: 200, wantBody: `{"version":2}`},
+		{method: "GET", path: "/v3/status", wantCode: 404},
+	}
+
+	for _, test := range tests {
+		c.Run(test.method+" "+test.path, func(c *qt.C) {
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			for k, v := range test.header {
+				req.Header[k] = v
+			}
+			w := httptest.NewRecorder()
+			srv.ServeHTTP(w, req)
+			c.Assert(w.Code, qt.Equals, test.wantCode, qt.Commentf("body: %s", w.Body.String()))
+			if test.wantBody != "" {
+				c.Assert(w.Body.String(), qt.Equals, test.wantBody)
+			}
+		})
+	}
+}
+
+func TestGeneratedResponse(t *testing.T) {
+	c := qt.New(t)
+	srv, err := NewServer(testMeta(), "", zerolog.Nop())
+	c.Assert(err, qt.IsNil)
+
+	get := func() string {
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, httptest.NewRequest("GET", "/items/1", nil))
+		c.Assert(w.Code, qt.Equals, 200)
+		return w.Body.String()
+	}
+
+	body := get()
+	var resp struct {
+		ID   string
+		Tags []string
+	}
+	c.Assert(json.Unmarshal([]byte(body), &resp), qt.IsNil)
+	c.Assert(resp.ID, qt.HasLen, 36)
+	c.Assert(len(resp.Tags) > 0, qt.IsTrue)
+
+	// Generated responses are deterministic.
+	c.Assert(get(), qt.Equals, body)
+}
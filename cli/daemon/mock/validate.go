@@ -0,0 +1,407 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"encore.dev/beta/errs"
+	"encr.dev/parser/encoding"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+// validateRequest validates the request against the endpoint's request schema.
+// The pathParams are the values of the endpoint's path parameters, in order.
+func (s *Server) validateRequest(ep *endpoint, req *http.Request, pathParams []string) error {
+	if err := validatePathParams(ep.rpc.Path, pathParams); err != nil {
+		return err
+	}
+
+	if ep.rpc.AccessType == meta.RPC_AUTH && !s.hasAuth(req) {
+		return errs.B().Code(errs.Unauthenticated).Msg("endpoint requires auth but none provided").Err()
+	}
+
+	// Raw endpoints receive the request as-is.
+	if ep.rpc.Proto == meta.RPC_RAW || ep.rpc.RequestSchema == nil {
+		return nil
+	}
+
+	method := req.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	enc := ep.enc.RequestEncodingForMethod(method)
+	if enc == nil {
+		return errs.B().Code(errs.InvalidArgument).Msgf("method %s not supported", req.Method).Err()
+	}
+
+	// required reports whether the request field for p must be present.
+	optional := optionalFields(s.md, ep.rpc.RequestSchema)
+	required := func(p *encoding.ParameterEncoding) bool {
+		opt, ok := optional[p.SrcName]
+		return ok && !opt
+	}
+
+	v := &validator{md: s.md}
+	for _, p := range enc.HeaderParameters {
+		if val := req.Header.Get(p.Name); val != "" {
+			if err := v.validateString(p.Type, val); err != nil {
+				return invalidArg("header %s: %v", p.Name, err)
+			}
+		} else if required(p) {
+			return invalidArg("missing required header %s", p.Name)
+		}
+	}
+	query := req.URL.Query()
+	for _, p := range enc.QueryParameters {
+		vals := query[p.Name]
+		if len(vals) == 0 && required(p) {
+			return invalidArg("missing required query parameter %s", p.Name)
+		}
+		for _, val := range vals {
+			if err := v.validateString(p.Type, val); err != nil {
+				return invalidArg("query parameter %s: %v", p.Name, err)
+			}
+		}
+	}
+
+	if len(enc.BodyParameters) == 0 {
+		return nil
+	}
+	data, err := readBody(req)
+	if err != nil {
+		return invalidArg("could not read request body: %v", err)
+	}
+
+	var body map[string]any
+	if data != nil {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&body); err != nil {
+			return invalidArg("invalid JSON body: %v", err)
+		}
+	}
+	for _, p := range enc.BodyParameters {
+		if val, ok := body[p.Name]; ok {
+			if err := v.validate(p.Type, val, nil); err != nil {
+				return invalidArg("field %s: %v", p.Name, err)
+			}
+		} else if required(p) {
+			return invalidArg("missing required field %s", p.Name)
+		}
+	}
+	return nil
+}
+
+// optionalFields returns the set of optional fields of the request struct,
+// keyed by field name.
+func optionalFields(md *meta.Data, typ *schema.Type) map[string]bool {
+	st, err := encoding.GetConcreteStructType(md.Decls, typ, nil)
+	if err != nil {
+		return nil
+	}
+	optional := make(map[string]bool, len(st.Fields))
+	for _, f := range st.Fields {
+		optional[f.Name] = f.Optional
+	}
+	return optional
+}
+
+// hasAuth reports whether the request contains any authentication data
+// understood by the app's auth handler.
+func (s *Server) hasAuth(req *http.Request) bool {
+	if s.auth == nil || s.auth.LegacyTokenFormat {
+		return strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ")
+	}
+	for _, p := range s.auth.HeaderParameters {
+		if req.Header.Get(p.Name) != "" {
+			return true
+		}
+	}
+	query := req.URL.Query()
+	for _, p := range s.auth.QueryParameters {
+		if query.Get(p.Name) != "" {
+			return true
+		}
+	}
+	for _, p := range s.auth.CookieParameters {
+		if c, err := req.Cookie(p.Name); err == nil && c.Value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// validatePathParams validates the path parameter values against the path's parameter types.
+func validatePathParams(path *meta.Path, values []string) error {
+	idx := 0
+	for _, seg := range path.GetSegments() {
+		if seg.Type == meta.PathSegment_LITERAL {
+			continue
+		} else if idx >= len(values) {
+			break
+		}
+		val := values[idx]
+		idx++
+
+		var err error
+		switch seg.ValueType {
+		case meta.PathSegment_BOOL:
+			_, err = strconv.ParseBool(val)
+		case meta.PathSegment_INT8:
+			_, err = strconv.ParseInt(val, 10, 8)
+		case meta.PathSegment_INT16:
+			_, err = strconv.ParseInt(val, 10, 16)
+		case meta.PathSegment_INT32:
+			_, err = strconv.ParseInt(val, 10, 32)
+		case meta.PathSegment_INT64, meta.PathSegment_INT:
+			_, err = strconv.ParseInt(val, 10, 64)
+		case meta.PathSegment_UINT8:
+			_, err = strconv.ParseUint(val, 10, 8)
+		case meta.PathSegment_UINT16:
+			_, err = strconv.ParseUint(val, 10, 16)
+		case meta.PathSegment_UINT32:
+			_, err = strconv.ParseUint(val, 10, 32)
+		case meta.PathSegment_UINT64, meta.PathSegment_UINT:
+			_, err = strconv.ParseUint(val, 10, 64)
+		case meta.PathSegment_UUID:
+			_, err = uuid.FromString(val)
+		}
+		if err != nil {
+			return invalidArg("invalid path parameter %s: %q is not a valid %s",
+				seg.Value, val, strings.ToLower(seg.ValueType.String()))
+		}
+	}
+	return nil
+}
+
+// validator validates decoded JSON values against schema types.
+type validator struct {
+	md *meta.Data
+}
+
+// validate validates the decoded JSON value val against typ.
+// The typeArgs are the type arguments in scope, for resolving type parameters.
+func (v *validator) validate(typ *schema.Type, val any, typeArgs []*schema.Type) error {
+	if typ == nil || val == nil {
+		// Null is valid for any type; the app decodes it as the zero value.
+		return nil
+	}
+
+	switch t := typ.Typ.(type) {
+	case *schema.Type_Builtin:
+		return v.validateBuiltin(t.Builtin, val)
+
+	case *schema.Type_Named:
+		decl := v.md.Decls[t.Named.Id]
+		args := make([]*schema.Type, len(t.Named.TypeArguments))
+		for i, arg := range t.Named.TypeArguments {
+			args[i] = resolveTypeParam(arg, typeArgs)
+		}
+		return v.validate(decl.Type, val, args)
+
+	case *schema.Type_TypeParameter:
+		if idx := int(t.TypeParameter.ParamIdx); idx < len(typeArgs) {
+			return v.validate(typeArgs[idx], val, nil)
+		}
+		return nil
+
+	case *schema.Type_Pointer:
+		return v.validate(t.Pointer.Base, val, typeArgs)
+
+	case *schema.Type_Config:
+		return v.validate(t.Config.Elem, val, typeArgs)
+
+	case *schema.Type_List:
+		if b, ok := t.List.Elem.Typ.(*schema.Type_Builtin); ok && b.Builtin == schema.Builtin_UINT8 {
+			return v.validateBuiltin(schema.Builtin_BYTES, val)
+		}
+		list, ok := val.([]any)
+		if !ok {
+			return fmt.Errorf("expected array, got %s", jsonKind(val))
+		}
+		for i, elem := range list {
+			if err := v.validate(t.List.Elem, elem, typeArgs); err != nil {
+				return fmt.Errorf("[%d]: %v", i, err)
+			}
+		}
+		return nil
+
+	case *schema.Type_Map:
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return fmt.Errorf("expected object, got %s", jsonKind(val))
+		}
+		for key, elem := range obj {
+			if err := v.validateString(resolveTypeParam(t.Map.Key, typeArgs), key); err != nil {
+				return fmt.Errorf("key %q: %v", key, err)
+			}
+			if err := v.validate(t.Map.Value, elem, typeArgs); err != nil {
+				return fmt.Errorf("[%q]: %v", key, err)
+			}
+		}
+		return nil
+
+	case *schema.Type_Struct:
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return fmt.Errorf("expected object, got %s", jsonKind(val))
+		}
+		for _, f := range t.Struct.Fields {
+			if f.JsonName == "-" || encoding.IgnoreField(f) {
+				continue
+			}
+			key := f.Name
+			if f.JsonName != "" {
+				key = f.JsonName
+			}
+			if fv, ok := obj[key]; ok {
+				if err := v.validate(f.Typ, fv, typeArgs); err != nil {
+					return fmt.Errorf("%s: %v", key, err)
+				}
+			} else if !f.Optional {
+				return fmt.Errorf("missing required field %s", key)
+			}
+		}
+		return nil
+
+	default:
+		return nil
+	}
+}
+
+// validateString validates a string value, such as a header or query parameter, against typ.
+func (v *validator) validateString(typ *schema.Type, val string) error {
+	switch t := typ.Typ.(type) {
+	case *schema.Type_Builtin:
+		switch t.Builtin {
+		case schema.Builtin_STRING, schema.Builtin_BYTES, schema.Builtin_TIME, schema.Builtin_UUID,
+			schema.Builtin_USER_ID, schema.Builtin_JSON, schema.Builtin_ANY:
+			return v.validateBuiltin(t.Builtin, val)
+		case schema.Builtin_BOOL:
+			if _, err := strconv.ParseBool(val); err != nil {
+				return fmt.Errorf("%q is not a valid bool", val)
+			}
+			return nil
+		default:
+			return v.validateBuiltin(t.Builtin, json.Number(val))
+		}
+	case *schema.Type_Named:
+		return v.validateString(v.md.Decls[t.Named.Id].Type, val)
+	case *schema.Type_Pointer:
+		return v.validateString(t.Pointer.Base, val)
+	case *schema.Type_List:
+		return v.validateString(t.List.Elem, val)
+	default:
+		return nil
+	}
+}
+
+func (v *validator) validateBuiltin(b schema.Builtin, val any) error {
+	kind := strings.ToLower(b.String())
+	switch b {
+	case schema.Builtin_ANY, schema.Builtin_JSON:
+		return nil
+
+	case schema.Builtin_BOOL:
+		if _, ok := val.(bool); !ok {
+			return fmt.Errorf("expected bool, got %s", jsonKind(val))
+		}
+		return nil
+
+	case schema.Builtin_INT, schema.Builtin_INT8, schema.Builtin_INT16, schema.Builtin_INT32, schema.Builtin_INT64:
+		num, ok := val.(json.Number)
+		if !ok {
+			return fmt.Errorf("expected %s, got %s", kind, jsonKind(val))
+		}
+		if _, err := strconv.ParseInt(string(num), 10, bitSize(b)); err != nil {
+			return fmt.Errorf("%s is not a valid %s", num, kind)
+		}
+		return nil
+
+	case schema.Builtin_UINT, schema.Builtin_UINT8, schema.Builtin_UINT16, schema.Builtin_UINT32, schema.Builtin_UINT64:
+		num, ok := val.(json.Number)
+		if !ok {
+			return fmt.Errorf("expected %s, got %s", kind, jsonKind(val))
+		}
+		if _, err := strconv.ParseUint(string(num), 10, bitSize(b)); err != nil {
+			return fmt.Errorf("%s is not a valid %s", num, kind)
+		}
+		return nil
+
+	case schema.Builtin_FLOAT32, schema.Builtin_FLOAT64:
+		num, ok := val.(json.Number)
+		if !ok {
+			return fmt.Errorf("expected %s, got %s", kind, jsonKind(val))
+		}
+		if _, err := strconv.ParseFloat(string(num), bitSize(b)); err != nil {
+			return fmt.Errorf("%s is not a valid %s", num, kind)
+		}
+		return nil
+	}
+
+	// The remaining builtins are all encoded as strings.
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("expected %s, got %s", kind, jsonKind(val))
+	}
+	var err error
+	switch b {
+	case schema.Builtin_BYTES:
+		_, err = base64.StdEncoding.DecodeString(str)
+	case schema.Builtin_TIME:
+		_, err = time.Parse(time.RFC3339, str)
+	case schema.Builtin_UUID:
+		_, err = uuid.FromString(str)
+	}
+	if err != nil {
+		return fmt.Errorf("%q is not a valid %s", str, kind)
+	}
+	return nil
+}
+
+// bitSize reports the bit size of the given numeric builtin.
+func bitSize(b schema.Builtin) int {
+	switch b {
+	case schema.Builtin_INT8, schema.Builtin_UINT8:
+		return 8
+	case schema.Builtin_INT16, schema.Builtin_UINT16:
+		return 16
+	case schema.Builtin_INT32, schema.Builtin_UINT32, schema.Builtin_FLOAT32:
+		return 32
+	default:
+		return 64
+	}
+}
+
+// jsonKind describes the kind of a decoded JSON value, for use in error messages.
+func jsonKind(val any) string {
+	switch val.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", val)
+	}
+}
+
+func invalidArg(format string, args ...any) error {
+	return errs.B().Code(errs.InvalidArgument).Msgf(format, args...).Err()
+}
@@ -1,6 +1,7 @@
 package run
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -186,27 +187,11 @@ func (mgr *Manager) generateConfig(p generateConfigParams) (*config.Runtime, err
 		sqlDBs     []*config.SQLDatabase
 	)
 	if cluster := p.RS.GetSQLCluster(); cluster != nil {
-		srv := &config.SQLServer{
-			Host: "localhost:" + strconv.Itoa(mgr.DBProxyPort),
-		}
-		sqlServers = append(sqlServers, srv)
-
-		for _, svc := range p.Meta.Svcs {
-			if len(svc.Migrations) > 0 {
-				sqlDBs = append(sqlDBs, &config.SQLDatabase{
-					EncoreName:   svc.Name,
-					DatabaseName: svc.Name,
-					User:         "encore",
-					Password:     cluster.Password,
-				})
-			}
-		}
-
-		// Configure max connections based on 96 connections
-		// divided evenly among the databases
-		maxConns := 96 / len(sqlDBs)
-		for _, db := range sqlDBs {
-			db.MaxConnections = maxConns
+		var err error
+		proxyHost := "localhost:" + strconv.Itoa(mgr.DBProxyPort)
+		sqlServers, sqlDBs, err = cluster.RuntimeConfig(context.Background(), p.Meta, proxyHost)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to configure sql databases")
 		}
 	}
 
@@ -276,6 +261,16 @@ func (mgr *Manager) generateConfig(p generateConfigParams) (*config.Runtime, err
 		return nil, errors.Wrap(err, "failed to get global CORS")
 	}
 
+	var compression *config.Compression
+	if c, err := p.App.Compression(); err != nil {
+		return nil, errors.Wrap(err, "failed to get compression config")
+	} else if c != nil {
+		compression = &config.Compression{
+			Encodings: c.Encodings,
+			MinSize:   c.MinSize,
+		}
+	}
+
 	return &config.Runtime{
 		AppID:           p.ConfigAppID,
 		AppSlug:         p.App.PlatformID(),
@@ -306,5 +301,6 @@ func (mgr *Manager) generateConfig(p generateConfigParams) (*config.Runtime, err
 			ExtraExposedHeaders:            globalCORS.ExposeHeaders,
 			AllowPrivateNetworkAccess:      true,
 		},
+		Compression: compression,
 	}, nil
 }
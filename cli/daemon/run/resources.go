@@ -60,7 +60,7 @@ type ResourceServer interface {
 // if they are not already running based on the given parse result
 func (rs *ResourceServices) StartRequiredServices(a *AsyncBuildJobs, md *meta.Data) error {
 	if sqldb.IsUsed(md) && rs.GetSQLCluster() == nil {
-		a.Go("Creating PostgreSQL database cluster", true, 300*time.Millisecond, rs.StartSQLCluster(a, md))
+		a.Go("Creating SQL database cluster", true, 300*time.Millisecond, rs.StartSQLCluster(a, md))
 	}
 
 	if pubsub.IsUsed(md) && rs.GetPubSub() == nil {
@@ -135,28 +135,35 @@ func (rs *ResourceServices) StartSQLCluster(a *AsyncBuildJobs, md *meta.Data) fu
 			ClusterID: sqldb.GetClusterID(rs.app, sqldb.Run),
 			Memfs:     false,
 		})
-		if _, err := exec.LookPath("docker"); err != nil {
-			return errors.New("This application requires docker to run since it uses an SQL database. Install docker first.")
-		} else if !isDockerRunning(ctx) {
-			return errors.New("The docker daemon is not running. Start it first.")
+		// SQLite databases need no container, so only require docker
+		// when the app uses other database engines.
+		engines := sqldb.EnginesUsed(md)
+		if engines[sqldb.Postgres] || engines[sqldb.MySQL] {
+			if _, err := exec.LookPath("docker"); err != nil {
+				return errors.New("This application requires docker to run since it uses an SQL database. Install docker first.")
+			} else if !isDockerRunning(ctx) {
+				return errors.New("The docker daemon is not running. Start it first.")
+			}
 		}
 
-		log.Debug().Msg("checking if sqldb image exists")
-		if ok, err := docker.ImageExists(ctx); err == nil && !ok {
-			rs.log.Debug().Msg("pulling sqldb image")
-			pullOp := a.tracker.Add("Pulling PostgreSQL docker image", time.Now())
-			if err := docker.PullImage(ctx); err != nil {
-				rs.log.Error().Err(err).Msg("unable to pull sqldb image")
-				a.tracker.Fail(pullOp, err)
-			} else {
-				a.tracker.Done(pullOp, 0)
-				rs.log.Info().Msg("successfully pulled sqldb image")
+		if engines[sqldb.Postgres] {
+			log.Debug().Msg("checking if sqldb image exists")
+			if ok, err := docker.ImageExists(ctx); err == nil && !ok {
+				rs.log.Debug().Msg("pulling sqldb image")
+				pullOp := a.tracker.Add("Pulling PostgreSQL docker image", time.Now())
+				if err := docker.PullImage(ctx); err != nil {
+					rs.log.Error().Err(err).Msg("unable to pull sqldb image")
+					a.tracker.Fail(pullOp, err)
+				} else {
+					a.tracker.Done(pullOp, 0)
+					rs.log.Info().Msg("successfully pulled sqldb image")
+				}
+			} else if err != nil {
+				return fmt.Errorf("unable to check if sqldb image exists: %w", err)
 			}
-		} else if err != nil {
-			return fmt.Errorf("unable to check if sqldb image exists: %w", err)
 		}
 
-		if _, err := cluster.Start(ctx); err != nil {
+		if err := cluster.StartFor(ctx, md); err != nil {
 			return fmt.Errorf("unable to start sqldb cluster: %w", err)
 		}
 		rs.mutex.Lock()
@@ -334,6 +334,22 @@ func (r *Run) buildAndStart(ctx context.Context, tracker *optracker.OpTracker) e
 		return err
 	}
 
+	// Run the seed programs of databases that were just set up from scratch,
+	// now that the databases are ready.
+	if cluster := r.ResourceServers.GetSQLCluster(); cluster != nil {
+		err := r.Mgr.RunSeedPrograms(ctx, SeedProgramsParams{
+			App:     r.App,
+			Meta:    parse.Meta,
+			Cluster: cluster,
+			Environ: r.params.Environ,
+			Stdout:  newLogWriter(r, r.Mgr.RunStdout),
+			Stderr:  newLogWriter(r, r.Mgr.RunStderr),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	startOp := tracker.Add("Starting Encore application", start)
 	newProcess, err := r.StartProc(&StartProcParams{
 		Ctx:            ctx,
@@ -0,0 +1,96 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/sqldb"
+	"encr.dev/pkg/paths"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// SeedProgramsParams groups the parameters for the RunSeedPrograms method.
+type SeedProgramsParams struct {
+	// App is the app to run the seed programs of.
+	App *apps.Instance
+
+	// Meta is the metadata of the app.
+	Meta *meta.Data
+
+	// Cluster is the cluster containing the databases to seed.
+	Cluster *sqldb.Cluster
+
+	// Services are the services whose databases to seed.
+	// If nil all databases are seeded.
+	Services []string
+
+	// Force runs the seed programs even if they have already run.
+	// Otherwise they only run for databases that were set up from scratch.
+	Force bool
+
+	// Environ are the environment variables to set when running the seed programs,
+	// in the same format as os.Environ().
+	Environ []string
+
+	// Stdout and Stderr are where the seed programs' output should be written.
+	Stdout, Stderr io.Writer
+}
+
+// RunSeedPrograms runs the Go seed programs of the app's databases,
+// after the databases have been set up and their seed scripts have run.
+// A seed program is the main package in the "seed" directory of a service,
+// and runs like a script run with "encore exec".
+//
+// The seed programs can't run while setting up the databases,
+// as they connect to the databases through the proxy, which waits for
+// the databases to be set up.
+func (mgr *Manager) RunSeedPrograms(ctx context.Context, p SeedProgramsParams) error {
+	var filter map[string]bool
+	if p.Services != nil {
+		filter = make(map[string]bool)
+		for _, svc := range p.Services {
+			filter[svc] = true
+		}
+	}
+
+	for _, svc := range p.Meta.Svcs {
+		if len(svc.Migrations) == 0 || (filter != nil && !filter[svc.Name]) {
+			continue
+		}
+		if ok, err := sqldb.HasSeedProgram(p.App.Root(), svc); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+		db, ok := p.Cluster.GetDB(svc.Name)
+		if !ok {
+			continue
+		}
+		if !p.Force {
+			if pending, err := db.SeedProgramPending(ctx); err != nil {
+				return fmt.Errorf("check seed state of db %s: %v", db.Name, err)
+			} else if !pending {
+				continue
+			}
+		}
+
+		err := mgr.ExecScript(ctx, ExecScriptParams{
+			App:        p.App,
+			MainPkg:    paths.Pkg(p.Meta.ModulePath).JoinSlash(paths.RelSlash(path.Join(svc.RelPath, "seed"))),
+			WorkingDir: ".",
+			Environ:    p.Environ,
+			Stdout:     p.Stdout,
+			Stderr:     p.Stderr,
+		})
+		if err != nil {
+			return fmt.Errorf("seed program of db %s: %v", db.Name, err)
+		}
+		if err := db.SeedProgramDone(ctx); err != nil {
+			return fmt.Errorf("seed db %s: %v", db.Name, err)
+		}
+	}
+	return nil
+}
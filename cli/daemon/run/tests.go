@@ -93,27 +93,10 @@ func (mgr *Manager) Test(ctx context.Context, params TestParams) (err error) {
 		sqlDBs     []*config.SQLDatabase
 	)
 	if params.SQLDBCluster != nil {
-		srv := &config.SQLServer{
-			Host: "localhost:" + strconv.Itoa(mgr.DBProxyPort),
-		}
-		sqlServers = append(sqlServers, srv)
-		for _, svc := range parse.Meta.Svcs {
-			if len(svc.Migrations) > 0 {
-				sqlDBs = append(sqlDBs, &config.SQLDatabase{
-					ServerID:     0,
-					EncoreName:   svc.Name,
-					DatabaseName: svc.Name,
-					User:         "encore",
-					Password:     params.SQLDBCluster.Password,
-				})
-			}
-		}
-
-		// Configure max connections based on 96 connections
-		// divided evenly among the databases
-		maxConns := 96 / len(sqlDBs)
-		for _, db := range sqlDBs {
-			db.MaxConnections = maxConns
+		proxyHost := "localhost:" + strconv.Itoa(mgr.DBProxyPort)
+		sqlServers, sqlDBs, err = params.SQLDBCluster.RuntimeConfig(ctx, parse.Meta, proxyHost)
+		if err != nil {
+			return err
 		}
 	}
 
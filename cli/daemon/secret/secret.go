@@ -1,4 +1,7 @@
 // Package secret fetches and caches development secrets for Encore apps.
+//
+// Apps with a local secret store (see package localsecret) read their
+// secrets from the store instead of the Encore platform.
 package secret
 
 import (
@@ -19,6 +22,7 @@ import (
 	"golang.org/x/sync/singleflight"
 
 	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/internal/localsecret"
 	"encr.dev/cli/internal/platform"
 	"encr.dev/pkg/experiments"
 )
@@ -50,6 +54,9 @@ type LoadResult struct {
 	mgr *Manager
 	app *apps.Instance
 
+	// local is whether the secrets are read from the app's local secret store.
+	local bool
+
 	once    syncutil.Once
 	ch      <-chan singleflight.Result
 	initial singleflight.Result
@@ -58,6 +65,11 @@ type LoadResult struct {
 // Load loads the secrets for appSlug.
 // If appSlug is empty, (*LoadResult).Get resolves to empty secret data.
 func (mgr *Manager) Load(app *apps.Instance) *LoadResult {
+	// Apps with a local secret store don't use the platform.
+	if localsecret.Exists(app.Root()) {
+		return &LoadResult{mgr: mgr, app: app, local: true}
+	}
+
 	mgr.pollOnce.Do(mgr.startPolling)
 
 	// Ignore cases when the app isn't linked.
@@ -81,7 +93,9 @@ func (lr *LoadResult) Get(ctx context.Context, expSet *experiments.Set) (data *D
 		}
 	}()
 
-	if lr == nil || lr.app.PlatformID() == "" {
+	if lr != nil && lr.local {
+		return loadLocal(lr.app)
+	} else if lr == nil || lr.app.PlatformID() == "" {
 		return &Data{}, nil
 	}
 
@@ -125,6 +139,26 @@ func (lr *LoadResult) Get(ctx context.Context, expSet *experiments.Set) (data *D
 	}
 }
 
+// loadLocal loads the secrets for local development from the app's local secret store.
+// The store is read every time, so there is no need to poll for updates.
+func loadLocal(app *apps.Instance) (*Data, error) {
+	store, err := localsecret.Open(app.Root())
+	if err != nil {
+		return nil, fmt.Errorf("load local secrets: %v", err)
+	} else if len(store.Secrets()) == 0 {
+		return &Data{Synced: time.Now()}, nil
+	}
+	id, err := localsecret.LoadIdentity()
+	if err != nil {
+		return nil, fmt.Errorf("load local secrets: %v", err)
+	}
+	values, err := store.Resolve(id, "local", "local")
+	if err != nil {
+		return nil, fmt.Errorf("load local secrets: %v", err)
+	}
+	return &Data{Synced: time.Now(), Values: values}, nil
+}
+
 // UpdateKey updates the cached secret key to the given value.
 func (mgr *Manager) UpdateKey(appSlug, key, value string) {
 	mgr.mu.Lock()
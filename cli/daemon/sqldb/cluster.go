@@ -40,6 +40,16 @@ type Cluster struct {
 
 	Roles EncoreRoles // set by Start
 
+	// mysqlOnce starts the MySQL server for MySQL databases,
+	// after which mysqlStatus holds its status.
+	mysqlOnce   syncutil.Once
+	mysqlStatus atomic.Pointer[ClusterStatus]
+
+	// sqliteOnce creates the directory for SQLite databases,
+	// after which sqlitePath holds its path.
+	sqliteOnce syncutil.Once
+	sqlitePath string
+
 	// Ctx is canceled when the cluster is being torn down.
 	Ctx    context.Context
 	cancel func() // for canceling Ctx
@@ -57,6 +67,17 @@ func (c *Cluster) Ready() <-chan struct{} {
 	return c.started
 }
 
+// isStarted reports whether the cluster has been started.
+// Clusters only containing MySQL or SQLite databases are never started.
+func (c *Cluster) isStarted() bool {
+	select {
+	case <-c.started:
+		return true
+	default:
+		return false
+	}
+}
+
 // Start creates the cluster if necessary and starts it.
 // If the cluster is already running it does nothing.
 func (c *Cluster) Start(ctx context.Context) (*ClusterStatus, error) {
@@ -192,7 +213,7 @@ func (c *Cluster) initDBs(md *meta.Data, reinit bool) {
 				db.CloseConns()
 			}
 			if !ok || reinit {
-				c.initDB(svc.Name)
+				c.initDB(svc.Name, EngineOf(svc))
 			}
 		}
 	}
@@ -201,10 +222,11 @@ func (c *Cluster) initDBs(md *meta.Data, reinit bool) {
 
 // initDB initializes the database for svc and adds it to c.dbs.
 // The cluster mutex must be held.
-func (c *Cluster) initDB(name string) *DB {
+func (c *Cluster) initDB(name string, engine Engine) *DB {
 	dbCtx, cancel := context.WithCancel(c.Ctx)
 	db := &DB{
 		Name:    name,
+		Engine:  engine,
 		Cluster: c,
 
 		Ctx:    dbCtx,
@@ -231,8 +253,8 @@ func (c *Cluster) Setup(ctx context.Context, appRoot string, md *meta.Data) erro
 
 		svc := svc
 		db, ok := c.dbs[svc.Name]
-		if !ok {
-			db = c.initDB(svc.Name)
+		if !ok || db.Engine != EngineOf(svc) {
+			db = c.initDB(svc.Name, EngineOf(svc))
 		}
 		g.Go(func() error { return db.Setup(ctx, appRoot, svc, false, false) })
 	}
@@ -253,8 +275,8 @@ func (c *Cluster) SetupAndMigrate(ctx context.Context, appRoot string, md *meta.
 
 		svc := svc
 		db, ok := c.dbs[svc.Name]
-		if !ok {
-			db = c.initDB(svc.Name)
+		if !ok || db.Engine != EngineOf(svc) {
+			db = c.initDB(svc.Name, EngineOf(svc))
 		}
 		g.Go(func() error { return db.Setup(ctx, appRoot, svc, true, false) })
 	}
@@ -288,8 +310,8 @@ func (c *Cluster) Recreate(ctx context.Context, appRoot string, services []strin
 		svc := svc
 		if len(svc.Migrations) > 0 && (filter == nil || filter[svc.Name]) {
 			db, ok := c.dbs[svc.Name]
-			if !ok {
-				db = c.initDB(svc.Name)
+			if !ok || db.Engine != EngineOf(svc) {
+				db = c.initDB(svc.Name, EngineOf(svc))
 			}
 			g.Go(func() error { return db.Setup(ctx, appRoot, svc, true, true) })
 		}
@@ -300,6 +322,37 @@ func (c *Cluster) Recreate(ctx context.Context, appRoot string, services []strin
 	return err
 }
 
+// Seed runs the seed scripts for the given services' databases,
+// creating and migrating the databases first if necessary.
+// If services is the nil slice it seeds all databases.
+func (c *Cluster) Seed(ctx context.Context, appRoot string, services []string, md *meta.Data) error {
+	c.log.Debug().Msg("seeding cluster")
+	var filter map[string]bool
+	if services != nil {
+		filter = make(map[string]bool)
+		for _, svc := range services {
+			filter[svc] = true
+		}
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	c.mu.Lock()
+	for _, svc := range md.Svcs {
+		svc := svc
+		if len(svc.Migrations) > 0 && (filter == nil || filter[svc.Name]) {
+			db, ok := c.dbs[svc.Name]
+			if !ok || db.Engine != EngineOf(svc) {
+				db = c.initDB(svc.Name, EngineOf(svc))
+			}
+			g.Go(func() error { return db.SetupAndSeed(ctx, appRoot, svc) })
+		}
+	}
+	c.mu.Unlock()
+	err := g.Wait()
+	c.log.Debug().Err(err).Msg("seeded cluster")
+	return err
+}
+
 // Status reports the cluster's status.
 func (c *Cluster) Status(ctx context.Context) (*ClusterStatus, error) {
 	if st := c.cachedStatus.Load(); st != nil {
@@ -5,12 +5,16 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"io/fs"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database"
+	mysqlmigrate "github.com/golang-migrate/migrate/v4/database/mysql"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/database/sqlite3"
 	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
 
@@ -20,6 +24,7 @@ import (
 // DB represents a single database instance within a cluster.
 type DB struct {
 	Name    string // database name
+	Engine  Engine // database engine
 	Cluster *Cluster
 
 	// Ctx is canceled when the database is being torn down.
@@ -43,7 +48,14 @@ func (db *DB) Ready() <-chan struct{} {
 }
 
 // Setup sets up the database, (re)creating it if necessary and running schema migrations.
-func (db *DB) Setup(ctx context.Context, appRoot string, svc *meta.Service, migrate, recreate bool) (err error) {
+// In run clusters, databases migrated from scratch are then seeded with the service's seed scripts.
+func (db *DB) Setup(ctx context.Context, appRoot string, svc *meta.Service, migrate, recreate bool) error {
+	_, err := db.setup(ctx, appRoot, svc, migrate, recreate)
+	return err
+}
+
+// setup is like Setup, and additionally reports whether the database was seeded.
+func (db *DB) setup(ctx context.Context, appRoot string, svc *meta.Service, migrate, recreate bool) (seeded bool, err error) {
 	db.log.Debug().Msg("setting up database")
 	db.setupMu.Lock()
 	defer db.setupMu.Unlock()
@@ -61,14 +73,17 @@ func (db *DB) Setup(ctx context.Context, appRoot string, svc *meta.Service, migr
 
 	if recreate {
 		if err := db.Drop(ctx); err != nil {
-			return fmt.Errorf("drop db %s: %v", db.Name, err)
+			return false, fmt.Errorf("drop db %s: %v", db.Name, err)
 		}
 	}
 	if err := db.Create(ctx); err != nil {
-		return fmt.Errorf("create db %s: %v", db.Name, err)
+		return false, fmt.Errorf("create db %s: %v", db.Name, err)
 	}
-	if err := db.EnsureRoles(ctx, db.Cluster.Roles...); err != nil {
-		return fmt.Errorf("ensure db roles %s: %v", db.Name, err)
+	if db.Engine == Postgres {
+		// Only PostgreSQL databases are accessed using the Encore roles.
+		if err := db.EnsureRoles(ctx, db.Cluster.Roles...); err != nil {
+			return false, fmt.Errorf("ensure db roles %s: %v", db.Name, err)
+		}
 	}
 	if migrate || recreate || !db.migrated {
 		if err := db.Migrate(ctx, appRoot, svc); err != nil {
@@ -76,17 +91,46 @@ func (db *DB) Setup(ctx context.Context, appRoot string, svc *meta.Service, migr
 			// Otherwise we might fail to open a database shell when there
 			// is a migration issue.
 			if migrate || recreate {
-				return fmt.Errorf("migrate db %s: %v", db.Name, err)
+				return false, fmt.Errorf("migrate db %s: %v", db.Name, err)
+			}
+		} else if db.hasTemplate() {
+			if err := db.CreateTemplate(ctx); err != nil {
+				return false, fmt.Errorf("create template db %s: %v", db.Name, err)
 			}
 		}
 	}
-	return nil
+	if db.migrated && db.Cluster.ID.Type == Run {
+		if pending, err := db.seedPending(ctx); err != nil {
+			return false, fmt.Errorf("check seed state of db %s: %v", db.Name, err)
+		} else if pending {
+			if err := db.Seed(ctx, appRoot, svc); err != nil {
+				return false, fmt.Errorf("seed db %s: %v", db.Name, err)
+			}
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 // Create creates the database in the cluster if it does not already exist.
 // It reports whether the database was initialized for the first time
 // in this process.
 func (db *DB) Create(ctx context.Context) error {
+	switch db.Engine {
+	case MySQL:
+		adm, err := db.connectMySQL(ctx, "")
+		if err != nil {
+			return err
+		}
+		defer adm.Close()
+		_, err = adm.ExecContext(ctx, "CREATE DATABASE IF NOT EXISTS "+quoteMySQLIdent(db.Name))
+		return err
+	case SQLite:
+		// The database file is created when it's first opened.
+		_, err := db.Cluster.sqliteDir()
+		return err
+	}
+
 	adm, err := db.connectSuperuser(ctx)
 	if err != nil {
 		return err
@@ -188,26 +232,21 @@ func (db *DB) Migrate(ctx context.Context, appRoot string, svc *meta.Service) (e
 		}
 	}()
 
-	info, err := db.Cluster.Info(ctx)
-	if err != nil {
-		return err
-	} else if info.Status != Running {
-		return errors.New("cluster not running")
-	}
-
-	admin, ok := info.Encore.First(RoleAdmin, RoleSuperuser)
-	if !ok {
-		return errors.New("unable to find superuser or admin roles")
-	}
-	uri := info.ConnURI(db.Name, admin)
-	db.log.Debug().Str("uri", uri).Msg("running migrations")
-	conn, err := sql.Open("pgx", uri)
+	conn, err := db.openMigrationConn(ctx)
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
 
-	instance, err := postgres.WithInstance(conn, &postgres.Config{})
+	var instance database.Driver
+	switch db.Engine {
+	case MySQL:
+		instance, err = mysqlmigrate.WithInstance(conn, &mysqlmigrate.Config{})
+	case SQLite:
+		instance, err = sqlite3.WithInstance(conn, &sqlite3.Config{})
+	default:
+		instance, err = postgres.WithInstance(conn, &postgres.Config{})
+	}
 	if err != nil {
 		return err
 	}
@@ -221,6 +260,12 @@ func (db *DB) Migrate(ctx context.Context, appRoot string, svc *meta.Service) (e
 	if err != nil {
 		return err
 	}
+	if _, _, verErr := m.Version(); errors.Is(verErr, migrate.ErrNilVersion) && db.Cluster.ID.Type == Run {
+		// The database is migrated from scratch, so it needs seeding.
+		if err := db.markSeedPending(ctx, conn, appRoot, svc); err != nil {
+			return fmt.Errorf("mark db %s for seeding: %v", db.Name, err)
+		}
+	}
 
 	err = m.Up()
 	if errors.Is(err, migrate.ErrNoChange) {
@@ -229,9 +274,10 @@ func (db *DB) Migrate(ctx context.Context, appRoot string, svc *meta.Service) (e
 	}
 
 	// If we have a dirty migration, reset the dirty flag and try again.
-	// This is safe since all migrations run inside transactions.
+	// This is safe since all migrations run inside transactions,
+	// except on MySQL where schema changes can't be rolled back.
 	var dirty migrate.ErrDirty
-	if errors.As(err, &dirty) {
+	if errors.As(err, &dirty) && db.Engine != MySQL {
 		ver := dirty.Version - 1
 		// golang-migrate uses -1 to mean "no version", not 0.
 		if ver == 0 {
@@ -254,8 +300,68 @@ func (db *DB) Migrate(ctx context.Context, appRoot string, svc *meta.Service) (e
 	return nil
 }
 
-// Drop drops the database in the cluster if it exists.
+// openMigrationConn opens a connection to the database for running migrations.
+func (db *DB) openMigrationConn(ctx context.Context) (*sql.DB, error) {
+	switch db.Engine {
+	case MySQL:
+		return db.connectMySQL(ctx, db.Name)
+	case SQLite:
+		path, err := db.sqlitePath()
+		if err != nil {
+			return nil, err
+		}
+		return sql.Open("sqlite3", "file:"+path+"?_foreign_keys=on")
+	}
+
+	info, err := db.Cluster.Info(ctx)
+	if err != nil {
+		return nil, err
+	} else if info.Status != Running {
+		return nil, errors.New("cluster not running")
+	}
+
+	admin, ok := info.Encore.First(RoleAdmin, RoleSuperuser)
+	if !ok {
+		return nil, errors.New("unable to find superuser or admin roles")
+	}
+	uri := info.ConnURI(db.Name, admin)
+	db.log.Debug().Str("uri", uri).Msg("running migrations")
+	return sql.Open("pgx", uri)
+}
+
+// Drop drops the database in the cluster if it exists,
+// along with its template database.
 func (db *DB) Drop(ctx context.Context) error {
+	if db.hasTemplate() {
+		if err := db.dropTemplate(ctx); err != nil {
+			return fmt.Errorf("drop template db: %v", err)
+		}
+	}
+
+	switch db.Engine {
+	case MySQL:
+		adm, err := db.connectMySQL(ctx, "")
+		if err != nil {
+			return err
+		}
+		defer adm.Close()
+		_, err = adm.ExecContext(ctx, "DROP DATABASE IF EXISTS "+quoteMySQLIdent(db.Name))
+		db.log.Debug().Err(err).Msgf("dropped database")
+		return err
+	case SQLite:
+		path, err := db.sqlitePath()
+		if err != nil {
+			return err
+		}
+		for _, suffix := range []string{"", "-wal", "-shm"} {
+			if err := os.Remove(path + suffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+		db.log.Debug().Msgf("dropped database")
+		return nil
+	}
+
 	adm, err := db.connectSuperuser(ctx)
 	if err != nil {
 		return err
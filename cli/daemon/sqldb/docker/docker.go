@@ -149,10 +149,70 @@ func (d *Driver) ClusterStatus(ctx context.Context, id sqldb.ClusterID) (*sqldb.
 
 // clusterStatus reports both the standard ClusterStatus but also the container name we actually resolved to.
 func (d *Driver) clusterStatus(ctx context.Context, id sqldb.ClusterID) (status *sqldb.ClusterStatus, containerName string, err error) {
+	c, containerName, err := inspectContainer(ctx, containerNames(id))
+	if err != nil {
+		return nil, "", err
+	} else if c == nil {
+		return &sqldb.ClusterStatus{Status: sqldb.NotFound}, containerName, nil
+	}
+
+	status = &sqldb.ClusterStatus{Status: sqldb.Stopped, Config: &sqldb.ConnConfig{
+		// Defaults if we don't find anything else configured.
+		Superuser: sqldb.Role{
+			Type:     sqldb.RoleSuperuser,
+			Username: DefaultSuperuserUsername,
+			Password: DefaultSuperuserPassword,
+		},
+		RootDatabase: DefaultRootDatabase,
+	}}
+	if c.State.Running {
+		status.Status = sqldb.Running
+	}
+	ports := c.NetworkSettings.Ports["5432/tcp"]
+	if len(ports) > 0 {
+		status.Config.Host = ports[0].HostIP + ":" + ports[0].HostPort
+	}
+
+	// Read the Postgres config from the docker container's environment.
+	for _, env := range c.Config.Env {
+		if name, value, ok := strings.Cut(env, "="); ok {
+			switch name {
+			case "POSTGRES_USER":
+				status.Config.Superuser.Username = value
+			case "POSTGRES_PASSWORD":
+				status.Config.Superuser.Password = value
+			case "POSTGRES_DB":
+				status.Config.RootDatabase = value
+			}
+		}
+	}
+
+	return status, containerName, nil
+}
+
+// container describes a docker container, as reported by `docker container inspect`.
+type container struct {
+	Name  string
+	State struct {
+		Running bool
+	}
+	Config struct {
+		Env []string
+	}
+	NetworkSettings struct {
+		Ports map[string][]struct {
+			HostIP   string
+			HostPort string
+		}
+	}
+}
+
+// inspectContainer inspects the first container that exists among the candidate names.
+// If none of them exist it reports a nil container.
+func inspectContainer(ctx context.Context, cnames []string) (c *container, containerName string, err error) {
 	var output []byte
 
 	// Try the candidate container names in order.
-	cnames := containerNames(id)
 	for _, cname := range cnames {
 		var err error
 		out, err := exec.CommandContext(ctx, "docker", "container", "inspect", cname).CombinedOutput()
@@ -172,68 +232,24 @@ func (d *Driver) clusterStatus(ctx context.Context, id sqldb.ClusterID) (status
 		}
 	}
 	if output == nil {
-		return &sqldb.ClusterStatus{Status: sqldb.NotFound}, containerName, nil
+		return nil, containerName, nil
 	}
 
-	var resp []struct {
-		Name  string
-		State struct {
-			Running bool
-		}
-		Config struct {
-			Env []string
-		}
-		NetworkSettings struct {
-			Ports map[string][]struct {
-				HostIP   string
-				HostPort string
-			}
-		}
-	}
+	var resp []*container
 	if err := json.Unmarshal(output, &resp); err != nil {
 		return nil, "", fmt.Errorf("parse `docker container inspect` response: %v", err)
 	}
 	for _, c := range resp {
 		if c.Name == "/"+containerName {
-			status := &sqldb.ClusterStatus{Status: sqldb.Stopped, Config: &sqldb.ConnConfig{
-				// Defaults if we don't find anything else configured.
-				Superuser: sqldb.Role{
-					Type:     sqldb.RoleSuperuser,
-					Username: DefaultSuperuserUsername,
-					Password: DefaultSuperuserPassword,
-				},
-				RootDatabase: DefaultRootDatabase,
-			}}
-			if c.State.Running {
-				status.Status = sqldb.Running
-			}
-			ports := c.NetworkSettings.Ports["5432/tcp"]
-			if len(ports) > 0 {
-				status.Config.Host = ports[0].HostIP + ":" + ports[0].HostPort
-			}
-
-			// Read the Postgres config from the docker container's environment.
-			for _, env := range c.Config.Env {
-				if name, value, ok := strings.Cut(env, "="); ok {
-					switch name {
-					case "POSTGRES_USER":
-						status.Config.Superuser.Username = value
-					case "POSTGRES_PASSWORD":
-						status.Config.Superuser.Password = value
-					case "POSTGRES_DB":
-						status.Config.RootDatabase = value
-					}
-				}
-			}
-
-			return status, containerName, nil
+			return c, containerName, nil
 		}
 	}
-	return &sqldb.ClusterStatus{Status: sqldb.NotFound}, containerName, nil
+	return nil, containerName, nil
 }
 
 func (d *Driver) DestroyCluster(ctx context.Context, id sqldb.ClusterID) error {
 	cnames := containerNames(id)
+	cnames = append(cnames, mysqlContainerNames(id)...)
 	for _, cname := range cnames {
 		out, err := exec.CommandContext(ctx, "docker", "rm", "-f", cname).CombinedOutput()
 		if err != nil {
@@ -0,0 +1,165 @@
+package docker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/rs/zerolog"
+
+	"encr.dev/cli/daemon/sqldb"
+)
+
+var _ sqldb.EngineDriver = (*Driver)(nil)
+
+const (
+	// MySQLImage is the docker image used for MySQL databases.
+	MySQLImage = "mysql:8.0"
+
+	DefaultMySQLRootPassword = "mysql"
+	DefaultMySQLRootDatabase = "mysql"
+)
+
+func (d *Driver) CreateEngineServer(ctx context.Context, p *sqldb.CreateParams, engine sqldb.Engine, log zerolog.Logger) (status *sqldb.ClusterStatus, err error) {
+	if engine != sqldb.MySQL {
+		return nil, fmt.Errorf("docker: unsupported database engine %q", engine)
+	}
+
+	// Pulling the image can take a while, so don't use the timeout below.
+	if out, err := exec.CommandContext(ctx, "docker", "image", "inspect", MySQLImage).CombinedOutput(); err != nil {
+		if !strings.Contains(string(out), "No such image") {
+			return nil, fmt.Errorf("check docker image: %v", err)
+		}
+		log.Debug().Msg("MySQL image does not exist, pulling")
+		if out, err := exec.CommandContext(ctx, "docker", "pull", MySQLImage).CombinedOutput(); err != nil {
+			log.Error().Err(err).Msg("failed to pull MySQL image")
+			return nil, fmt.Errorf("pull docker image: %s (%v)", out, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 90*time.Second)
+	defer cancel()
+
+	cid := p.ClusterID
+	status, existingContainerName, err := d.mysqlStatus(ctx, cid)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get container status")
+		return nil, err
+	}
+
+	switch status.Status {
+	case sqldb.Running:
+		log.Debug().Str("hostport", status.Config.Host).Msg("mysql server already running")
+		return status, nil
+
+	case sqldb.Stopped:
+		log.Debug().Msg("mysql server stopped, restarting")
+		if out, err := exec.CommandContext(ctx, "docker", "start", existingContainerName).CombinedOutput(); err != nil {
+			return nil, fmt.Errorf("could not start mysql container: %s (%v)", string(out), err)
+		}
+
+	case sqldb.NotFound:
+		log.Debug().Msg("mysql server not found, creating")
+		args := []string{
+			"run",
+			"-d",
+			"-p", "3306",
+			"-e", "MYSQL_ROOT_PASSWORD=" + DefaultMySQLRootPassword,
+			"--name", mysqlContainerNames(cid)[0],
+		}
+		if p.Memfs {
+			args = append(args, "--mount", "type=tmpfs,destination=/var/lib/mysql")
+		}
+		args = append(args, MySQLImage)
+		if out, err := exec.CommandContext(ctx, "docker", args...).CombinedOutput(); err != nil {
+			return nil, fmt.Errorf("could not start mysql database as docker container: %s: %v", out, err)
+		}
+		log.Debug().Msg("mysql server created")
+
+	default:
+		return nil, fmt.Errorf("unknown mysql server status %q", status.Status)
+	}
+
+	// Wait for the server to accept connections. MySQL initializes its data
+	// directory on first start, which takes considerably longer than PostgreSQL.
+	for {
+		status, _, err = d.mysqlStatus(ctx, cid)
+		if err != nil {
+			return nil, err
+		}
+		if status.Status == sqldb.Running && status.Config.Host != "" {
+			if err = pingMySQL(ctx, status); err == nil {
+				log.Debug().Str("hostport", status.Config.Host).Msg("mysql server started")
+				return status, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Debug().Err(err).Msg("failed to connect to mysql server")
+			return nil, fmt.Errorf("mysql server did not come up: %v", ctx.Err())
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// mysqlStatus reports the status of the MySQL server for the given cluster,
+// and the container name we actually resolved to.
+func (d *Driver) mysqlStatus(ctx context.Context, id sqldb.ClusterID) (status *sqldb.ClusterStatus, containerName string, err error) {
+	c, containerName, err := inspectContainer(ctx, mysqlContainerNames(id))
+	if err != nil {
+		return nil, "", err
+	} else if c == nil {
+		return &sqldb.ClusterStatus{Status: sqldb.NotFound}, containerName, nil
+	}
+
+	status = &sqldb.ClusterStatus{Status: sqldb.Stopped, Config: &sqldb.ConnConfig{
+		Superuser: sqldb.Role{
+			Type:     sqldb.RoleSuperuser,
+			Username: "root",
+			Password: DefaultMySQLRootPassword,
+		},
+		RootDatabase: DefaultMySQLRootDatabase,
+	}}
+	if c.State.Running {
+		status.Status = sqldb.Running
+	}
+	ports := c.NetworkSettings.Ports["3306/tcp"]
+	if len(ports) > 0 {
+		status.Config.Host = ports[0].HostIP + ":" + ports[0].HostPort
+	}
+	for _, env := range c.Config.Env {
+		if name, value, ok := strings.Cut(env, "="); ok && name == "MYSQL_ROOT_PASSWORD" {
+			status.Config.Superuser.Password = value
+		}
+	}
+	return status, containerName, nil
+}
+
+// pingMySQL checks whether the MySQL server accepts connections.
+func pingMySQL(ctx context.Context, status *sqldb.ClusterStatus) error {
+	cfg := mysql.NewConfig()
+	cfg.User = status.Config.Superuser.Username
+	cfg.Passwd = status.Config.Superuser.Password
+	cfg.Net, cfg.Addr = "tcp", status.Config.Host
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+	return db.PingContext(ctx)
+}
+
+// mysqlContainerNames computes the MySQL container name candidates for a given clusterID.
+func mysqlContainerNames(id sqldb.ClusterID) []string {
+	names := containerNames(id)
+	for i, name := range names {
+		names[i] = name + "-mysql"
+	}
+	return names
+}
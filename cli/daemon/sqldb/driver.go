@@ -24,6 +24,16 @@ type Driver interface {
 	ClusterStatus(ctx context.Context, id ClusterID) (*ClusterStatus, error)
 }
 
+// An EngineDriver is a Driver that can also run database servers
+// for engines other than PostgreSQL. SQLite databases need no server.
+type EngineDriver interface {
+	Driver
+
+	// CreateEngineServer creates (if necessary) and starts (if necessary) a database server
+	// for the given engine, and returns its status once it accepts connections.
+	CreateEngineServer(ctx context.Context, p *CreateParams, engine Engine, log zerolog.Logger) (*ClusterStatus, error)
+}
+
 type ConnConfig struct {
 	// Host is the host address to connect to the database.
 	// It is only set when Status == Running.
@@ -0,0 +1,263 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+
+	"encore.dev/appruntime/exported/config"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// Engine is the database engine a database runs on.
+type Engine string
+
+const (
+	// Postgres databases run in the cluster itself and are accessed through the database proxy.
+	Postgres Engine = "postgres"
+	// MySQL databases run in a MySQL server started alongside the cluster.
+	MySQL Engine = "mysql"
+	// SQLite databases are files in a directory belonging to the cluster,
+	// and need no server at all.
+	SQLite Engine = "sqlite"
+)
+
+// EngineOf reports the engine of the database for svc.
+func EngineOf(svc *meta.Service) Engine {
+	switch svc.SqlEngine {
+	case meta.Service_MYSQL:
+		return MySQL
+	case meta.Service_SQLITE:
+		return SQLite
+	default:
+		return Postgres
+	}
+}
+
+// EnginesUsed reports the engines used by the databases in md.
+func EnginesUsed(md *meta.Data) map[Engine]bool {
+	engines := make(map[Engine]bool)
+	for _, svc := range md.Svcs {
+		if len(svc.Migrations) > 0 {
+			engines[EngineOf(svc)] = true
+		}
+	}
+	return engines
+}
+
+// StartFor starts what's needed to run the databases in md:
+// the cluster itself for PostgreSQL databases and a MySQL server
+// for MySQL databases. SQLite databases need no server.
+func (c *Cluster) StartFor(ctx context.Context, md *meta.Data) error {
+	engines := EnginesUsed(md)
+	if engines[Postgres] {
+		if _, err := c.Start(ctx); err != nil {
+			return err
+		}
+	}
+	if engines[MySQL] {
+		if _, err := c.startMySQL(ctx); err != nil {
+			return fmt.Errorf("start mysql server: %v", err)
+		}
+	}
+	if engines[SQLite] {
+		if _, err := c.sqliteDir(); err != nil {
+			return fmt.Errorf("create sqlite directory: %v", err)
+		}
+	}
+	return nil
+}
+
+// startMySQL starts the cluster's MySQL server if necessary and reports its status.
+func (c *Cluster) startMySQL(ctx context.Context) (*ClusterStatus, error) {
+	err := c.mysqlOnce.Do(func() error {
+		ed, ok := c.driver.(EngineDriver)
+		if !ok {
+			return fmt.Errorf("the database driver does not support MySQL databases")
+		}
+		st, err := ed.CreateEngineServer(ctx, &CreateParams{
+			ClusterID: c.ID,
+			Memfs:     c.Memfs,
+		}, MySQL, c.log)
+		if err != nil {
+			return err
+		}
+		c.mysqlStatus.Store(st)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.mysqlStatus.Load(), nil
+}
+
+// sqliteDir returns the directory containing the cluster's SQLite databases,
+// creating it if necessary. Clusters using an in-memory filesystem use a
+// temporary directory that is removed when the cluster is torn down.
+func (c *Cluster) sqliteDir() (string, error) {
+	err := c.sqliteOnce.Do(func() error {
+		if c.Memfs {
+			dir, err := os.MkdirTemp("", "encore-sqlite-")
+			if err != nil {
+				return err
+			}
+			go func() {
+				<-c.Ctx.Done()
+				_ = os.RemoveAll(dir)
+			}()
+			c.sqlitePath = dir
+			return nil
+		}
+
+		cache, err := os.UserCacheDir()
+		if err != nil {
+			return err
+		}
+		dir := filepath.Join(cache, "encore", "sqlite", string(clusterKeys(c.ID)[0]))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+		c.sqlitePath = dir
+		return nil
+	})
+	return c.sqlitePath, err
+}
+
+// RuntimeConfig computes the runtime configuration for connecting to the databases in md.
+// PostgreSQL databases are accessed through the database proxy listening on proxyHost,
+// while MySQL and SQLite databases are accessed directly. For test clusters it includes
+// the template databases the test runtime clones to isolate each test's database.
+func (c *Cluster) RuntimeConfig(ctx context.Context, md *meta.Data, proxyHost string) ([]*config.SQLServer, []*config.SQLDatabase, error) {
+	var (
+		servers   []*config.SQLServer
+		dbs       []*config.SQLDatabase
+		serverIDs = make(map[Engine]int)
+	)
+	for _, svc := range md.Svcs {
+		if len(svc.Migrations) == 0 {
+			continue
+		}
+
+		engine := EngineOf(svc)
+		db := &config.SQLDatabase{
+			EncoreName:   svc.Name,
+			DatabaseName: svc.Name,
+		}
+		var srv *config.SQLServer
+		switch engine {
+		case Postgres:
+			srv = &config.SQLServer{Host: proxyHost}
+			db.User, db.Password = "encore", c.Password
+		case MySQL:
+			st, err := c.startMySQL(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("start mysql server: %v", err)
+			}
+			srv = &config.SQLServer{Host: st.Config.Host, Engine: string(MySQL)}
+			db.User, db.Password = st.Config.Superuser.Username, st.Config.Superuser.Password
+		case SQLite:
+			dir, err := c.sqliteDir()
+			if err != nil {
+				return nil, nil, fmt.Errorf("create sqlite directory: %v", err)
+			}
+			srv = &config.SQLServer{Host: dir, Engine: string(SQLite)}
+		}
+
+		if c.ID.Type == Test && engine != MySQL {
+			db.TemplateName = svc.Name + templateSuffix
+		}
+
+		id, ok := serverIDs[engine]
+		if !ok {
+			id = len(servers)
+			servers = append(servers, srv)
+			serverIDs[engine] = id
+		}
+		db.ServerID = id
+		dbs = append(dbs, db)
+	}
+
+	// Configure max connections based on 96 connections
+	// divided evenly among the databases
+	if len(dbs) > 0 {
+		maxConns := 96 / len(dbs)
+		for _, db := range dbs {
+			db.MaxConnections = maxConns
+		}
+	}
+	return servers, dbs, nil
+}
+
+// DirectDSN reports the data source name for connecting directly to a MySQL or
+// SQLite database. PostgreSQL databases are instead accessed through the database proxy.
+func (db *DB) DirectDSN(ctx context.Context) (string, error) {
+	switch db.Engine {
+	case MySQL:
+		st, err := db.Cluster.startMySQL(ctx)
+		if err != nil {
+			return "", err
+		}
+		u := &url.URL{
+			Scheme: "mysql",
+			User:   url.UserPassword(st.Config.Superuser.Username, st.Config.Superuser.Password),
+			Host:   st.Config.Host,
+			Path:   "/" + db.Name,
+		}
+		return u.String(), nil
+	case SQLite:
+		path, err := db.sqlitePath()
+		if err != nil {
+			return "", err
+		}
+		return "file:" + path, nil
+	default:
+		return "", fmt.Errorf("%s databases are accessed through the database proxy", db.Engine)
+	}
+}
+
+// sqlitePath reports the path to the file of an SQLite database.
+// It matches the path used by the runtime.
+func (db *DB) sqlitePath() (string, error) {
+	dir, err := db.Cluster.sqliteDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, db.Name+".db"), nil
+}
+
+// connectMySQL opens a superuser connection to the given database on the cluster's MySQL server.
+// On success the returned db must be closed by the caller.
+func (db *DB) connectMySQL(ctx context.Context, database string) (*sql.DB, error) {
+	st, err := db.Cluster.startMySQL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := mysql.NewConfig()
+	cfg.User = st.Config.Superuser.Username
+	cfg.Passwd = st.Config.Superuser.Password
+	cfg.Net, cfg.Addr = "tcp", st.Config.Host
+	cfg.DBName = database
+	cfg.MultiStatements = true // migrations can contain multiple statements
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	conn := sql.OpenDB(connector)
+	if err := conn.PingContext(ctx); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("connect to mysql server: %v", err)
+	}
+	return conn, nil
+}
+
+// quoteMySQLIdent quotes an identifier for use in MySQL.
+func quoteMySQLIdent(ident string) string {
+	return "`" + strings.ReplaceAll(ident, "`", "``") + "`"
+}
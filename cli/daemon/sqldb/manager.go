@@ -58,7 +58,7 @@ func (cm *ClusterManager) Create(ctx context.Context, params *CreateParams) *Clu
 	defer cm.mu.Unlock()
 
 	c, ok := cm.get(params.ClusterID)
-	if ok {
+	if ok && c.isStarted() {
 		if status, err := c.Status(ctx); err != nil || status.Status != Running {
 			// The cluster is no longer running; recreate it to clear our cached state.
 			c.cancel()
@@ -0,0 +1,168 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// seedFiles lists the seed scripts of the service svc, in the order they run.
+// Seed scripts are the .sql files in the "seed" directory of the service.
+func seedFiles(appRoot string, svc *meta.Service) ([]string, error) {
+	// Glob returns the files sorted by name.
+	return filepath.Glob(filepath.Join(appRoot, svc.RelPath, "seed", "*.sql"))
+}
+
+// HasSeedProgram reports whether the service svc has a Go seed program:
+// a main package in the service's "seed" directory, which runs after the seed scripts.
+// Seed programs are built and run by the run manager, using SeedProgramPending
+// and SeedProgramDone to keep track of whether they have run.
+func HasSeedProgram(appRoot string, svc *meta.Service) (bool, error) {
+	files, err := filepath.Glob(filepath.Join(appRoot, svc.RelPath, "seed", "*.go"))
+	if err != nil {
+		return false, err
+	}
+	for _, f := range files {
+		if !strings.HasSuffix(f, "_test.go") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// seedPendingTable is created in run databases with seed scripts when they are
+// migrated from scratch, and dropped once the seed scripts have run.
+// Keeping track of it in the database, rather than in the daemon,
+// makes sure the seed scripts run even if the daemon restarts before they do.
+const seedPendingTable = "encore_seed_pending"
+
+// seedProgramPendingTable is like seedPendingTable, for the Go seed program.
+// It's tracked separately since the seed program runs outside of the seed transaction.
+const seedProgramPendingTable = "encore_seed_program_pending"
+
+// markSeedPending records that the database needs seeding,
+// if the service has seed scripts or a seed program.
+func (db *DB) markSeedPending(ctx context.Context, conn *sql.DB, appRoot string, svc *meta.Service) error {
+	if files, err := seedFiles(appRoot, svc); err != nil {
+		return err
+	} else if len(files) > 0 {
+		if _, err := conn.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+seedPendingTable+" (id INTEGER)"); err != nil {
+			return err
+		}
+	}
+	if ok, err := HasSeedProgram(appRoot, svc); err != nil || !ok {
+		return err
+	}
+	_, err := conn.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+seedProgramPendingTable+" (id INTEGER)")
+	return err
+}
+
+// seedPending reports whether the database needs its seed scripts to run.
+func (db *DB) seedPending(ctx context.Context) (bool, error) {
+	return db.tableExists(ctx, seedPendingTable)
+}
+
+// SeedProgramPending reports whether the database needs its seed program to run.
+func (db *DB) SeedProgramPending(ctx context.Context) (bool, error) {
+	return db.tableExists(ctx, seedProgramPendingTable)
+}
+
+// SeedProgramDone records that the seed program of the database has run.
+func (db *DB) SeedProgramDone(ctx context.Context) error {
+	conn, err := db.openMigrationConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.ExecContext(ctx, "DROP TABLE IF EXISTS "+seedProgramPendingTable)
+	return err
+}
+
+// tableExists reports whether the database contains the table name.
+func (db *DB) tableExists(ctx context.Context, name string) (bool, error) {
+	conn, err := db.openMigrationConn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	var query string
+	switch db.Engine {
+	case MySQL:
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?"
+	case SQLite:
+		query = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	default:
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1"
+	}
+	var n int
+	err = conn.QueryRowContext(ctx, query, name).Scan(&n)
+	return n > 0, err
+}
+
+// Seed runs the seed scripts of the service svc against the database,
+// in a single transaction.
+func (db *DB) Seed(ctx context.Context, appRoot string, svc *meta.Service) (err error) {
+	files, err := seedFiles(appRoot, svc)
+	if err != nil {
+		return err
+	} else if len(files) == 0 {
+		return nil
+	}
+
+	db.log.Debug().Int("files", len(files)).Msg("seeding database")
+	defer func() {
+		if err != nil {
+			db.log.Error().Err(err).Msg("seeding failed")
+		} else {
+			db.log.Info().Msg("seeding completed")
+		}
+	}()
+
+	conn, err := db.openMigrationConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		// Executing the script without arguments allows it to contain multiple statements.
+		if _, err := tx.ExecContext(ctx, string(data)); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("seed script %s: %v", filepath.Base(file), err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "DROP TABLE IF EXISTS "+seedPendingTable); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// SetupAndSeed sets up and migrates the database, and then runs its seed scripts
+// unless they already ran as part of setting up the database.
+// It doesn't run the seed program; see HasSeedProgram.
+func (db *DB) SetupAndSeed(ctx context.Context, appRoot string, svc *meta.Service) error {
+	seeded, err := db.setup(ctx, appRoot, svc, true, false)
+	if err != nil || seeded {
+		return err
+	}
+	if err := db.Seed(ctx, appRoot, svc); err != nil {
+		return fmt.Errorf("seed db %s: %v", db.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package sqldb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// templateSuffix is the suffix added to the name of a database
+// to get the name of its template database.
+const templateSuffix = "__template"
+
+// hasTemplate reports whether the database has a template database
+// the test runtime clones to isolate each test's database.
+// Cloning MySQL databases is not supported.
+func (db *DB) hasTemplate() bool {
+	return db.Cluster.ID.Type == Test && db.Engine != MySQL
+}
+
+// templateName reports the name of the database's template database.
+func (db *DB) templateName() string {
+	return db.Name + templateSuffix
+}
+
+// CreateTemplate (re)creates the template database from the migrated database,
+// for the test runtime to clone for each test.
+func (db *DB) CreateTemplate(ctx context.Context) error {
+	db.log.Debug().Msg("creating template database")
+	if err := db.dropTemplate(ctx); err != nil {
+		return err
+	}
+
+	if db.Engine == SQLite {
+		tmplPath, err := db.sqliteTemplatePath()
+		if err != nil {
+			return err
+		}
+		conn, err := db.openMigrationConn(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		// VACUUM INTO writes a consistent copy of the database, unlike copying the
+		// database file which may be missing changes not yet checkpointed from the WAL.
+		_, err = conn.ExecContext(ctx, "VACUUM INTO ?", tmplPath)
+		return err
+	}
+
+	adm, err := db.connectSuperuser(ctx)
+	if err != nil {
+		return err
+	}
+	defer adm.Close(context.Background())
+
+	owner, ok := db.Cluster.Roles.First(RoleAdmin, RoleSuperuser)
+	if !ok {
+		return errors.New("unable to find admin or superuser roles")
+	}
+	ownerName := (pgx.Identifier{owner.Username}).Sanitize()
+
+	// Drop all connections since PostgreSQL can't copy a database that is being accessed.
+	_, _ = adm.Exec(ctx, "SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = $1", db.Name)
+	_, err = adm.Exec(ctx, fmt.Sprintf("CREATE DATABASE %s TEMPLATE %s OWNER %s;",
+		(pgx.Identifier{db.templateName()}).Sanitize(), (pgx.Identifier{db.Name}).Sanitize(), ownerName))
+	if err != nil {
+		return err
+	}
+
+	// The test runtime clones the template using the admin role.
+	if owner.Type == RoleAdmin {
+		_, err = adm.Exec(ctx, fmt.Sprintf("ALTER ROLE %s CREATEDB;", ownerName))
+	}
+	return err
+}
+
+// dropTemplate drops the database's template database if it exists.
+func (db *DB) dropTemplate(ctx context.Context) error {
+	if db.Engine == SQLite {
+		tmplPath, err := db.sqliteTemplatePath()
+		if err != nil {
+			return err
+		}
+		if err := os.Remove(tmplPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	adm, err := db.connectSuperuser(ctx)
+	if err != nil {
+		return err
+	}
+	defer adm.Close(context.Background())
+
+	name := db.templateName()
+	_, _ = adm.Exec(ctx, "SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = $1", name)
+	_, err = adm.Exec(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s;", (pgx.Identifier{name}).Sanitize()))
+	return err
+}
+
+// sqliteTemplatePath reports the path to the file of an SQLite database's template.
+// It matches the path used by the runtime.
+func (db *DB) sqliteTemplatePath() (string, error) {
+	dir, err := db.Cluster.sqliteDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, db.templateName()+".db"), nil
+}
@@ -59,7 +59,7 @@ func (s *Server) Test(req *daemonpb.TestRequest, stream daemonpb.Daemon_TestServ
 
 		go func() {
 			defer setupCancel()
-			if _, err := cluster.Start(setupCtx); err != nil {
+			if err := cluster.StartFor(setupCtx, parse.Meta); err != nil {
 				dbSetupErr <- err
 			} else if err := cluster.Recreate(setupCtx, req.AppRoot, nil, parse.Meta); err != nil {
 				dbSetupErr <- err
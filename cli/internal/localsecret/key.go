@@ -0,0 +1,165 @@
+package localsecret
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+
+	"encr.dev/internal/conf"
+)
+
+// KeyEnvVar is the environment variable that, if set, provides
+// the secret key to use instead of the user's key file.
+const KeyEnvVar = "ENCORE_SECRET_KEY"
+
+// ErrNoIdentity is reported when the user has no secret key.
+var ErrNoIdentity = errors.New("no secret key found: generate one with 'encore secret key' or set the " + KeyEnvVar + " environment variable")
+
+// Identity is an age X25519 identity used to decrypt a secret store.
+type Identity struct {
+	id *age.X25519Identity
+}
+
+// Recipient is the age public key of an Identity,
+// which can be given access to a secret store.
+type Recipient string
+
+// GenerateIdentity generates a new random identity.
+func GenerateIdentity() (*Identity, error) {
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{id: id}, nil
+}
+
+// ParseIdentity parses an age secret key, as returned by (*Identity).String.
+func ParseIdentity(s string) (*Identity, error) {
+	id, err := age.ParseX25519Identity(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret key: %v", err)
+	}
+	return &Identity{id: id}, nil
+}
+
+// String encodes the identity. It must be kept private.
+func (id *Identity) String() string {
+	return id.id.String()
+}
+
+// Recipient returns the public key of the identity.
+func (id *Identity) Recipient() Recipient {
+	return Recipient(id.id.Recipient().String())
+}
+
+// ParseRecipient parses an age public key, as returned by Recipient.String.
+func ParseRecipient(s string) (Recipient, error) {
+	r, err := age.ParseX25519Recipient(strings.TrimSpace(s))
+	if err != nil {
+		return "", fmt.Errorf("invalid public key: %v", err)
+	}
+	return Recipient(r.String()), nil
+}
+
+func (r Recipient) String() string {
+	return string(r)
+}
+
+// LoadIdentity loads the user's identity, from the ENCORE_SECRET_KEY
+// environment variable if set and otherwise from the user's key file.
+// It reports ErrNoIdentity if the user has no identity.
+func LoadIdentity() (*Identity, error) {
+	if s := os.Getenv(KeyEnvVar); s != "" {
+		return ParseIdentity(s)
+	}
+
+	path, err := identityPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoIdentity
+	} else if err != nil {
+		return nil, err
+	}
+	return ParseIdentity(string(data))
+}
+
+// LoadOrCreateIdentity is like LoadIdentity but generates a new identity,
+// and writes it to the user's key file, if the user has none.
+func LoadOrCreateIdentity() (id *Identity, created bool, err error) {
+	id, err = LoadIdentity()
+	if !errors.Is(err, ErrNoIdentity) {
+		return id, false, err
+	}
+
+	id, err = GenerateIdentity()
+	if err != nil {
+		return nil, false, err
+	}
+	path, err := identityPath()
+	if err != nil {
+		return nil, false, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, false, err
+	}
+	if err := os.WriteFile(path, []byte(id.String()+"\n"), 0600); err != nil {
+		return nil, false, err
+	}
+	return id, true, nil
+}
+
+// identityPath returns the path to the user's key file.
+func identityPath() (string, error) {
+	dir, err := conf.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "secret.key"), nil
+}
+
+// encryptKey encrypts the data key for the recipients with age,
+// returning it in the armored format.
+func encryptKey(dataKey []byte, recipients []Recipient) (string, error) {
+	rs := make([]age.Recipient, len(recipients))
+	for i, r := range recipients {
+		parsed, err := age.ParseX25519Recipient(string(r))
+		if err != nil {
+			return "", fmt.Errorf("invalid recipient %s: %v", r, err)
+		}
+		rs[i] = parsed
+	}
+
+	var buf bytes.Buffer
+	aw := armor.NewWriter(&buf)
+	w, err := age.Encrypt(aw, rs...)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(dataKey); err != nil {
+		return "", err
+	} else if err := w.Close(); err != nil {
+		return "", err
+	} else if err := aw.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// decryptKey decrypts a data key encrypted with encryptKey using the identity id.
+func decryptKey(encrypted string, id *Identity) ([]byte, error) {
+	r, err := age.Decrypt(armor.NewReader(strings.NewReader(encrypted)), id.id)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
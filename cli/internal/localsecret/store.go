@@ -0,0 +1,394 @@
+// Package localsecret implements a secret store kept in an encrypted file
+// in the application's repository, for apps that don't manage their secrets
+// with the Encore platform.
+//
+// Like sops, the secret keys and the environments they are set for are stored
+// in plaintext, and only the secret values are encrypted. The values are encrypted
+// with a random data key, which in turn is encrypted with age for the recipients'
+// age public keys.
+package localsecret
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/exp/slices"
+)
+
+// FileName is the name of the secret store file, relative to the app root.
+const FileName = ".secrets.enc.json"
+
+// Path returns the path to the secret store of the app at appRoot.
+func Path(appRoot string) string {
+	return filepath.Join(appRoot, FileName)
+}
+
+// Exists reports whether the app at appRoot has a secret store.
+func Exists(appRoot string) bool {
+	_, err := os.Stat(Path(appRoot))
+	return err == nil
+}
+
+// Store is a local secret store.
+type Store struct {
+	path string
+	data storeData
+}
+
+type storeData struct {
+	Version int `json:"version"`
+
+	// Recipients are the age public keys with access to the store.
+	Recipients []Recipient `json:"recipients"`
+
+	// DataKey is the key the secret values are encrypted with,
+	// encrypted with age for the recipients, in the armored format.
+	DataKey string `json:"data_key,omitempty"`
+
+	// Secrets are the secret groups, keyed by secret key.
+	Secrets map[string][]*Group `json:"secrets"`
+}
+
+// Group is a secret value for a set of environments.
+type Group struct {
+	// ID uniquely identifies the group.
+	ID string `json:"id"`
+
+	// Selector describes the environments the value is used for,
+	// like "type:production" or "env:staging".
+	Selector []string `json:"selector"`
+
+	// Value is the encrypted secret value.
+	Value string `json:"value"`
+
+	CreatedAt  time.Time  `json:"created_at"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+}
+
+// Secret is a secret key and the groups of values set for it.
+type Secret struct {
+	Key    string
+	Groups []*Group
+}
+
+// ConflictError is reported when setting a secret value for environments
+// that already have a value from another group.
+type ConflictError struct {
+	Key       string
+	Conflicts map[string][]string // group id -> conflicting selectors
+}
+
+func (e *ConflictError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "the environment selection conflicts with other values for %s:", e.Key)
+	ids := make([]string, 0, len(e.Conflicts))
+	for id := range e.Conflicts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		fmt.Fprintf(&b, "\n\t%s %s", id, strings.Join(e.Conflicts[id], ", "))
+	}
+	return b.String()
+}
+
+// Open opens the secret store of the app at appRoot.
+// If the store doesn't exist, an empty store is returned
+// that is created when saved.
+func Open(appRoot string) (*Store, error) {
+	s := &Store{
+		path: Path(appRoot),
+		data: storeData{Version: 1, Secrets: make(map[string][]*Group)},
+	}
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &s.data); err != nil {
+		return nil, fmt.Errorf("parse %s: %v", FileName, err)
+	} else if s.data.Version != 1 {
+		return nil, fmt.Errorf("parse %s: unsupported version %d", FileName, s.data.Version)
+	}
+	if s.data.Secrets == nil {
+		s.data.Secrets = make(map[string][]*Group)
+	}
+	return s, nil
+}
+
+// Save writes the store to disk.
+// The store is written to a temporary file that replaces the store once written,
+// so the store is never left partially written.
+func (s *Store) Save() error {
+	data, err := json.MarshalIndent(s.data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(s.path), FileName+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	} else if err := f.Chmod(0644); err != nil {
+		_ = f.Close()
+		return err
+	} else if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	} else if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
+
+// Secrets returns the secrets in the store, ordered by key.
+func (s *Store) Secrets() []*Secret {
+	secrets := make([]*Secret, 0, len(s.data.Secrets))
+	for key, groups := range s.data.Secrets {
+		secrets = append(secrets, &Secret{Key: key, Groups: groups})
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Key < secrets[j].Key
+	})
+	return secrets
+}
+
+// Recipients returns the public keys that can decrypt the store.
+func (s *Store) Recipients() []string {
+	recipients := make([]string, len(s.data.Recipients))
+	for i, r := range s.data.Recipients {
+		recipients[i] = r.String()
+	}
+	return recipients
+}
+
+// Set sets the value of the secret key for the environments matching selector.
+// If a group with the same selector exists, its value is updated.
+// Otherwise a new group is created, unless it would conflict with an existing group.
+//
+// If the store is empty, id becomes its first recipient.
+func (s *Store) Set(id *Identity, key, value string, selector []string) (g *Group, created bool, err error) {
+	dataKey, err := s.dataKey(id, true)
+	if err != nil {
+		return nil, false, err
+	}
+
+	selector = canonicalize(selector)
+	conflicts := make(map[string][]string)
+	for _, g := range s.data.Secrets[key] {
+		if g.ArchivedAt != nil {
+			continue
+		} else if slices.Equal(g.Selector, selector) {
+			g.Value, err = encrypt(dataKey, key, g.ID, value)
+			return g, false, err
+		}
+		for _, sel := range g.Selector {
+			if slices.Contains(selector, sel) {
+				conflicts[g.ID] = append(conflicts[g.ID], sel)
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, false, &ConflictError{Key: key, Conflicts: conflicts}
+	}
+
+	g = &Group{
+		ID:        newGroupID(),
+		Selector:  selector,
+		CreatedAt: time.Now().UTC(),
+	}
+	if g.Value, err = encrypt(dataKey, key, g.ID, value); err != nil {
+		return nil, false, err
+	}
+	s.data.Secrets[key] = append(s.data.Secrets[key], g)
+	return g, true, nil
+}
+
+// SetArchived archives or unarchives the group with the given id.
+// Archived groups are kept in the store but their values are not used.
+func (s *Store) SetArchived(groupID string, archived bool) error {
+	for key, groups := range s.data.Secrets {
+		for _, g := range groups {
+			if g.ID != groupID {
+				continue
+			}
+
+			if !archived {
+				// Make sure the group doesn't conflict with the active groups.
+				for _, other := range groups {
+					if other == g || other.ArchivedAt != nil {
+						continue
+					}
+					for _, sel := range other.Selector {
+						if slices.Contains(g.Selector, sel) {
+							return &ConflictError{Key: key, Conflicts: map[string][]string{other.ID: {sel}}}
+						}
+					}
+				}
+				g.ArchivedAt = nil
+			} else if g.ArchivedAt == nil {
+				now := time.Now().UTC()
+				g.ArchivedAt = &now
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("secret group %s not found", groupID)
+}
+
+// AddRecipient gives the owner of the public key r access to the store.
+// The identity id must already have access.
+func (s *Store) AddRecipient(id *Identity, r Recipient) error {
+	if slices.Contains(s.data.Recipients, r) {
+		return nil
+	}
+	dataKey, err := s.dataKey(id, true)
+	if err != nil {
+		return err
+	}
+	recipients := append(slices.Clone(s.data.Recipients), r)
+	encrypted, err := encryptKey(dataKey, recipients)
+	if err != nil {
+		return err
+	}
+	s.data.Recipients, s.data.DataKey = recipients, encrypted
+	return nil
+}
+
+// Resolve decrypts the values of the secrets for the environment with
+// the given type and name. Values set for the specific environment
+// take precedence over values set for its environment type.
+func (s *Store) Resolve(id *Identity, envType, envName string) (map[string]string, error) {
+	values := make(map[string]string)
+	if len(s.data.Secrets) == 0 {
+		return values, nil
+	}
+
+	dataKey, err := s.dataKey(id, false)
+	if err != nil {
+		return nil, err
+	}
+	for key := range s.data.Secrets {
+		if g := s.lookup(key, envType, envName); g != nil {
+			if values[key], err = decrypt(dataKey, key, g.ID, g.Value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return values, nil
+}
+
+// lookup returns the active group providing the value of the secret key
+// for the environment with the given type and name, or nil if there is none.
+func (s *Store) lookup(key, envType, envName string) *Group {
+	var byType *Group
+	for _, g := range s.data.Secrets[key] {
+		if g.ArchivedAt != nil {
+			continue
+		}
+		if envName != "" && slices.Contains(g.Selector, "env:"+envName) {
+			return g
+		} else if envType != "" && slices.Contains(g.Selector, "type:"+envType) {
+			byType = g
+		}
+	}
+	return byType
+}
+
+// dataKey returns the data key of the store, decrypted using id.
+// If init is true and the store has no recipients, a new data key
+// is generated and id is made the first recipient.
+func (s *Store) dataKey(id *Identity, init bool) ([]byte, error) {
+	if len(s.data.Recipients) == 0 {
+		if !init {
+			return nil, fmt.Errorf("the secret store has no recipients")
+		}
+		dataKey := make([]byte, chacha20poly1305.KeySize)
+		if _, err := rand.Read(dataKey); err != nil {
+			return nil, err
+		}
+		recipients := []Recipient{id.Recipient()}
+		encrypted, err := encryptKey(dataKey, recipients)
+		if err != nil {
+			return nil, err
+		}
+		s.data.Recipients, s.data.DataKey = recipients, encrypted
+		return dataKey, nil
+	}
+
+	pub := id.Recipient()
+	if !slices.Contains(s.data.Recipients, pub) {
+		return nil, fmt.Errorf("your public key %s does not have access to the secret store; "+
+			"ask someone with access to run 'encore secret grant %s'", pub, pub)
+	}
+	dataKey, err := decryptKey(s.data.DataKey, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt the data key of the secret store: %v", err)
+	} else if len(dataKey) != chacha20poly1305.KeySize {
+		return nil, fmt.Errorf("invalid data key in the secret store")
+	}
+	return dataKey, nil
+}
+
+// encrypt encrypts the value of the secret key in group groupID.
+// The key and group id are authenticated to prevent values from being moved.
+func encrypt(dataKey []byte, key, groupID, value string) (string, error) {
+	aead, err := chacha20poly1305.NewX(dataKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(value)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	out := aead.Seal(nonce, nonce, []byte(value), []byte(key+"\x00"+groupID))
+	return base64.StdEncoding.EncodeToString(out), nil
+}
+
+// decrypt decrypts a value encrypted with encrypt.
+func decrypt(dataKey []byte, key, groupID, value string) (string, error) {
+	aead, err := chacha20poly1305.NewX(dataKey)
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(data) < aead.NonceSize() {
+		return "", fmt.Errorf("invalid encrypted value for secret %s", key)
+	}
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(key+"\x00"+groupID))
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt secret %s", key)
+	}
+	return string(plaintext), nil
+}
+
+// canonicalize returns the selectors sorted and deduplicated.
+func canonicalize(selector []string) []string {
+	out := slices.Clone(selector)
+	sort.Strings(out)
+	return slices.Compact(out)
+}
+
+func newGroupID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return "secgrp_local_" + hex.EncodeToString(b[:])
+}
@@ -0,0 +1,127 @@
+package localsecret
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestIdentity(t *testing.T) {
+	c := qt.New(t)
+	id, err := GenerateIdentity()
+	c.Assert(err, qt.IsNil)
+
+	parsed, err := ParseIdentity(id.String())
+	c.Assert(err, qt.IsNil)
+	c.Assert(parsed.Recipient(), qt.Equals, id.Recipient())
+
+	r, err := ParseRecipient(id.Recipient().String())
+	c.Assert(err, qt.IsNil)
+	c.Assert(r, qt.Equals, id.Recipient())
+
+	_, err = ParseIdentity(id.Recipient().String())
+	c.Assert(err, qt.ErrorMatches, `invalid secret key: .*`)
+	_, err = ParseRecipient("age1abc")
+	c.Assert(err, qt.ErrorMatches, `invalid public key: .*`)
+}
+
+func TestStore(t *testing.T) {
+	c := qt.New(t)
+	root := t.TempDir()
+	id, err := GenerateIdentity()
+	c.Assert(err, qt.IsNil)
+
+	s, err := Open(root)
+	c.Assert(err, qt.IsNil)
+	c.Assert(Exists(root), qt.IsFalse)
+
+	dev, created, err := s.Set(id, "Token", "dev-token", []string{"type:local", "type:development"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(created, qt.IsTrue)
+	c.Assert(dev.Selector, qt.DeepEquals, []string{"type:development", "type:local"})
+	_, _, err = s.Set(id, "Token", "prod-token", []string{"type:production"})
+	c.Assert(err, qt.IsNil)
+	_, _, err = s.Set(id, "Token", "staging-token", []string{"env:staging"})
+	c.Assert(err, qt.IsNil)
+
+	// Setting a value for the same selector updates the group.
+	g, created, err := s.Set(id, "Token", "dev-token-2", []string{"type:development", "type:local"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(created, qt.IsFalse)
+	c.Assert(g.ID, qt.Equals, dev.ID)
+
+	// Overlapping selectors conflict.
+	_, _, err = s.Set(id, "Token", "x", []string{"type:local", "type:preview"})
+	c.Assert(err, qt.ErrorMatches, `(?s)the environment selection conflicts with other values for Token:\n\t`+dev.ID+` type:local`)
+
+	c.Assert(s.Save(), qt.IsNil)
+	c.Assert(Exists(root), qt.IsTrue)
+
+	// Values are only stored encrypted.
+	data, err := os.ReadFile(Path(root))
+	c.Assert(err, qt.IsNil)
+	c.Assert(strings.Contains(string(data), "token"), qt.IsFalse)
+
+	s, err = Open(root)
+	c.Assert(err, qt.IsNil)
+	for _, tt := range []struct {
+		envType, envName, want string
+	}{
+		{"local", "local", "dev-token-2"},
+		{"production", "prod", "prod-token"},
+		{"production", "staging", "staging-token"},
+		{"", "staging", "staging-token"},
+		{"preview", "pr-1", ""},
+	} {
+		values, err := s.Resolve(id, tt.envType, tt.envName)
+		c.Assert(err, qt.IsNil)
+		c.Assert(values["Token"], qt.Equals, tt.want, qt.Commentf("env %s/%s", tt.envType, tt.envName))
+	}
+
+	// Archived groups are not used.
+	c.Assert(s.SetArchived(dev.ID, true), qt.IsNil)
+	values, err := s.Resolve(id, "local", "local")
+	c.Assert(err, qt.IsNil)
+	c.Assert(values, qt.DeepEquals, map[string]string{})
+	_, _, err = s.Set(id, "Token", "new-dev-token", []string{"type:local"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(s.SetArchived(dev.ID, false), qt.ErrorMatches, `(?s)the environment selection conflicts.*`)
+	c.Assert(s.SetArchived("secgrp_unknown", true), qt.ErrorMatches, `secret group secgrp_unknown not found`)
+}
+
+func TestStore_Recipients(t *testing.T) {
+	c := qt.New(t)
+	root := t.TempDir()
+	alice, err := GenerateIdentity()
+	c.Assert(err, qt.IsNil)
+	bob, err := GenerateIdentity()
+	c.Assert(err, qt.IsNil)
+
+	s, err := Open(root)
+	c.Assert(err, qt.IsNil)
+	_, _, err = s.Set(alice, "Token", "secret", []string{"type:production"})
+	c.Assert(err, qt.IsNil)
+
+	_, err = s.Resolve(bob, "production", "")
+	c.Assert(err, qt.ErrorMatches, `your public key \S+ does not have access to the secret store; .*`)
+	_, _, err = s.Set(bob, "Token", "other", []string{"type:production"})
+	c.Assert(err, qt.ErrorMatches, `your public key \S+ does not have access to the secret store; .*`)
+
+	c.Assert(s.AddRecipient(alice, bob.Recipient()), qt.IsNil)
+	c.Assert(s.Recipients(), qt.DeepEquals, []string{alice.Recipient().String(), bob.Recipient().String()})
+	values, err := s.Resolve(bob, "production", "")
+	c.Assert(err, qt.IsNil)
+	c.Assert(values, qt.DeepEquals, map[string]string{"Token": "secret"})
+
+	// The data key is stored as an armored age file, and the store
+	// is replaced without leaving temporary files behind.
+	c.Assert(s.data.DataKey, qt.Matches, `(?s)-----BEGIN AGE ENCRYPTED FILE-----\n.*`)
+	c.Assert(s.Save(), qt.IsNil)
+	c.Assert(s.Save(), qt.IsNil)
+	entries, err := os.ReadDir(root)
+	c.Assert(err, qt.IsNil)
+	c.Assert(entries, qt.HasLen, 1)
+	c.Assert(entries[0].Name(), qt.Equals, FileName)
+}
@@ -136,6 +136,8 @@ func (b *rpcBuilder) Write(f *File) {
 		Id("PathParamNames").Op(":").Add(pathParamNames(rpc.Path)),
 		Id("DefLoc").Op(":").Lit(defLoc),
 		Id("Access").Op(":").Add(access),
+		b.versionField(rpc),
+		b.deprecationField(rpc),
 
 		Id("DecodeReq").Op(":").Add(decodeReq),
 		Id("CloneReq").Op(":").Add(reqDesc.Clone),
@@ -208,6 +210,32 @@ func (r *structDesc) AddField(kind fieldKind, name string, goType *Statement, bu
 	return fieldName
 }
 
+// versionField renders the Version field of the API description,
+// or nothing if the API is unversioned.
+func (b *rpcBuilder) versionField(rpc *est.RPC) Code {
+	if rpc.Version == 0 {
+		return Null()
+	}
+	return Id("Version").Op(":").Lit(rpc.Version)
+}
+
+// deprecationField renders the Deprecation field of the API description,
+// or nothing if the API is not deprecated.
+func (b *rpcBuilder) deprecationField(rpc *est.RPC) Code {
+	d := rpc.Deprecation
+	if d == nil {
+		return Null()
+	}
+	return Id("Deprecation").Op(":").Op("&").Qual("encore.dev/appruntime/api", "Deprecation").ValuesFunc(func(g *Group) {
+		if d.Since != "" {
+			g.Id("Since").Op(":").Lit(d.Since)
+		}
+		if d.Sunset != "" {
+			g.Id("Sunset").Op(":").Lit(d.Sunset)
+		}
+	})
+}
+
 // renderDecodeReq renders the DecodeReq code as a func literal.
 func (b *rpcBuilder) renderDecodeReq() *Statement {
 	return Func().Params(
@@ -133,6 +133,7 @@ sections: [
 			{title: "Migrate an existing backend to Encore", segment: "migrate-to-encore"},
 			{title: "Migrate away from Encore", segment: "migrate-away"},
 			{title: "Build with cgo", segment: "cgo"},
+			{title: "Compress API responses", segment: "compression"},
 		]
 	},
 	{
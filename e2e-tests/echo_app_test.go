@@ -86,7 +86,7 @@ func doTestEndToEndWithApp(t *testing.T, env []string) {
 		clientgen.LangTypeScript: "ts/client.ts",
 		clientgen.LangJavascript: "js/client.js",
 	} {
-		client, err := clientgen.Client(lang, "slug", app.Meta)
+		client, err := clientgen.Client(lang, "slug", app.Meta, clientgen.GenOptions{})
 		if err != nil {
 			fmt.Println(err.Error())
 			c.FailNow()
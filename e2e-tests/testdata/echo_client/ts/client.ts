@@ -68,6 +68,25 @@ export interface ClientOptions {
      */
     fetcher?: Fetcher
 
+    /**
+     * The number of milliseconds after which a request is aborted and an APIError
+     * with the DeadlineExceeded code is thrown. Individual calls can override this
+     * using CallOptions. By default requests do not time out.
+     */
+    timeout?: number
+
+    /**
+     * The retry policy to use for failed requests. Individual calls can override
+     * this using CallOptions. By default requests are not retried.
+     */
+    retry?: RetryPolicy
+
+    /**
+     * Interceptors which are run on each request before it is sent and on each
+     * response after it is received, in the order they are given.
+     */
+    interceptors?: Interceptors
+
     /**
      * Allows you to set the authentication data to be used for each
      * request either by passing in a static object or by passing in
@@ -96,30 +115,30 @@ export namespace cache {
             this.baseClient = baseClient
         }
 
-        public async GetList(key: number): Promise<ListResponse> {
+        public async GetList(key: number, options?: CallOptions): Promise<ListResponse> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("GET", `/cache/list/${encodeURIComponent(key)}`)
+            const resp = await this.baseClient.callAPI("GET", `/cache/list/${encodeURIComponent(key)}`, undefined, options)
             return await resp.json() as ListResponse
         }
 
-        public async GetStruct(key: number): Promise<StructVal> {
+        public async GetStruct(key: number, options?: CallOptions): Promise<StructVal> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("GET", `/cache/struct/${encodeURIComponent(key)}`)
+            const resp = await this.baseClient.callAPI("GET", `/cache/struct/${encodeURIComponent(key)}`, undefined, options)
             return await resp.json() as StructVal
         }
 
-        public async Incr(key: string): Promise<IncrResponse> {
+        public async Incr(key: string, options?: CallOptions): Promise<IncrResponse> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/cache/incr/${encodeURIComponent(key)}`)
+            const resp = await this.baseClient.callAPI("POST", `/cache/incr/${encodeURIComponent(key)}`, undefined, options)
             return await resp.json() as IncrResponse
         }
 
-        public async PostList(key: number, val: string): Promise<void> {
-            await this.baseClient.callAPI("POST", `/cache/list/${encodeURIComponent(key)}/${encodeURIComponent(val)}`)
+        public async PostList(key: number, val: string, options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/cache/list/${encodeURIComponent(key)}/${encodeURIComponent(val)}`, undefined, options)
         }
 
-        public async PostStruct(key: number, val: string): Promise<void> {
-            await this.baseClient.callAPI("POST", `/cache/struct/${encodeURIComponent(key)}/${encodeURIComponent(val)}`)
+        public async PostStruct(key: number, val: string, options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/cache/struct/${encodeURIComponent(key)}/${encodeURIComponent(val)}`, undefined, options)
         }
     }
 }
@@ -136,17 +155,17 @@ export namespace di {
             this.baseClient = baseClient
         }
 
-        public async One(): Promise<void> {
-            await this.baseClient.callAPI("POST", `/di/one`)
+        public async One(options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/di/one`, undefined, options)
         }
 
         public async Three(method: string, body?: BodyInit, options?: CallParameters): Promise<Response> {
             return this.baseClient.callAPI(method, `/di/raw`, body, options)
         }
 
-        public async Two(): Promise<TwoResponse> {
+        public async Two(options?: CallOptions): Promise<TwoResponse> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/di/two`)
+            const resp = await this.baseClient.callAPI("POST", `/di/two`, undefined, options)
             return await resp.json() as TwoResponse
         }
     }
@@ -260,58 +279,58 @@ export namespace echo {
         /**
          * AppMeta returns app metadata.
          */
-        public async AppMeta(): Promise<AppMetadata> {
+        public async AppMeta(options?: CallOptions): Promise<AppMetadata> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/echo.AppMeta`)
+            const resp = await this.baseClient.callAPI("POST", `/echo.AppMeta`, undefined, options)
             return await resp.json() as AppMetadata
         }
 
         /**
          * BasicEcho echoes back the request data.
          */
-        public async BasicEcho(params: BasicData): Promise<BasicData> {
+        public async BasicEcho(params: BasicData, options?: CallOptions): Promise<BasicData> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/echo.BasicEcho`, JSON.stringify(params))
+            const resp = await this.baseClient.callAPI("POST", `/echo.BasicEcho`, JSON.stringify(params), options)
             return await resp.json() as BasicData
         }
 
-        public async ConfigValues(): Promise<ConfigResponse> {
+        public async ConfigValues(options?: CallOptions): Promise<ConfigResponse> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/echo.ConfigValues`)
+            const resp = await this.baseClient.callAPI("POST", `/echo.ConfigValues`, undefined, options)
             return await resp.json() as ConfigResponse
         }
 
         /**
          * Echo echoes back the request data.
          */
-        public async Echo(params: Data<string, number>): Promise<Data<string, number>> {
+        public async Echo(params: Data<string, number>, options?: CallOptions): Promise<Data<string, number>> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/echo.Echo`, JSON.stringify(params))
+            const resp = await this.baseClient.callAPI("POST", `/echo.Echo`, JSON.stringify(params), options)
             return await resp.json() as Data<string, number>
         }
 
         /**
          * EmptyEcho echoes back the request data.
          */
-        public async EmptyEcho(params: EmptyData): Promise<EmptyData> {
+        public async EmptyEcho(params: EmptyData, options?: CallOptions): Promise<EmptyData> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/echo.EmptyEcho`, JSON.stringify(params))
+            const resp = await this.baseClient.callAPI("POST", `/echo.EmptyEcho`, JSON.stringify(params), options)
             return await resp.json() as EmptyData
         }
 
         /**
          * Env returns the environment.
          */
-        public async Env(): Promise<EnvResponse> {
+        public async Env(options?: CallOptions): Promise<EnvResponse> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/echo.Env`)
+            const resp = await this.baseClient.callAPI("POST", `/echo.Env`, undefined, options)
             return await resp.json() as EnvResponse
         }
 
         /**
          * HeadersEcho echoes back the request headers
          */
-        public async HeadersEcho(params: HeadersData): Promise<HeadersData> {
+        public async HeadersEcho(params: HeadersData, options?: CallOptions): Promise<HeadersData> {
             // Convert our params into the objects we need for the request
             const headers: Record<string, string> = {
                 "x-int":    String(params.Int),
@@ -319,7 +338,7 @@ export namespace echo {
             }
 
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/echo.HeadersEcho`, undefined, {headers})
+            const resp = await this.baseClient.callAPI("POST", `/echo.HeadersEcho`, undefined, {...options, headers})
 
             //Populate the return object from the JSON body and received headers
             const rtn = await resp.json() as HeadersData
@@ -331,29 +350,29 @@ export namespace echo {
         /**
          * MuteEcho absorbs a request
          */
-        public async MuteEcho(params: Data<string, string>): Promise<void> {
+        public async MuteEcho(params: Data<string, string>, options?: CallOptions): Promise<void> {
             // Convert our params into the objects we need for the request
             const query: Record<string, string | string[]> = {
                 key:   params.Key,
                 value: params.Value,
             }
 
-            await this.baseClient.callAPI("GET", `/echo.MuteEcho`, undefined, {query})
+            await this.baseClient.callAPI("GET", `/echo.MuteEcho`, undefined, {...options, query})
         }
 
         /**
          * NilResponse returns a nil response and nil error
          */
-        public async NilResponse(): Promise<BasicData> {
+        public async NilResponse(options?: CallOptions): Promise<BasicData> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/echo.NilResponse`)
+            const resp = await this.baseClient.callAPI("POST", `/echo.NilResponse`, undefined, options)
             return await resp.json() as BasicData
         }
 
         /**
          * NonBasicEcho echoes back the request data.
          */
-        public async NonBasicEcho(pathString: string, pathInt: number, pathWild: string[], params: NonBasicData): Promise<NonBasicData> {
+        public async NonBasicEcho(pathString: string, pathInt: number, pathWild: string[], params: NonBasicData, options?: CallOptions): Promise<NonBasicData> {
             // Convert our params into the objects we need for the request
             const headers: Record<string, string> = {
                 "x-header-number": String(params.HeaderNumber),
@@ -383,7 +402,7 @@ export namespace echo {
             }
 
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/NonBasicEcho/${encodeURIComponent(pathString)}/${encodeURIComponent(pathInt)}/${pathWild.map(encodeURIComponent).join("/")}`, JSON.stringify(body), {headers, query})
+            const resp = await this.baseClient.callAPI("POST", `/NonBasicEcho/${encodeURIComponent(pathString)}/${encodeURIComponent(pathInt)}/${pathWild.map(encodeURIComponent).join("/")}`, JSON.stringify(body), {...options, headers, query})
 
             //Populate the return object from the JSON body and received headers
             const rtn = await resp.json() as NonBasicData
@@ -395,24 +414,24 @@ export namespace echo {
         /**
          * Noop does nothing
          */
-        public async Noop(): Promise<void> {
-            await this.baseClient.callAPI("GET", `/echo.Noop`)
+        public async Noop(options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("GET", `/echo.Noop`, undefined, options)
         }
 
         /**
          * Pong returns a bird tuple
          */
-        public async Pong(): Promise<Data<string, string>> {
+        public async Pong(options?: CallOptions): Promise<Data<string, string>> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("GET", `/echo.Pong`)
+            const resp = await this.baseClient.callAPI("GET", `/echo.Pong`, undefined, options)
             return await resp.json() as Data<string, string>
         }
 
         /**
          * Publish publishes a request on a topic
          */
-        public async Publish(): Promise<void> {
-            await this.baseClient.callAPI("POST", `/echo.Publish`)
+        public async Publish(options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/echo.Publish`, undefined, options)
         }
     }
 }
@@ -426,8 +445,8 @@ export namespace endtoend {
             this.baseClient = baseClient
         }
 
-        public async GeneratedWrappersEndToEndTest(): Promise<void> {
-            await this.baseClient.callAPI("GET", `/generated-wrappers-end-to-end-test`)
+        public async GeneratedWrappersEndToEndTest(options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("GET", `/generated-wrappers-end-to-end-test`, undefined, options)
         }
     }
 }
@@ -444,19 +463,19 @@ export namespace middleware {
             this.baseClient = baseClient
         }
 
-        public async Error(): Promise<void> {
-            await this.baseClient.callAPI("POST", `/middleware.Error`)
+        public async Error(options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/middleware.Error`, undefined, options)
         }
 
-        public async ResponseGen(params: Payload): Promise<Payload> {
+        public async ResponseGen(params: Payload, options?: CallOptions): Promise<Payload> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/middleware.ResponseGen`, JSON.stringify(params))
+            const resp = await this.baseClient.callAPI("POST", `/middleware.ResponseGen`, JSON.stringify(params), options)
             return await resp.json() as Payload
         }
 
-        public async ResponseRewrite(params: Payload): Promise<Payload> {
+        public async ResponseRewrite(params: Payload, options?: CallOptions): Promise<Payload> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/middleware.ResponseRewrite`, JSON.stringify(params))
+            const resp = await this.baseClient.callAPI("POST", `/middleware.ResponseRewrite`, JSON.stringify(params), options)
             return await resp.json() as Payload
         }
     }
@@ -529,9 +548,9 @@ export namespace test {
          * GetMessage allows us to test an API which takes no parameters,
          * but returns data. It also tests two API's on the same path with different HTTP methods
          */
-        public async GetMessage(clientID: string): Promise<BodyEcho> {
+        public async GetMessage(clientID: string, options?: CallOptions): Promise<BodyEcho> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("GET", `/last_message/${encodeURIComponent(clientID)}`)
+            const resp = await this.baseClient.callAPI("GET", `/last_message/${encodeURIComponent(clientID)}`, undefined, options)
             return await resp.json() as BodyEcho
         }
 
@@ -539,7 +558,7 @@ export namespace test {
          * MarshallerTestHandler allows us to test marshalling of all the inbuilt types in all
          * the field types. It simply echos all the responses back to the client
          */
-        public async MarshallerTestHandler(params: MarshallerTest<number>): Promise<MarshallerTest<number>> {
+        public async MarshallerTestHandler(params: MarshallerTest<number>, options?: CallOptions): Promise<MarshallerTest<number>> {
             // Convert our params into the objects we need for the request
             const headers: Record<string, string> = {
                 "x-boolean": String(params.HeaderBoolean),
@@ -581,7 +600,7 @@ export namespace test {
             }
 
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/test.MarshallerTestHandler`, JSON.stringify(body), {headers, query})
+            const resp = await this.baseClient.callAPI("POST", `/test.MarshallerTestHandler`, JSON.stringify(body), {...options, headers, query})
 
             //Populate the return object from the JSON body and received headers
             const rtn = await resp.json() as MarshallerTest<number>
@@ -600,23 +619,23 @@ export namespace test {
         /**
          * Noop allows us to test if a simple HTTP request can be made
          */
-        public async Noop(): Promise<void> {
-            await this.baseClient.callAPI("POST", `/test.Noop`)
+        public async Noop(options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/test.Noop`, undefined, options)
         }
 
         /**
          * NoopWithError allows us to test if the structured errors are returned
          */
-        public async NoopWithError(): Promise<void> {
-            await this.baseClient.callAPI("POST", `/test.NoopWithError`)
+        public async NoopWithError(options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/test.NoopWithError`, undefined, options)
         }
 
         /**
          * PathMultiSegments allows us to wildcard segments and segment URI encoding
          */
-        public async PathMultiSegments(bool: boolean, int: number, _string: string, uuid: string, wildcard: string[]): Promise<MultiPathSegment> {
+        public async PathMultiSegments(bool: boolean, int: number, _string: string, uuid: string, wildcard: string[], options?: CallOptions): Promise<MultiPathSegment> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/multi/${encodeURIComponent(bool)}/${encodeURIComponent(int)}/${encodeURIComponent(_string)}/${encodeURIComponent(uuid)}/${wildcard.map(encodeURIComponent).join("/")}`)
+            const resp = await this.baseClient.callAPI("POST", `/multi/${encodeURIComponent(bool)}/${encodeURIComponent(int)}/${encodeURIComponent(_string)}/${encodeURIComponent(uuid)}/${wildcard.map(encodeURIComponent).join("/")}`, undefined, options)
             return await resp.json() as MultiPathSegment
         }
 
@@ -632,7 +651,7 @@ export namespace test {
          * RestStyleAPI tests all the ways we can get data into and out of the application
          * using Encore request handlers
          */
-        public async RestStyleAPI(objType: number, name: string, params: RestParams): Promise<RestParams> {
+        public async RestStyleAPI(objType: number, name: string, params: RestParams, options?: CallOptions): Promise<RestParams> {
             // Convert our params into the objects we need for the request
             const headers: Record<string, string> = {
                 "some-key": params.HeaderValue,
@@ -649,7 +668,7 @@ export namespace test {
             }
 
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("PUT", `/rest/object/${encodeURIComponent(objType)}/${encodeURIComponent(name)}`, JSON.stringify(body), {headers, query})
+            const resp = await this.baseClient.callAPI("PUT", `/rest/object/${encodeURIComponent(objType)}/${encodeURIComponent(name)}`, JSON.stringify(body), {...options, headers, query})
 
             //Populate the return object from the JSON body and received headers
             const rtn = await resp.json() as RestParams
@@ -661,18 +680,18 @@ export namespace test {
          * SimpleBodyEcho allows us to exercise the body marshalling from JSON
          * and being returned purely as a body
          */
-        public async SimpleBodyEcho(params: BodyEcho): Promise<BodyEcho> {
+        public async SimpleBodyEcho(params: BodyEcho, options?: CallOptions): Promise<BodyEcho> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/test.SimpleBodyEcho`, JSON.stringify(params))
+            const resp = await this.baseClient.callAPI("POST", `/test.SimpleBodyEcho`, JSON.stringify(params), options)
             return await resp.json() as BodyEcho
         }
 
         /**
          * TestAuthHandler allows us to test the clients ability to add tokens to requests
          */
-        public async TestAuthHandler(): Promise<BodyEcho> {
+        public async TestAuthHandler(options?: CallOptions): Promise<BodyEcho> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/test.TestAuthHandler`)
+            const resp = await this.baseClient.callAPI("POST", `/test.TestAuthHandler`, undefined, options)
             return await resp.json() as BodyEcho
         }
 
@@ -680,8 +699,8 @@ export namespace test {
          * UpdateMessage allows us to test an API which takes parameters,
          * but doesn't return anything
          */
-        public async UpdateMessage(clientID: string, params: BodyEcho): Promise<void> {
-            await this.baseClient.callAPI("PUT", `/last_message/${encodeURIComponent(clientID)}`, JSON.stringify(params))
+        public async UpdateMessage(clientID: string, params: BodyEcho, options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("PUT", `/last_message/${encodeURIComponent(clientID)}`, JSON.stringify(params), options)
         }
     }
 }
@@ -698,8 +717,8 @@ export namespace validation {
             this.baseClient = baseClient
         }
 
-        public async TestOne(params: Request): Promise<void> {
-            await this.baseClient.callAPI("POST", `/validation.TestOne`, JSON.stringify(params))
+        public async TestOne(params: Request, options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/validation.TestOne`, JSON.stringify(params), options)
         }
     }
 }
@@ -708,6 +727,48 @@ export namespace validation {
 export type JSONValue = string | number | boolean | null | JSONValue[] | {[key: string]: JSONValue}
 
 
+// retryableMethods returns the HTTP methods the given retry policy applies to
+function retryableMethods(policy: RetryPolicy): string[] {
+    return policy.methods ?? ["GET", "HEAD", "OPTIONS", "PUT", "DELETE"]
+}
+
+// isRetryableError reports whether a request which failed with the given error should be retried
+function isRetryableError(policy: RetryPolicy, err: any): boolean {
+    if (err instanceof APIError) {
+        return (policy.codes ?? [ErrCode.Unavailable, ErrCode.ResourceExhausted]).includes(err.code)
+    }
+
+    // fetch rejects with a TypeError on network failures
+    return err instanceof TypeError
+}
+
+// retryBackoff returns how long to wait before retrying the given attempt, using exponential backoff with full jitter
+function retryBackoff(policy: RetryPolicy, attempt: number): number {
+    const initial = policy.initialBackoff ?? 100
+    const max = policy.maxBackoff ?? 5000
+    return Math.random() * Math.min(max, initial * Math.pow(2, attempt - 1))
+}
+
+// sleep waits for ms milliseconds, ending early with a Canceled error if the signal is aborted.
+function sleep(ms: number, signal?: AbortSignal | null): Promise<void> {
+    return new Promise((resolve, reject) => {
+        const canceled = () => new APIError(499, { code: ErrCode.Canceled, message: "request canceled" })
+        if (signal?.aborted) {
+            reject(canceled())
+            return
+        }
+        const onAbort = () => {
+            clearTimeout(timer)
+            reject(canceled())
+        }
+        const timer = setTimeout(() => {
+            signal?.removeEventListener("abort", onAbort)
+            resolve()
+        }, ms)
+        signal?.addEventListener("abort", onAbort, { once: true })
+    })
+}
+
 function encodeQuery(parts: Record<string, string | string[]>): string {
     const pairs: string[] = []
     for (const key in parts) {
@@ -734,8 +795,22 @@ function mustBeSet<A>(field: string, value: A | null | undefined): A {
     return value
 }
 
+/**
+ * CallOptions allows you to control the behaviour of an individual API call.
+ */
+export interface CallOptions {
+    /** A signal which can be used to abort the request */
+    signal?: AbortSignal
+
+    /** The number of milliseconds after which the request is aborted, overriding the client's timeout */
+    timeout?: number
+
+    /** The retry policy for this call, overriding the client's retry policy */
+    retry?: RetryPolicy
+}
+
 // CallParameters is the type of the parameters to a method call, but require headers to be a Record type
-type CallParameters = Omit<RequestInit, "method" | "body"> & {
+type CallParameters = Omit<RequestInit, "method" | "body"> & CallOptions & {
     /** Any headers to be sent with the request */
     headers?: Record<string, string>;
 
@@ -743,6 +818,43 @@ type CallParameters = Omit<RequestInit, "method" | "body"> & {
     query?: Record<string, string | string[]>
 }
 
+/**
+ * RetryPolicy describes when and how failed requests are retried.
+ *
+ * Only requests using one of the given methods are retried, and only when they
+ * failed with a network error or one of the given error codes.
+ */
+export interface RetryPolicy {
+    /** The maximum number of attempts, including the initial request */
+    maxAttempts: number
+
+    /** The delay in milliseconds before the first retry, doubled for every subsequent retry. Defaults to 100 */
+    initialBackoff?: number
+
+    /** The maximum delay in milliseconds between retries. Defaults to 5000 */
+    maxBackoff?: number
+
+    /** The HTTP methods which are safe to retry. Defaults to the idempotent methods */
+    methods?: string[]
+
+    /** The error codes which are retried. Defaults to ErrCode.Unavailable and ErrCode.ResourceExhausted */
+    codes?: ErrCode[]
+}
+
+// RequestInterceptor is called with each request before it is sent, and returns the request to send
+export type RequestInterceptor = (url: string, init: RequestInit) => RequestInit | Promise<RequestInit>
+
+// ResponseInterceptor is called with each response before it is processed, and returns the response to process
+export type ResponseInterceptor = (response: Response) => Response | Promise<Response>
+
+/**
+ * Interceptors allows you to run code on each request made and response received by the client.
+ */
+export interface Interceptors {
+    request?: RequestInterceptor[]
+    response?: ResponseInterceptor[]
+}
+
 // AuthDataGenerator is a function that returns a new instance of the authentication data required by this API
 export type AuthDataGenerator = () => (echo.AuthParams | undefined)
 
@@ -755,6 +867,9 @@ class BaseClient {
     readonly baseURL: string
     readonly fetcher: Fetcher
     readonly headers: Record<string, string>
+    readonly timeout?: number
+    readonly retry?: RetryPolicy
+    readonly interceptors: Interceptors
     readonly authGenerator?: AuthDataGenerator
 
     constructor(baseURL: string, options: ClientOptions) {
@@ -771,6 +886,10 @@ class BaseClient {
             this.fetcher = boundFetch
         }
 
+        this.timeout = options.timeout
+        this.retry = options.retry
+        this.interceptors = options.interceptors ?? {}
+
         // Setup an authentication data generator using the auth data token option
         if (options.auth !== undefined) {
             const auth = options.auth
@@ -785,7 +904,7 @@ class BaseClient {
 
     // callAPI is used by each generated API method to actually make the request
     public async callAPI(method: string, path: string, body?: BodyInit, params?: CallParameters): Promise<Response> {
-        let { query, ...rest } = params ?? {}
+        let { query, timeout, retry, ...rest } = params ?? {}
         const init = {
             ...rest,
             method,
@@ -811,9 +930,71 @@ class BaseClient {
             init.headers["authorization"] = authData.Authorization
         }
 
-        // Make the actual request
         const queryString = query ? '?' + encodeQuery(query) : ''
-        const response = await this.fetcher(this.baseURL+path+queryString, init)
+        const url = this.baseURL+path+queryString
+
+        // Only retry requests which are safe to retry and whose body can be sent again
+        const policy = retry ?? this.retry
+        const replayable = body === undefined || typeof body === "string"
+        const maxAttempts = policy && replayable && retryableMethods(policy).includes(method) ? policy.maxAttempts : 1
+
+        for (let attempt = 1; ; attempt++) {
+            try {
+                return await this.doRequest(url, init, timeout ?? this.timeout)
+            } catch (err) {
+                if (attempt >= maxAttempts || !isRetryableError(policy!, err)) {
+                    throw err
+                }
+                await sleep(retryBackoff(policy!, attempt), init.signal)
+            }
+        }
+    }
+
+    // doRequest makes a single attempt at the request, running the interceptors and enforcing the timeout
+    private async doRequest(url: string, init: RequestInit, timeout?: number): Promise<Response> {
+        for (const interceptor of this.interceptors.request ?? []) {
+            init = await interceptor(url, init)
+        }
+
+        // Abort the request if it takes longer than the timeout, while still respecting the caller's signal
+        let timer: ReturnType<typeof setTimeout> | undefined
+        let timedOut = false
+        const signal = init.signal
+        let onAbort: (() => void) | undefined
+        if (timeout !== undefined) {
+            const controller = new AbortController()
+            if (signal?.aborted) {
+                controller.abort()
+            } else if (signal) {
+                onAbort = () => controller.abort()
+                signal.addEventListener("abort", onAbort, { once: true })
+            }
+            timer = setTimeout(() => {
+                timedOut = true
+                controller.abort()
+            }, timeout)
+            init = {...init, signal: controller.signal}
+        }
+
+        // Make the actual request
+        let response: Response
+        try {
+            response = await this.fetcher(url, init)
+        } catch (err) {
+            if (timedOut) {
+                throw new APIError(504, { code: ErrCode.DeadlineExceeded, message: `request timed out after ${timeout}ms` })
+            }
+            throw err
+        } finally {
+            clearTimeout(timer)
+            if (onAbort) {
+                signal?.removeEventListener("abort", onAbort)
+            }
+        }
+
+        for (const interceptor of this.interceptors.response ?? []) {
+            response = await interceptor(response)
+        }
 
         // handle any error responses
         if (!response.ok) {
@@ -48,8 +48,15 @@ func Detect(path string) (lang Lang, ok bool) {
 	}
 }
 
+// GenOptions are options for generating a client.
+type GenOptions struct {
+	// APIVersion is the API version to generate the client for.
+	// If zero, all versions of versioned APIs are included.
+	APIVersion int
+}
+
 // Client generates an API client based on the given app metadata.
-func Client(lang Lang, appSlug string, md *meta.Data) (code []byte, err error) {
+func Client(lang Lang, appSlug string, md *meta.Data, opts GenOptions) (code []byte, err error) {
 	defer func() {
 		if e := recover(); e != nil {
 			err = srcerrors.UnhandledPanic(e)
@@ -70,6 +77,8 @@ func Client(lang Lang, appSlug string, md *meta.Data) (code []byte, err error) {
 		return nil, ErrUnknownLang
 	}
 
+	md = resolveVersions(md, opts.APIVersion)
+
 	var buf bytes.Buffer
 	if err := gen.Generate(&buf, appSlug, md); err != nil {
 		return nil, fmt.Errorf("genclient.Generate %s %s: %v", lang, appSlug, err)
@@ -225,6 +225,56 @@ Defaults to http.DefaultClient`,
 		},
 	)
 
+	// Generate the WithTimeout function
+	g.generateOptionFunc(
+		file,
+		"Timeout",
+		`sets the timeout for API calls made with a context which has no deadline.
+
+This does not apply to raw endpoints, as their response body is read by the caller.`,
+		&Statement{Id("timeout").Qual("time", "Duration")},
+		&Statement{
+			Id("base").Dot("timeout").Op("=").Id("timeout"),
+			Return(Nil()),
+		},
+	)
+
+	// Generate the WithRetryPolicy function
+	g.generateOptionFunc(
+		file,
+		"RetryPolicy",
+		`configures how failed API calls are retried.
+
+By default API calls are not retried. Raw endpoints are never retried.`,
+		&Statement{Id("policy").Id("RetryPolicy")},
+		&Statement{
+			Id("base").Dot("retryPolicy").Op("=").Op("&").Id("policy"),
+			Return(Nil()),
+		},
+	)
+
+	// Generate the interceptor functions
+	g.generateOptionFunc(
+		file,
+		"RequestInterceptor",
+		"adds a function which is called with each request before it is sent.\n\nInterceptors are called in the order they are added.",
+		&Statement{Id("interceptor").Id("RequestInterceptor")},
+		&Statement{
+			Id("base").Dot("requestInterceptors").Op("=").Append(Id("base").Dot("requestInterceptors"), Id("interceptor")),
+			Return(Nil()),
+		},
+	)
+	g.generateOptionFunc(
+		file,
+		"ResponseInterceptor",
+		"adds a function which is called with each response before it is processed.\n\nInterceptors are called in the order they are added.",
+		&Statement{Id("interceptor").Id("ResponseInterceptor")},
+		&Statement{
+			Id("base").Dot("responseInterceptors").Op("=").Append(Id("base").Dot("responseInterceptors"), Id("interceptor")),
+			Return(Nil()),
+		},
+	)
+
 	if g.md.AuthHandler != nil {
 		typ := g.getType(g.md.AuthHandler.Params)
 		rawType := typ
@@ -310,13 +360,13 @@ func (g *golang) generateServiceClient(file *File, service *meta.Service) error
 		}
 
 		// Add the documentation for the API to the interface method
-		if rpc.Doc != "" {
+		if doc := rpcDoc(g.md, rpc); doc != "" {
 			// Add a newline if this is not the first method
 			if len(interfaceMethods) > 0 {
 				interfaceMethods = append(interfaceMethods, Line())
 			}
 
-			for _, line := range strings.Split(strings.TrimSpace(rpc.Doc), "\n") {
+			for _, line := range strings.Split(strings.TrimSpace(doc), "\n") {
 				interfaceMethods = append(interfaceMethods, Comment(line))
 			}
 		}
@@ -341,7 +391,7 @@ func (g *golang) generateServiceClient(file *File, service *meta.Service) error
 			continue
 		}
 
-		for _, line := range strings.Split(strings.TrimSpace(rpc.Doc), "\n") {
+		for _, line := range strings.Split(strings.TrimSpace(rpcDoc(g.md, rpc)), "\n") {
 			if line != "" {
 				file.Comment(line)
 			}
@@ -997,6 +1047,43 @@ func (g *golang) generateBaseClient(file *File) (err error) {
 			Params(Op("*").Qual("net/http", "Response"), Error()),
 	)
 
+	// Add the retry policy and interceptor types
+	file.Line()
+	file.Comment("RetryPolicy describes when and how failed API calls are retried.")
+	file.Comment("")
+	file.Comment("Only calls using one of the Methods are retried, and only when they failed")
+	file.Comment("with a network error or an APIError with one of the Codes.")
+	file.Type().Id("RetryPolicy").Struct(
+		Id("MaxAttempts").Int().Comment("The maximum number of attempts, including the initial call"),
+		Id("InitialBackoff").Qual("time", "Duration").Comment("The delay before the first retry, doubled for every subsequent retry (defaults to 100ms)"),
+		Id("MaxBackoff").Qual("time", "Duration").Comment("The maximum delay between retries (defaults to 5s)"),
+		Id("Methods").Index().String().Comment("The HTTP methods which are safe to retry (defaults to the idempotent methods)"),
+		Id("Codes").Index().Id("ErrCode").Comment("The error codes which are retried (defaults to ErrUnavailable and ErrResourceExhausted)"),
+	)
+	file.Line()
+	file.Comment("RequestInterceptor is called with each request before it is sent.")
+	file.Comment("Returning an error aborts the request.")
+	file.Type().Id("RequestInterceptor").Op("=").Func().Params(Id("req").Op("*").Qual("net/http", "Request")).Error()
+	file.Line()
+	file.Comment("ResponseInterceptor is called with each response before it is processed.")
+	file.Comment("Returning an error fails the request.")
+	file.Type().Id("ResponseInterceptor").Op("=").Func().Params(Id("resp").Op("*").Qual("net/http", "Response")).Error()
+
+	// Add the helper for capturing response headers
+	file.Line()
+	file.Comment("CaptureResponseHeaders returns a copy of ctx which makes the API calls made with it")
+	file.Comment("store the HTTP headers of their response in dst, including for calls returning an APIError.")
+	file.Comment("This can be used to read headers which are not part of the response type, like those set by middleware.")
+	file.Func().Id("CaptureResponseHeaders").
+		Params(Id("ctx").Qual("context", "Context"), Id("dst").Op("*").Qual("net/http", "Header")).
+		Qual("context", "Context").
+		Block(
+			Return(Qual("context", "WithValue").Call(Id("ctx"), Id("responseHeadersKey").Values(), Id("dst"))),
+		)
+	file.Line()
+	file.Comment("responseHeadersKey is the context key for the destination of CaptureResponseHeaders.")
+	file.Type().Id("responseHeadersKey").Struct()
+
 	// Add the base client struct
 	file.Line()
 	file.Comment("baseClient holds all the information we need to make requests to an Encore application")
@@ -1018,6 +1105,18 @@ func (g *golang) generateBaseClient(file *File) (err error) {
 
 		grp.Id("userAgent").String().
 			Commentf("What user agent we will use in the API requests")
+
+		grp.Id("timeout").Qual("time", "Duration").
+			Comment("The timeout for API calls without a deadline")
+
+		grp.Id("retryPolicy").Op("*").Id("RetryPolicy").
+			Comment("How failed API calls are retried (nil means no retries)")
+
+		grp.Id("requestInterceptors").Index().Id("RequestInterceptor").
+			Comment("Called with each request before it is sent")
+
+		grp.Id("responseInterceptors").Index().Id("ResponseInterceptor").
+			Comment("Called with each response before it is processed")
 	})
 
 	// Add the Do method for th base client
@@ -1052,10 +1151,29 @@ func (g *golang) generateBaseClient(file *File) (err error) {
 			grp.Id("req").Dot("Host").Op("=").Id("req").Dot("URL").Dot("Host")
 			grp.Line()
 
-			grp.Comment("Finally, make the request via the configured HTTP Client")
-			grp.Return(
-				Id("b").Dot("httpClient").Dot("Do").Call(Id("req")),
+			grp.Comment("Run the request interceptors")
+			grp.For(List(Id("_"), Id("interceptor")).Op(":=").Range().Id("b").Dot("requestInterceptors")).Block(
+				If(Err().Op(":=").Id("interceptor").Call(Id("req")), Err().Op("!=").Nil()).Block(
+					Return(Nil(), Err()),
+				),
 			)
+			grp.Line()
+
+			grp.Comment("Make the request via the configured HTTP Client")
+			grp.List(Id("resp"), Err()).Op(":=").Id("b").Dot("httpClient").Dot("Do").Call(Id("req"))
+			grp.If(Err().Op("!=").Nil()).Block(
+				Return(Nil(), Err()),
+			)
+			grp.Line()
+
+			grp.Comment("Finally, run the response interceptors")
+			grp.For(List(Id("_"), Id("interceptor")).Op(":=").Range().Id("b").Dot("responseInterceptors")).Block(
+				If(Err().Op(":=").Id("interceptor").Call(Id("resp")), Err().Op("!=").Nil()).Block(
+					Id("_").Op("=").Id("resp").Dot("Body").Dot("Close").Call(),
+					Return(Nil(), Err()),
+				),
+			)
+			grp.Return(Id("resp"), Nil())
 		})
 	if err != nil {
 		return
@@ -1076,16 +1194,69 @@ func (g *golang) generateBaseClient(file *File) (err error) {
 		).
 		Params(Qual("net/http", "Header"), Error()).
 		Block(
+			Comment("Apply the default timeout if the caller has not set a deadline"),
+			If(
+				List(Id("_"), Id("ok")).Op(":=").Id("ctx").Dot("Deadline").Call(),
+				Op("!").Id("ok").Op("&&").Id("client").Dot("timeout").Op(">").Lit(0),
+			).Block(
+				Var().Id("cancel").Qual("context", "CancelFunc"),
+				List(Id("ctx"), Id("cancel")).Op("=").Qual("context", "WithTimeout").Call(Id("ctx"), Id("client").Dot("timeout")),
+				Defer().Id("cancel").Call(),
+			),
+			Line(),
+
 			Comment("Encode the API body"),
-			Var().Id("bodyReader").Qual("io", "Reader"),
+			Var().Id("bodyBytes").Index().Byte(),
 			If(Id("body").Op("!=").Nil()).Block(
-				List(Id("bodyBytes"), Err()).Op(":=").
+				Var().Err().Error(),
+				List(Id("bodyBytes"), Err()).Op("=").
 					Qual("encoding/json", "Marshal").
 					Call(Id("body")),
 				If(Err().Op("!=").Nil()).Block(
 					Return(Nil(), Qual("fmt", "Errorf").Call(Lit("marshal request: %w"), Err())),
 				),
+			),
+			Line(),
 
+			Comment("Make the call, retrying it as long as the retry policy allows"),
+			For(Id("attempt").Op(":=").Lit(1), Empty(), Id("attempt").Op("++")).Block(
+				List(Id("respHeaders"), Err()).Op(":=").Id("callAPIOnce").Call(
+					Id("ctx"), Id("client"), Id("method"), Id("path"), Id("headers"), Id("bodyBytes"), Id("resp"),
+				),
+				If(Err().Op("==").Nil().Op("||").Op("!").Id("shouldRetry").Call(
+					Id("ctx"), Id("client").Dot("retryPolicy"), Id("method"), Id("attempt"), Err(),
+				)).Block(
+					Return(Id("respHeaders"), Err()),
+				),
+				Line(),
+
+				Select().Block(
+					Case(Op("<-").Qual("time", "After").Call(Id("retryBackoff").Call(Id("client").Dot("retryPolicy"), Id("attempt")))).Block(),
+					Case(Op("<-").Id("ctx").Dot("Done").Call()).Block(
+						Return(Nil(), Err()),
+					),
+				),
+			),
+		)
+
+	// Add the function making a single attempt at an API call
+	file.Line()
+	file.Comment("callAPIOnce makes a single attempt at an API call and decodes the response")
+	file.Func().
+		Id("callAPIOnce").
+		Params(
+			Id("ctx").Qual("context", "Context"),
+			Id("client").Op("*").Id("baseClient"),
+			Id("method"),
+			Id("path").String(),
+			Id("headers").Qual("net/http", "Header"),
+			Id("bodyBytes").Index().Byte(),
+			Id("resp").Any(),
+		).
+		Params(Qual("net/http", "Header"), Error()).
+		Block(
+			Var().Id("bodyReader").Qual("io", "Reader"),
+			If(Id("bodyBytes").Op("!=").Nil()).Block(
 				Id("bodyReader").Op("=").Qual("bytes", "NewReader").Call(Id("bodyBytes")),
 			),
 			Line(),
@@ -1118,6 +1289,12 @@ func (g *golang) generateBaseClient(file *File) (err error) {
 			Defer().Func().Params().Block(
 				Id("_").Op("=").Id("rawResponse").Dot("Body").Dot("Close").Call(),
 			).Call(),
+			If(
+				List(Id("dst"), Id("ok")).Op(":=").Id("ctx").Dot("Value").Call(Id("responseHeadersKey").Values()).Assert(Op("*").Qual("net/http", "Header")),
+				Id("ok"),
+			).Block(
+				Op("*").Id("dst").Op("=").Id("rawResponse").Dot("Header"),
+			),
 			If(Id("rawResponse").Dot("StatusCode").Op(">=").Lit(400)).Block(
 				Comment("Read the full body sent back"),
 				List(Id("body"), Err()).Op(":=").Qual("io", "ReadAll").Call(Id("rawResponse").Dot("Body")),
@@ -1172,6 +1349,96 @@ func (g *golang) generateBaseClient(file *File) (err error) {
 			),
 		)
 
+	// Add the retry helpers
+	file.Line()
+	file.Comment("shouldRetry reports whether an API call which failed with err on the given attempt should be retried")
+	file.Func().
+		Id("shouldRetry").
+		Params(
+			Id("ctx").Qual("context", "Context"),
+			Id("policy").Op("*").Id("RetryPolicy"),
+			Id("method").String(),
+			Id("attempt").Int(),
+			Err().Error(),
+		).
+		Bool().
+		Block(
+			If(Id("policy").Op("==").Nil().Op("||").Id("attempt").Op(">=").Id("policy").Dot("MaxAttempts").Op("||").Id("ctx").Dot("Err").Call().Op("!=").Nil()).Block(
+				Return(False()),
+			),
+			Line(),
+
+			Comment("Only retry methods which are safe to retry"),
+			Id("methods").Op(":=").Id("policy").Dot("Methods"),
+			If(Id("methods").Op("==").Nil()).Block(
+				Id("methods").Op("=").Index().String().Values(
+					Qual("net/http", "MethodGet"),
+					Qual("net/http", "MethodHead"),
+					Qual("net/http", "MethodOptions"),
+					Qual("net/http", "MethodPut"),
+					Qual("net/http", "MethodDelete"),
+				),
+			),
+			Id("retryable").Op(":=").False(),
+			For(List(Id("_"), Id("m")).Op(":=").Range().Id("methods")).Block(
+				If(Id("m").Op("==").Id("method")).Block(
+					Id("retryable").Op("=").True(),
+					Break(),
+				),
+			),
+			If(Op("!").Id("retryable")).Block(
+				Return(False()),
+			),
+			Line(),
+
+			Comment("Retry API errors with one of the retryable codes"),
+			Var().Id("apiErr").Op("*").Id("APIError"),
+			If(Qual("errors", "As").Call(Err(), Op("&").Id("apiErr"))).Block(
+				Id("codes").Op(":=").Id("policy").Dot("Codes"),
+				If(Id("codes").Op("==").Nil()).Block(
+					Id("codes").Op("=").Index().Id("ErrCode").Values(Id("ErrUnavailable"), Id("ErrResourceExhausted")),
+				),
+				For(List(Id("_"), Id("code")).Op(":=").Range().Id("codes")).Block(
+					If(Id("apiErr").Dot("Code").Op("==").Id("code")).Block(
+						Return(True()),
+					),
+				),
+				Return(False()),
+			),
+			Line(),
+
+			Comment("Otherwise only retry errors from failing to get a response at all, such as network errors"),
+			Var().Id("urlErr").Op("*").Qual("net/url", "Error"),
+			Return(Qual("errors", "As").Call(Err(), Op("&").Id("urlErr"))),
+		)
+
+	file.Line()
+	file.Comment("retryBackoff returns how long to wait before retrying the given attempt, using exponential backoff with full jitter")
+	file.Func().
+		Id("retryBackoff").
+		Params(
+			Id("policy").Op("*").Id("RetryPolicy"),
+			Id("attempt").Int(),
+		).
+		Qual("time", "Duration").
+		Block(
+			List(Id("initial"), Id("max")).Op(":=").List(Id("policy").Dot("InitialBackoff"), Id("policy").Dot("MaxBackoff")),
+			If(Id("initial").Op("<=").Lit(0)).Block(
+				Id("initial").Op("=").Lit(100).Op("*").Qual("time", "Millisecond"),
+			),
+			If(Id("max").Op("<=").Lit(0)).Block(
+				Id("max").Op("=").Lit(5).Op("*").Qual("time", "Second"),
+			),
+			Line(),
+
+			Id("backoff").Op(":=").Id("initial").Op("<<").Parens(Id("attempt").Op("-").Lit(1)),
+			If(Id("backoff").Op("<=").Lit(0).Op("||").Id("backoff").Op(">").Id("max")).Block(
+				Comment("Cap the backoff, guarding against overflow for large attempt counts"),
+				Id("backoff").Op("=").Id("max"),
+			),
+			Return(Qual("time", "Duration").Call(Qual("math/rand", "Int63n").Call(Int64().Call(Id("backoff"))))),
+		)
+
 	return nil
 }
 
@@ -1190,6 +1457,7 @@ func (g *golang) writeErrorType(file *File) {
 		Return(Qual("fmt", "Sprintf").Call(Lit("%s: %s"), Id("e").Dot("Code"), Id("e").Dot("Message"))),
 	)
 	file.Line()
+	g.writeErrDetailsDecoder(file)
 	file.Line()
 
 	// Create the ErrCode type and list
@@ -1253,6 +1521,48 @@ func (g *golang) writeErrorType(file *File) {
 	)
 }
 
+// writeErrDetailsDecoder writes an UnmarshalJSON method for APIError that decodes
+// the error details into the error details type reported by the API.
+func (g *golang) writeErrDetailsDecoder(file *File) {
+	if len(g.md.ErrDetails) == 0 {
+		return
+	}
+
+	file.Line()
+	file.Comment("UnmarshalJSON decodes the error. If the error details are of a known type,")
+	file.Comment("Details is set to a pointer to a value of that type, such as *" + g.declToID(g.md.Decls[g.md.ErrDetails[0].Type.GetNamed().Id]).GoString() + ".")
+	file.Comment("Otherwise Details is set to the decoded JSON value.")
+	file.Func().Params(Id("e").Op("*").Id("APIError")).Id("UnmarshalJSON").Params(Id("data").Index().Byte()).Error().Block(
+		Var().Id("raw").Struct(
+			Id("Code").Id("ErrCode").Tag(map[string]string{"json": "code"}),
+			Id("Message").String().Tag(map[string]string{"json": "message"}),
+			Id("Details").Qual("encoding/json", "RawMessage").Tag(map[string]string{"json": "details"}),
+			Id("DetailsType").String().Tag(map[string]string{"json": "details_type"}),
+		),
+		If(Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(Id("data"), Op("&").Id("raw")), Err().Op("!=").Nil()).Block(
+			Return(Err()),
+		),
+		List(Id("e").Dot("Code"), Id("e").Dot("Message"), Id("e").Dot("Details")).Op("=").List(Id("raw").Dot("Code"), Id("raw").Dot("Message"), Nil()),
+		If(Len(Id("raw").Dot("Details")).Op("==").Lit(0).Op("||").String().Call(Id("raw").Dot("Details")).Op("==").Lit("null")).Block(
+			Return(Nil()),
+		),
+		Line(),
+		Var().Id("details").Any(),
+		Switch(Id("raw").Dot("DetailsType")).BlockFunc(func(grp *Group) {
+			for _, det := range g.md.ErrDetails {
+				grp.Case(Lit(det.Name)).Block(
+					Id("details").Op("=").Op("&").Add(g.getType(det.Type)).Values(),
+				)
+			}
+		}),
+		If(Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(Id("raw").Dot("Details"), Op("&").Id("details")), Err().Op("!=").Nil()).Block(
+			Return(Err()),
+		),
+		Id("e").Dot("Details").Op("=").Id("details"),
+		Return(Nil()),
+	)
+}
+
 func (g *golang) writeExtraHelpers(file *File) {
 	if g.seenSlicePath {
 		file.Line()
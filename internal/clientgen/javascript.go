@@ -146,8 +146,8 @@ func (js *javascript) writeService(svc *meta.Service) error {
 		js.WriteByte('\n')
 
 		// Doc string
-		if rpc.Doc != "" {
-			scanner := bufio.NewScanner(strings.NewReader(rpc.Doc))
+		if doc := rpcDoc(js.md, rpc); doc != "" {
+			scanner := bufio.NewScanner(strings.NewReader(doc))
 			indent()
 			js.WriteString("/**\n")
 			for scanner.Scan() {
@@ -31,12 +31,17 @@ type Generator struct {
 	spec      *openapi3.T
 	md        *meta.Data
 	seenDecls map[string]uint32
+
+	// authSchemes maps each auth handler to the names of
+	// the security schemes describing its credentials.
+	authSchemes map[*meta.AuthHandler][]string
 }
 
 func New(version GenVersion) *Generator {
 	return &Generator{
-		ver:       version,
-		seenDecls: make(map[string]uint32),
+		ver:         version,
+		seenDecls:   make(map[string]uint32),
+		authSchemes: make(map[*meta.AuthHandler][]string),
 	}
 }
 
@@ -48,11 +53,15 @@ func (g *Generator) Generate(buf *bytes.Buffer, appSlug string, md *meta.Data) e
 	g.md = md
 	g.spec = newSpec(appSlug)
 
+	if err := g.addSecuritySchemes(); err != nil {
+		return err
+	}
 	for _, svc := range md.Svcs {
 		if err := g.addService(svc); err != nil {
 			return err
 		}
 	}
+	g.addErrDetails()
 
 	out, err := g.spec.MarshalJSON()
 	if err != nil {
@@ -94,6 +103,97 @@ func (g *Generator) addRPC(rpc *meta.RPC) error {
 	return nil
 }
 
+// addSecuritySchemes describes the credentials accepted by the app's auth handlers
+// as security schemes. Token-based handlers are described as bearer tokens,
+// while structured handlers get a scheme per header, query or cookie parameter.
+func (g *Generator) addSecuritySchemes() error {
+	for _, h := range encoding.AuthHandlers(g.md) {
+		name := h.Scheme
+		if name == "" {
+			name = h.Name
+		}
+
+		auth, err := encoding.DescribeAuth(g.md, h.Params, nil)
+		if err != nil {
+			return errors.Wrapf(err, "describe auth handler %s", h.Name)
+		} else if auth == nil {
+			continue
+		}
+
+		summary, _ := splitDoc(h.Doc)
+		if auth.LegacyTokenFormat {
+			g.spec.Components.SecuritySchemes[name] = &openapi3.SecuritySchemeRef{
+				Value: &openapi3.SecurityScheme{
+					Type:        "http",
+					Scheme:      "bearer",
+					Description: summary,
+				},
+			}
+			g.authSchemes[h] = append(g.authSchemes[h], name)
+			continue
+		}
+
+		add := func(in string, params []*encoding.ParameterEncoding) {
+			for _, p := range params {
+				key := name + "." + p.WireFormat
+				g.spec.Components.SecuritySchemes[key] = &openapi3.SecuritySchemeRef{
+					Value: &openapi3.SecurityScheme{
+						Type:        "apiKey",
+						In:          in,
+						Name:        p.WireFormat,
+						Description: summary,
+					},
+				}
+				g.authSchemes[h] = append(g.authSchemes[h], key)
+			}
+		}
+		add(openapi3.ParameterInHeader, auth.HeaderParameters)
+		add(openapi3.ParameterInQuery, auth.QueryParameters)
+		add(openapi3.ParameterInCookie, auth.CookieParameters)
+	}
+	return nil
+}
+
+// rpcSecurity returns the security requirements of rpc.
+// Any credential accepted by one of the endpoint's auth handlers satisfies them,
+// and for public endpoints authentication is optional altogether.
+func (g *Generator) rpcSecurity(rpc *meta.RPC) *openapi3.SecurityRequirements {
+	var reqs openapi3.SecurityRequirements
+	for _, h := range encoding.RPCAuthHandlers(g.md, rpc) {
+		for _, name := range g.authSchemes[h] {
+			reqs = append(reqs, openapi3.SecurityRequirement{name: []string{}})
+		}
+	}
+	if len(reqs) == 0 {
+		return nil
+	}
+	if rpc.AccessType == meta.RPC_PUBLIC {
+		reqs = append(reqs, openapi3.SecurityRequirement{})
+	}
+	return &reqs
+}
+
+// addErrDetails describes the error details types declared in the app
+// as part of the APIError response.
+func (g *Generator) addErrDetails() {
+	if len(g.md.ErrDetails) == 0 {
+		return
+	}
+
+	errSchema := g.spec.Components.Responses["APIError"].Value.Content["application/json"].Schema.Value
+	details := &openapi3.Schema{Description: "Error details"}
+	detailsType := &openapi3.Schema{
+		Description: "The type of the error details, identifying which schema the details conform to",
+		Type:        openapi3.TypeString,
+	}
+	for _, det := range g.md.ErrDetails {
+		details.OneOf = append(details.OneOf, g.schemaType(det.Type))
+		detailsType.Enum = append(detailsType.Enum, det.Name)
+	}
+	errSchema.Properties["details"] = details.NewRef()
+	errSchema.Properties["details_type"] = detailsType.NewRef()
+}
+
 func (g *Generator) getOrCreatePath(rpc *meta.RPC) *openapi3.PathItem {
 	path := rpcPath(rpc)
 	if existing, ok := g.spec.Paths[path]; ok {
@@ -111,6 +211,8 @@ func (g *Generator) newOperationForEncoding(rpc *meta.RPC, method string, reqEnc
 		Description: desc,
 		OperationID: method + ":" + rpc.ServiceName + "." + rpc.Name,
 		Responses:   make(openapi3.Responses),
+		Deprecated:  rpc.Deprecation != nil,
+		Security:    g.rpcSecurity(rpc),
 	}
 
 	// Add path parameters
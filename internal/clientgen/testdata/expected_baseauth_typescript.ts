@@ -72,6 +72,25 @@ export interface ClientOptions {
      */
     fetcher?: Fetcher
 
+    /**
+     * The number of milliseconds after which a request is aborted and an APIError
+     * with the DeadlineExceeded code is thrown. Individual calls can override this
+     * using CallOptions. By default requests do not time out.
+     */
+    timeout?: number
+
+    /**
+     * The retry policy to use for failed requests. Individual calls can override
+     * this using CallOptions. By default requests are not retried.
+     */
+    retry?: RetryPolicy
+
+    /**
+     * Interceptors which are run on each request before it is sent and on each
+     * response after it is received, in the order they are given.
+     */
+    interceptors?: Interceptors
+
     /**
      * Allows you to set the auth token to be used for each request
      * either by passing in a static token string or by passing in a function
@@ -97,21 +116,63 @@ export namespace svc {
         /**
          * DummyAPI is a dummy endpoint.
          */
-        public async DummyAPI(params: Request): Promise<void> {
-            await this.baseClient.callAPI("POST", `/svc.DummyAPI`, JSON.stringify(params))
+        public async DummyAPI(params: Request, options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/svc.DummyAPI`, JSON.stringify(params), options)
         }
 
         /**
          * Private is a basic auth endpoint.
          */
-        public async Private(params: Request): Promise<void> {
-            await this.baseClient.callAPI("POST", `/svc.Private`, JSON.stringify(params))
+        public async Private(params: Request, options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/svc.Private`, JSON.stringify(params), options)
         }
     }
 }
 
 
 
+// retryableMethods returns the HTTP methods the given retry policy applies to
+function retryableMethods(policy: RetryPolicy): string[] {
+    return policy.methods ?? ["GET", "HEAD", "OPTIONS", "PUT", "DELETE"]
+}
+
+// isRetryableError reports whether a request which failed with the given error should be retried
+function isRetryableError(policy: RetryPolicy, err: any): boolean {
+    if (err instanceof APIError) {
+        return (policy.codes ?? [ErrCode.Unavailable, ErrCode.ResourceExhausted]).includes(err.code)
+    }
+
+    // fetch rejects with a TypeError on network failures
+    return err instanceof TypeError
+}
+
+// retryBackoff returns how long to wait before retrying the given attempt, using exponential backoff with full jitter
+function retryBackoff(policy: RetryPolicy, attempt: number): number {
+    const initial = policy.initialBackoff ?? 100
+    const max = policy.maxBackoff ?? 5000
+    return Math.random() * Math.min(max, initial * Math.pow(2, attempt - 1))
+}
+
+// sleep waits for ms milliseconds, ending early with a Canceled error if the signal is aborted.
+function sleep(ms: number, signal?: AbortSignal | null): Promise<void> {
+    return new Promise((resolve, reject) => {
+        const canceled = () => new APIError(499, { code: ErrCode.Canceled, message: "request canceled" })
+        if (signal?.aborted) {
+            reject(canceled())
+            return
+        }
+        const onAbort = () => {
+            clearTimeout(timer)
+            reject(canceled())
+        }
+        const timer = setTimeout(() => {
+            signal?.removeEventListener("abort", onAbort)
+            resolve()
+        }, ms)
+        signal?.addEventListener("abort", onAbort, { once: true })
+    })
+}
+
 function encodeQuery(parts: Record<string, string | string[]>): string {
     const pairs: string[] = []
     for (const key in parts) {
@@ -123,8 +184,28 @@ function encodeQuery(parts: Record<string, string | string[]>): string {
     return pairs.join("&")
 }
 
+/**
+ * CallOptions allows you to control the behaviour of an individual API call.
+ */
+export interface CallOptions {
+    /** A signal which can be used to abort the request */
+    signal?: AbortSignal
+
+    /** The number of milliseconds after which the request is aborted, overriding the client's timeout */
+    timeout?: number
+
+    /** The retry policy for this call, overriding the client's retry policy */
+    retry?: RetryPolicy
+
+    /**
+     * Called with the HTTP headers of the response, including for calls throwing an APIError.
+     * This can be used to read headers which are not part of the response type, like those set by middleware.
+     */
+    onResponseHeaders?: (headers: Headers) => void
+}
+
 // CallParameters is the type of the parameters to a method call, but require headers to be a Record type
-type CallParameters = Omit<RequestInit, "method" | "body"> & {
+type CallParameters = Omit<RequestInit, "method" | "body"> & CallOptions & {
     /** Any headers to be sent with the request */
     headers?: Record<string, string>;
 
@@ -132,6 +213,43 @@ type CallParameters = Omit<RequestInit, "method" | "body"> & {
     query?: Record<string, string | string[]>
 }
 
+/**
+ * RetryPolicy describes when and how failed requests are retried.
+ *
+ * Only requests using one of the given methods are retried, and only when they
+ * failed with a network error or one of the given error codes.
+ */
+export interface RetryPolicy {
+    /** The maximum number of attempts, including the initial request */
+    maxAttempts: number
+
+    /** The delay in milliseconds before the first retry, doubled for every subsequent retry. Defaults to 100 */
+    initialBackoff?: number
+
+    /** The maximum delay in milliseconds between retries. Defaults to 5000 */
+    maxBackoff?: number
+
+    /** The HTTP methods which are safe to retry. Defaults to the idempotent methods */
+    methods?: string[]
+
+    /** The error codes which are retried. Defaults to ErrCode.Unavailable and ErrCode.ResourceExhausted */
+    codes?: ErrCode[]
+}
+
+// RequestInterceptor is called with each request before it is sent, and returns the request to send
+export type RequestInterceptor = (url: string, init: RequestInit) => RequestInit | Promise<RequestInit>
+
+// ResponseInterceptor is called with each response before it is processed, and returns the response to process
+export type ResponseInterceptor = (response: Response) => Response | Promise<Response>
+
+/**
+ * Interceptors allows you to run code on each request made and response received by the client.
+ */
+export interface Interceptors {
+    request?: RequestInterceptor[]
+    response?: ResponseInterceptor[]
+}
+
 // AuthDataGenerator is a function that returns a new instance of the authentication data required by this API
 export type AuthDataGenerator = () => (string | undefined)
 
@@ -144,6 +262,9 @@ class BaseClient {
     readonly baseURL: string
     readonly fetcher: Fetcher
     readonly headers: Record<string, string>
+    readonly timeout?: number
+    readonly retry?: RetryPolicy
+    readonly interceptors: Interceptors
     readonly authGenerator?: AuthDataGenerator
 
     constructor(baseURL: string, options: ClientOptions) {
@@ -160,6 +281,10 @@ class BaseClient {
             this.fetcher = boundFetch
         }
 
+        this.timeout = options.timeout
+        this.retry = options.retry
+        this.interceptors = options.interceptors ?? {}
+
         // Setup an authentication data generator using the auth data token option
         if (options.auth !== undefined) {
             const auth = options.auth
@@ -174,7 +299,7 @@ class BaseClient {
 
     // callAPI is used by each generated API method to actually make the request
     public async callAPI(method: string, path: string, body?: BodyInit, params?: CallParameters): Promise<Response> {
-        let { query, ...rest } = params ?? {}
+        let { query, timeout, retry, onResponseHeaders, ...rest } = params ?? {}
         const init = {
             ...rest,
             method,
@@ -195,9 +320,72 @@ class BaseClient {
             init.headers["Authorization"] = "Bearer " + authData
         }
 
-        // Make the actual request
         const queryString = query ? '?' + encodeQuery(query) : ''
-        const response = await this.fetcher(this.baseURL+path+queryString, init)
+        const url = this.baseURL+path+queryString
+
+        // Only retry requests which are safe to retry and whose body can be sent again
+        const policy = retry ?? this.retry
+        const replayable = body === undefined || typeof body === "string"
+        const maxAttempts = policy && replayable && retryableMethods(policy).includes(method) ? policy.maxAttempts : 1
+
+        for (let attempt = 1; ; attempt++) {
+            try {
+                return await this.doRequest(url, init, timeout ?? this.timeout, onResponseHeaders)
+            } catch (err) {
+                if (attempt >= maxAttempts || !isRetryableError(policy!, err)) {
+                    throw err
+                }
+                await sleep(retryBackoff(policy!, attempt), init.signal)
+            }
+        }
+    }
+
+    // doRequest makes a single attempt at the request, running the interceptors and enforcing the timeout
+    private async doRequest(url: string, init: RequestInit, timeout?: number, onResponseHeaders?: (headers: Headers) => void): Promise<Response> {
+        for (const interceptor of this.interceptors.request ?? []) {
+            init = await interceptor(url, init)
+        }
+
+        // Abort the request if it takes longer than the timeout, while still respecting the caller's signal
+        let timer: ReturnType<typeof setTimeout> | undefined
+        let timedOut = false
+        const signal = init.signal
+        let onAbort: (() => void) | undefined
+        if (timeout !== undefined) {
+            const controller = new AbortController()
+            if (signal?.aborted) {
+                controller.abort()
+            } else if (signal) {
+                onAbort = () => controller.abort()
+                signal.addEventListener("abort", onAbort, { once: true })
+            }
+            timer = setTimeout(() => {
+                timedOut = true
+                controller.abort()
+            }, timeout)
+            init = {...init, signal: controller.signal}
+        }
+
+        // Make the actual request
+        let response: Response
+        try {
+            response = await this.fetcher(url, init)
+        } catch (err) {
+            if (timedOut) {
+                throw new APIError(504, { code: ErrCode.DeadlineExceeded, message: `request timed out after ${timeout}ms` })
+            }
+            throw err
+        } finally {
+            clearTimeout(timer)
+            if (onAbort) {
+                signal?.removeEventListener("abort", onAbort)
+            }
+        }
+
+        for (const interceptor of this.interceptors.response ?? []) {
+            response = await interceptor(response)
+        }
+        onResponseHeaders?.(response.headers)
 
         // handle any error responses
         if (!response.ok) {
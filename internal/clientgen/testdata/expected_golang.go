@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -80,6 +81,46 @@ func WithHTTPClient(client HTTPDoer) Option {
 	}
 }
 
+// WithTimeout sets the timeout for API calls made with a context which has no deadline.
+//
+// This does not apply to raw endpoints, as their response body is read by the caller.
+func WithTimeout(timeout time.Duration) Option {
+	return func(base *baseClient) error {
+		base.timeout = timeout
+		return nil
+	}
+}
+
+// WithRetryPolicy configures how failed API calls are retried.
+//
+// By default API calls are not retried. Raw endpoints are never retried.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(base *baseClient) error {
+		base.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithRequestInterceptor adds a function which is called with each request before it is sent.
+//
+// Interceptors are called in the order they are added.
+func WithRequestInterceptor(interceptor RequestInterceptor) Option {
+	return func(base *baseClient) error {
+		base.requestInterceptors = append(base.requestInterceptors, interceptor)
+		return nil
+	}
+}
+
+// WithResponseInterceptor adds a function which is called with each response before it is processed.
+//
+// Interceptors are called in the order they are added.
+func WithResponseInterceptor(interceptor ResponseInterceptor) Option {
+	return func(base *baseClient) error {
+		base.responseInterceptors = append(base.responseInterceptors, interceptor)
+		return nil
+	}
+}
+
 // WithAuth allows you to set the authentication data to be used with each request
 func WithAuth(auth AuthenticationAuthData) Option {
 	return func(base *baseClient) error {
@@ -113,6 +154,12 @@ type ProductsCreateProductRequest struct {
 	Description    string `json:"description,omitempty"`
 }
 
+// DuplicateProductDetails is returned when a product
+// with the same name already exists.
+type ProductsDuplicateProductDetails struct {
+	ExistingID string `json:"existing_id"`
+}
+
 type ProductsProduct struct {
 	ID          string              `json:"id"`
 	Name        string              `json:"name"`
@@ -133,6 +180,11 @@ type ProductsProductListing struct {
 	} `json:"next"`
 }
 
+// QuotaDetails is returned when the product quota has been reached.
+type ProductsQuotaDetails struct {
+	Limit int `json:"limit"`
+}
+
 // ProductsClient Provides you access to call public and authenticated APIs on products. The concrete implementation is productsClient.
 // It is setup as an interface allowing you to use GoMock to create mock implementations during tests.
 type ProductsClient interface {
@@ -448,12 +500,46 @@ type HTTPDoer interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// RetryPolicy describes when and how failed API calls are retried.
+//
+// Only calls using one of the Methods are retried, and only when they failed
+// with a network error or an APIError with one of the Codes.
+type RetryPolicy struct {
+	MaxAttempts    int           // The maximum number of attempts, including the initial call
+	InitialBackoff time.Duration // The delay before the first retry, doubled for every subsequent retry (defaults to 100ms)
+	MaxBackoff     time.Duration // The maximum delay between retries (defaults to 5s)
+	Methods        []string      // The HTTP methods which are safe to retry (defaults to the idempotent methods)
+	Codes          []ErrCode     // The error codes which are retried (defaults to ErrUnavailable and ErrResourceExhausted)
+}
+
+// RequestInterceptor is called with each request before it is sent.
+// Returning an error aborts the request.
+type RequestInterceptor = func(req *http.Request) error
+
+// ResponseInterceptor is called with each response before it is processed.
+// Returning an error fails the request.
+type ResponseInterceptor = func(resp *http.Response) error
+
+// CaptureResponseHeaders returns a copy of ctx which makes the API calls made with it
+// store the HTTP headers of their response in dst, including for calls returning an APIError.
+// This can be used to read headers which are not part of the response type, like those set by middleware.
+func CaptureResponseHeaders(ctx context.Context, dst *http.Header) context.Context {
+	return context.WithValue(ctx, responseHeadersKey{}, dst)
+}
+
+// responseHeadersKey is the context key for the destination of CaptureResponseHeaders.
+type responseHeadersKey struct{}
+
 // baseClient holds all the information we need to make requests to an Encore application
 type baseClient struct {
-	authGenerator func(ctx context.Context) (AuthenticationAuthData, error) // The function which will add the authentication data to the requests
-	httpClient    HTTPDoer                                                  // The HTTP client which will be used for all API requests
-	baseURL       *url.URL                                                  // The base URL which API requests will be made against
-	userAgent     string                                                    // What user agent we will use in the API requests
+	authGenerator        func(ctx context.Context) (AuthenticationAuthData, error) // The function which will add the authentication data to the requests
+	httpClient           HTTPDoer                                                  // The HTTP client which will be used for all API requests
+	baseURL              *url.URL                                                  // The base URL which API requests will be made against
+	userAgent            string                                                    // What user agent we will use in the API requests
+	timeout              time.Duration                                             // The timeout for API calls without a deadline
+	retryPolicy          *RetryPolicy                                              // How failed API calls are retried (nil means no retries)
+	requestInterceptors  []RequestInterceptor                                      // Called with each request before it is sent
+	responseInterceptors []ResponseInterceptor                                     // Called with each response before it is processed
 }
 
 // Do sends the req to the Encore application adding the authorization token as required.
@@ -482,19 +568,67 @@ func (b *baseClient) Do(req *http.Request) (*http.Response, error) {
 	req.URL = b.baseURL.ResolveReference(req.URL)
 	req.Host = req.URL.Host
 
-	// Finally, make the request via the configured HTTP Client
-	return b.httpClient.Do(req)
+	// Run the request interceptors
+	for _, interceptor := range b.requestInterceptors {
+		if err := interceptor(req); err != nil {
+			return nil, err
+		}
+	}
+
+	// Make the request via the configured HTTP Client
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Finally, run the response interceptors
+	for _, interceptor := range b.responseInterceptors {
+		if err := interceptor(resp); err != nil {
+			_ = resp.Body.Close()
+			return nil, err
+		}
+	}
+	return resp, nil
 }
 
 // callAPI is used by each generated API method to actually make request and decode the responses
 func callAPI(ctx context.Context, client *baseClient, method, path string, headers http.Header, body, resp any) (http.Header, error) {
+	// Apply the default timeout if the caller has not set a deadline
+	if _, ok := ctx.Deadline(); !ok && client.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, client.timeout)
+		defer cancel()
+	}
+
 	// Encode the API body
-	var bodyReader io.Reader
+	var bodyBytes []byte
 	if body != nil {
-		bodyBytes, err := json.Marshal(body)
+		var err error
+		bodyBytes, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("marshal request: %w", err)
 		}
+	}
+
+	// Make the call, retrying it as long as the retry policy allows
+	for attempt := 1; ; attempt++ {
+		respHeaders, err := callAPIOnce(ctx, client, method, path, headers, bodyBytes, resp)
+		if err == nil || !shouldRetry(ctx, client.retryPolicy, method, attempt, err) {
+			return respHeaders, err
+		}
+
+		select {
+		case <-time.After(retryBackoff(client.retryPolicy, attempt)):
+		case <-ctx.Done():
+			return nil, err
+		}
+	}
+}
+
+// callAPIOnce makes a single attempt at an API call and decodes the response
+func callAPIOnce(ctx context.Context, client *baseClient, method, path string, headers http.Header, bodyBytes []byte, resp any) (http.Header, error) {
+	var bodyReader io.Reader
+	if bodyBytes != nil {
 		bodyReader = bytes.NewReader(bodyBytes)
 	}
 
@@ -519,6 +653,9 @@ func callAPI(ctx context.Context, client *baseClient, method, path string, heade
 	defer func() {
 		_ = rawResponse.Body.Close()
 	}()
+	if dst, ok := ctx.Value(responseHeadersKey{}).(*http.Header); ok {
+		*dst = rawResponse.Header
+	}
 	if rawResponse.StatusCode >= 400 {
 		// Read the full body sent back
 		body, err := io.ReadAll(rawResponse.Body)
@@ -550,6 +687,66 @@ func callAPI(ctx context.Context, client *baseClient, method, path string, heade
 	return rawResponse.Header, nil
 }
 
+// shouldRetry reports whether an API call which failed with err on the given attempt should be retried
+func shouldRetry(ctx context.Context, policy *RetryPolicy, method string, attempt int, err error) bool {
+	if policy == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	// Only retry methods which are safe to retry
+	methods := policy.Methods
+	if methods == nil {
+		methods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+	}
+	retryable := false
+	for _, m := range methods {
+		if m == method {
+			retryable = true
+			break
+		}
+	}
+	if !retryable {
+		return false
+	}
+
+	// Retry API errors with one of the retryable codes
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		codes := policy.Codes
+		if codes == nil {
+			codes = []ErrCode{ErrUnavailable, ErrResourceExhausted}
+		}
+		for _, code := range codes {
+			if apiErr.Code == code {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Otherwise only retry errors from failing to get a response at all, such as network errors
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// retryBackoff returns how long to wait before retrying the given attempt, using exponential backoff with full jitter
+func retryBackoff(policy *RetryPolicy, attempt int) time.Duration {
+	initial, max := policy.InitialBackoff, policy.MaxBackoff
+	if initial <= 0 {
+		initial = 100 * time.Millisecond
+	}
+	if max <= 0 {
+		max = 5 * time.Second
+	}
+
+	backoff := initial << (attempt - 1)
+	if backoff <= 0 || backoff > max {
+		// Cap the backoff, guarding against overflow for large attempt counts
+		backoff = max
+	}
+	return time.Duration(rand.Int63n(int64(backoff)))
+}
+
 // pathEscapeSlice escapes a slice of strings and then joins them into a single string
 func pathEscapeSlice(paths []string) string {
 	var escapedPaths strings.Builder
@@ -573,6 +770,38 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Code, e.Message)
 }
 
+// UnmarshalJSON decodes the error. If the error details are of a known type,
+// Details is set to a pointer to a value of that type, such as *ProductsDuplicateProductDetails.
+// Otherwise Details is set to the decoded JSON value.
+func (e *APIError) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Code        ErrCode         `json:"code"`
+		Message     string          `json:"message"`
+		Details     json.RawMessage `json:"details"`
+		DetailsType string          `json:"details_type"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	e.Code, e.Message, e.Details = raw.Code, raw.Message, nil
+	if len(raw.Details) == 0 || string(raw.Details) == "null" {
+		return nil
+	}
+
+	var details any
+	switch raw.DetailsType {
+	case "products.DuplicateProductDetails":
+		details = &ProductsDuplicateProductDetails{}
+	case "products.QuotaDetails":
+		details = &ProductsQuotaDetails{}
+	}
+	if err := json.Unmarshal(raw.Details, &details); err != nil {
+		return err
+	}
+	e.Details = details
+	return nil
+}
+
 type ErrCode int
 
 const (
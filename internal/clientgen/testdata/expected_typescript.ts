@@ -58,6 +58,25 @@ export interface ClientOptions {
      */
     fetcher?: Fetcher
 
+    /**
+     * The number of milliseconds after which a request is aborted and an APIError
+     * with the DeadlineExceeded code is thrown. Individual calls can override this
+     * using CallOptions. By default requests do not time out.
+     */
+    timeout?: number
+
+    /**
+     * The retry policy to use for failed requests. Individual calls can override
+     * this using CallOptions. By default requests are not retried.
+     */
+    retry?: RetryPolicy
+
+    /**
+     * Interceptors which are run on each request before it is sent and on each
+     * response after it is received, in the order they are given.
+     */
+    interceptors?: Interceptors
+
     /**
      * Allows you to set the authentication data to be used for each
      * request either by passing in a static object or by passing in
@@ -84,6 +103,14 @@ export namespace products {
         description: string
     }
 
+    /**
+     * DuplicateProductDetails is returned when a product
+     * with the same name already exists.
+     */
+    export interface DuplicateProductDetails {
+        "existing_id": string
+    }
+
     export interface Product {
         id: string
         name: string
@@ -104,6 +131,13 @@ export namespace products {
         }
     }
 
+    /**
+     * QuotaDetails is returned when the product quota has been reached.
+     */
+    export interface QuotaDetails {
+        limit: number
+    }
+
     export class ServiceClient {
         private baseClient: BaseClient
 
@@ -111,7 +145,7 @@ export namespace products {
             this.baseClient = baseClient
         }
 
-        public async Create(params: CreateProductRequest): Promise<Product> {
+        public async Create(params: CreateProductRequest, options?: CallOptions): Promise<Product> {
             // Convert our params into the objects we need for the request
             const headers: Record<string, string> = {
                 "idempotency-key": params.IdempotencyKey,
@@ -124,13 +158,13 @@ export namespace products {
             }
 
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/products.Create`, JSON.stringify(body), {headers})
+            const resp = await this.baseClient.callAPI("POST", `/products.Create`, JSON.stringify(body), {...options, headers})
             return await resp.json() as Product
         }
 
-        public async List(): Promise<ProductListing> {
+        public async List(options?: CallOptions): Promise<ProductListing> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("GET", `/products.List`)
+            const resp = await this.baseClient.callAPI("GET", `/products.List`, undefined, options)
             return await resp.json() as ProductListing
         }
     }
@@ -223,20 +257,20 @@ export namespace svc {
         /**
          * DummyAPI is a dummy endpoint.
          */
-        public async DummyAPI(params: Request): Promise<void> {
-            await this.baseClient.callAPI("POST", `/svc.DummyAPI`, JSON.stringify(params))
+        public async DummyAPI(params: Request, options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/svc.DummyAPI`, JSON.stringify(params), options)
         }
 
-        public async Get(params: GetRequest): Promise<void> {
+        public async Get(params: GetRequest, options?: CallOptions): Promise<void> {
             // Convert our params into the objects we need for the request
             const query: Record<string, string | string[]> = {
                 boo: String(params.Baz),
             }
 
-            await this.baseClient.callAPI("GET", `/svc.Get`, undefined, {query})
+            await this.baseClient.callAPI("GET", `/svc.Get`, undefined, {...options, query})
         }
 
-        public async GetRequestWithAllInputTypes(params: AllInputTypes<number>): Promise<HeaderOnlyStruct> {
+        public async GetRequestWithAllInputTypes(params: AllInputTypes<number>, options?: CallOptions): Promise<HeaderOnlyStruct> {
             // Convert our params into the objects we need for the request
             const headers: Record<string, string> = {
                 "x-alice": String(params.A),
@@ -249,7 +283,7 @@ export namespace svc {
             }
 
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("GET", `/svc.GetRequestWithAllInputTypes`, undefined, {headers, query})
+            const resp = await this.baseClient.callAPI("GET", `/svc.GetRequestWithAllInputTypes`, undefined, {...options, headers, query})
 
             //Populate the return object from the JSON body and received headers
             const rtn = await resp.json() as HeaderOnlyStruct
@@ -265,7 +299,7 @@ export namespace svc {
             return rtn
         }
 
-        public async HeaderOnlyRequest(params: HeaderOnlyStruct): Promise<void> {
+        public async HeaderOnlyRequest(params: HeaderOnlyStruct, options?: CallOptions): Promise<void> {
             // Convert our params into the objects we need for the request
             const headers: Record<string, string> = {
                 "x-boolean": String(params.Boolean),
@@ -279,14 +313,14 @@ export namespace svc {
                 "x-uuid":    String(params.UUID),
             }
 
-            await this.baseClient.callAPI("GET", `/svc.HeaderOnlyRequest`, undefined, {headers})
+            await this.baseClient.callAPI("GET", `/svc.HeaderOnlyRequest`, undefined, {...options, headers})
         }
 
-        public async RESTPath(a: string, b: number): Promise<void> {
-            await this.baseClient.callAPI("POST", `/path/${encodeURIComponent(a)}/${encodeURIComponent(b)}`)
+        public async RESTPath(a: string, b: number, options?: CallOptions): Promise<void> {
+            await this.baseClient.callAPI("POST", `/path/${encodeURIComponent(a)}/${encodeURIComponent(b)}`, undefined, options)
         }
 
-        public async RequestWithAllInputTypes(params: AllInputTypes<string>): Promise<AllInputTypes<number>> {
+        public async RequestWithAllInputTypes(params: AllInputTypes<string>, options?: CallOptions): Promise<AllInputTypes<number>> {
             // Convert our params into the objects we need for the request
             const headers: Record<string, string> = {
                 "x-alice": String(params.A),
@@ -303,7 +337,7 @@ export namespace svc {
             }
 
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/svc.RequestWithAllInputTypes`, JSON.stringify(body), {headers, query})
+            const resp = await this.baseClient.callAPI("POST", `/svc.RequestWithAllInputTypes`, JSON.stringify(body), {...options, headers, query})
 
             //Populate the return object from the JSON body and received headers
             const rtn = await resp.json() as AllInputTypes<number>
@@ -315,9 +349,9 @@ export namespace svc {
          * TupleInputOutput tests the usage of generics in the client generator
          * and this comment is also multiline, so multiline comments get tested as well.
          */
-        public async TupleInputOutput(params: Tuple<string, WrappedRequest>): Promise<Tuple<boolean, Foo>> {
+        public async TupleInputOutput(params: Tuple<string, WrappedRequest>, options?: CallOptions): Promise<Tuple<boolean, Foo>> {
             // Now make the actual call to the API
-            const resp = await this.baseClient.callAPI("POST", `/svc.TupleInputOutput`, JSON.stringify(params))
+            const resp = await this.baseClient.callAPI("POST", `/svc.TupleInputOutput`, JSON.stringify(params), options)
             return await resp.json() as Tuple<boolean, Foo>
         }
 
@@ -331,6 +365,48 @@ export namespace svc {
 export type JSONValue = string | number | boolean | null | JSONValue[] | {[key: string]: JSONValue}
 
 
+// retryableMethods returns the HTTP methods the given retry policy applies to
+function retryableMethods(policy: RetryPolicy): string[] {
+    return policy.methods ?? ["GET", "HEAD", "OPTIONS", "PUT", "DELETE"]
+}
+
+// isRetryableError reports whether a request which failed with the given error should be retried
+function isRetryableError(policy: RetryPolicy, err: any): boolean {
+    if (err instanceof APIError) {
+        return (policy.codes ?? [ErrCode.Unavailable, ErrCode.ResourceExhausted]).includes(err.code)
+    }
+
+    // fetch rejects with a TypeError on network failures
+    return err instanceof TypeError
+}
+
+// retryBackoff returns how long to wait before retrying the given attempt, using exponential backoff with full jitter
+function retryBackoff(policy: RetryPolicy, attempt: number): number {
+    const initial = policy.initialBackoff ?? 100
+    const max = policy.maxBackoff ?? 5000
+    return Math.random() * Math.min(max, initial * Math.pow(2, attempt - 1))
+}
+
+// sleep waits for ms milliseconds, ending early with a Canceled error if the signal is aborted.
+function sleep(ms: number, signal?: AbortSignal | null): Promise<void> {
+    return new Promise((resolve, reject) => {
+        const canceled = () => new APIError(499, { code: ErrCode.Canceled, message: "request canceled" })
+        if (signal?.aborted) {
+            reject(canceled())
+            return
+        }
+        const onAbort = () => {
+            clearTimeout(timer)
+            reject(canceled())
+        }
+        const timer = setTimeout(() => {
+            signal?.removeEventListener("abort", onAbort)
+            resolve()
+        }, ms)
+        signal?.addEventListener("abort", onAbort, { once: true })
+    })
+}
+
 function encodeQuery(parts: Record<string, string | string[]>): string {
     const pairs: string[] = []
     for (const key in parts) {
@@ -357,8 +433,28 @@ function mustBeSet<A>(field: string, value: A | null | undefined): A {
     return value
 }
 
+/**
+ * CallOptions allows you to control the behaviour of an individual API call.
+ */
+export interface CallOptions {
+    /** A signal which can be used to abort the request */
+    signal?: AbortSignal
+
+    /** The number of milliseconds after which the request is aborted, overriding the client's timeout */
+    timeout?: number
+
+    /** The retry policy for this call, overriding the client's retry policy */
+    retry?: RetryPolicy
+
+    /**
+     * Called with the HTTP headers of the response, including for calls throwing an APIError.
+     * This can be used to read headers which are not part of the response type, like those set by middleware.
+     */
+    onResponseHeaders?: (headers: Headers) => void
+}
+
 // CallParameters is the type of the parameters to a method call, but require headers to be a Record type
-type CallParameters = Omit<RequestInit, "method" | "body"> & {
+type CallParameters = Omit<RequestInit, "method" | "body"> & CallOptions & {
     /** Any headers to be sent with the request */
     headers?: Record<string, string>;
 
@@ -366,6 +462,43 @@ type CallParameters = Omit<RequestInit, "method" | "body"> & {
     query?: Record<string, string | string[]>
 }
 
+/**
+ * RetryPolicy describes when and how failed requests are retried.
+ *
+ * Only requests using one of the given methods are retried, and only when they
+ * failed with a network error or one of the given error codes.
+ */
+export interface RetryPolicy {
+    /** The maximum number of attempts, including the initial request */
+    maxAttempts: number
+
+    /** The delay in milliseconds before the first retry, doubled for every subsequent retry. Defaults to 100 */
+    initialBackoff?: number
+
+    /** The maximum delay in milliseconds between retries. Defaults to 5000 */
+    maxBackoff?: number
+
+    /** The HTTP methods which are safe to retry. Defaults to the idempotent methods */
+    methods?: string[]
+
+    /** The error codes which are retried. Defaults to ErrCode.Unavailable and ErrCode.ResourceExhausted */
+    codes?: ErrCode[]
+}
+
+// RequestInterceptor is called with each request before it is sent, and returns the request to send
+export type RequestInterceptor = (url: string, init: RequestInit) => RequestInit | Promise<RequestInit>
+
+// ResponseInterceptor is called with each response before it is processed, and returns the response to process
+export type ResponseInterceptor = (response: Response) => Response | Promise<Response>
+
+/**
+ * Interceptors allows you to run code on each request made and response received by the client.
+ */
+export interface Interceptors {
+    request?: RequestInterceptor[]
+    response?: ResponseInterceptor[]
+}
+
 // AuthDataGenerator is a function that returns a new instance of the authentication data required by this API
 export type AuthDataGenerator = () => (authentication.AuthData | undefined)
 
@@ -378,6 +511,9 @@ class BaseClient {
     readonly baseURL: string
     readonly fetcher: Fetcher
     readonly headers: Record<string, string>
+    readonly timeout?: number
+    readonly retry?: RetryPolicy
+    readonly interceptors: Interceptors
     readonly authGenerator?: AuthDataGenerator
 
     constructor(baseURL: string, options: ClientOptions) {
@@ -394,6 +530,10 @@ class BaseClient {
             this.fetcher = boundFetch
         }
 
+        this.timeout = options.timeout
+        this.retry = options.retry
+        this.interceptors = options.interceptors ?? {}
+
         // Setup an authentication data generator using the auth data token option
         if (options.auth !== undefined) {
             const auth = options.auth
@@ -408,7 +548,7 @@ class BaseClient {
 
     // callAPI is used by each generated API method to actually make the request
     public async callAPI(method: string, path: string, body?: BodyInit, params?: CallParameters): Promise<Response> {
-        let { query, ...rest } = params ?? {}
+        let { query, timeout, retry, onResponseHeaders, ...rest } = params ?? {}
         const init = {
             ...rest,
             method,
@@ -429,9 +569,72 @@ class BaseClient {
             init.headers["x-api-key"] = authData.APIKey
         }
 
-        // Make the actual request
         const queryString = query ? '?' + encodeQuery(query) : ''
-        const response = await this.fetcher(this.baseURL+path+queryString, init)
+        const url = this.baseURL+path+queryString
+
+        // Only retry requests which are safe to retry and whose body can be sent again
+        const policy = retry ?? this.retry
+        const replayable = body === undefined || typeof body === "string"
+        const maxAttempts = policy && replayable && retryableMethods(policy).includes(method) ? policy.maxAttempts : 1
+
+        for (let attempt = 1; ; attempt++) {
+            try {
+                return await this.doRequest(url, init, timeout ?? this.timeout, onResponseHeaders)
+            } catch (err) {
+                if (attempt >= maxAttempts || !isRetryableError(policy!, err)) {
+                    throw err
+                }
+                await sleep(retryBackoff(policy!, attempt), init.signal)
+            }
+        }
+    }
+
+    // doRequest makes a single attempt at the request, running the interceptors and enforcing the timeout
+    private async doRequest(url: string, init: RequestInit, timeout?: number, onResponseHeaders?: (headers: Headers) => void): Promise<Response> {
+        for (const interceptor of this.interceptors.request ?? []) {
+            init = await interceptor(url, init)
+        }
+
+        // Abort the request if it takes longer than the timeout, while still respecting the caller's signal
+        let timer: ReturnType<typeof setTimeout> | undefined
+        let timedOut = false
+        const signal = init.signal
+        let onAbort: (() => void) | undefined
+        if (timeout !== undefined) {
+            const controller = new AbortController()
+            if (signal?.aborted) {
+                controller.abort()
+            } else if (signal) {
+                onAbort = () => controller.abort()
+                signal.addEventListener("abort", onAbort, { once: true })
+            }
+            timer = setTimeout(() => {
+                timedOut = true
+                controller.abort()
+            }, timeout)
+            init = {...init, signal: controller.signal}
+        }
+
+        // Make the actual request
+        let response: Response
+        try {
+            response = await this.fetcher(url, init)
+        } catch (err) {
+            if (timedOut) {
+                throw new APIError(504, { code: ErrCode.DeadlineExceeded, message: `request timed out after ${timeout}ms` })
+            }
+            throw err
+        } finally {
+            clearTimeout(timer)
+            if (onAbort) {
+                signal?.removeEventListener("abort", onAbort)
+            }
+        }
+
+        for (const interceptor of this.interceptors.response ?? []) {
+            response = await interceptor(response)
+        }
+        onResponseHeaders?.(response.headers)
 
         // handle any error responses
         if (!response.ok) {
@@ -471,6 +674,7 @@ interface APIErrorResponse {
     code: ErrCode
     message: string
     details?: any
+    details_type?: string
 }
 
 function isAPIErrorResponse(err: any): err is APIErrorResponse {
@@ -505,6 +709,12 @@ export class APIError extends Error {
      */
     public readonly details?: any
 
+    /**
+     * The error details, typed according to the type of details returned by the API.
+     * It is undefined if the error has no details or the details are of an unknown type.
+     */
+    public readonly typedDetails?: ErrDetails
+
     constructor(status: number, response: APIErrorResponse) {
         // extending errors causes issues after you construct them, unless you apply the following fixes
         super(response.message);
@@ -532,6 +742,7 @@ export class APIError extends Error {
         this.status = status
         this.code = response.code
         this.details = response.details
+        this.typedDetails = toErrDetails(response)
     }
 }
 
@@ -542,6 +753,27 @@ export function isAPIError(err: any): err is APIError {
     return err instanceof APIError;
 }
 
+/**
+ * ErrDetails is a union of the error details types the API may return,
+ * discriminated by the type field.
+ */
+export type ErrDetails =
+    | { type: "products.DuplicateProductDetails", value: products.DuplicateProductDetails }
+    | { type: "products.QuotaDetails", value: products.QuotaDetails }
+
+function toErrDetails(response: APIErrorResponse): ErrDetails | undefined {
+    if (response.details === undefined || response.details === null) {
+        return undefined
+    }
+    switch (response.details_type) {
+    case "products.DuplicateProductDetails":
+    case "products.QuotaDetails":
+        return { type: response.details_type, value: response.details }
+    default:
+        return undefined
+    }
+}
+
 export enum ErrCode {
     /**
      * OK indicates the operation was successful.
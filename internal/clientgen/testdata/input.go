@@ -168,6 +168,21 @@ type CreateProductRequest struct {
     Description    string `json:"description,omitempty"`
 }
 
+// DuplicateProductDetails is returned when a product
+// with the same name already exists.
+type DuplicateProductDetails struct {
+    ExistingID uuid.UUID `json:"existing_id"`
+}
+
+func (DuplicateProductDetails) ErrDetails() {}
+
+// QuotaDetails is returned when the product quota has been reached.
+type QuotaDetails struct {
+    Limit int `json:"limit"`
+}
+
+func (*QuotaDetails) ErrDetails() {}
+
 //encore:api public method=GET
 func List(ctx context.Context) (*ProductListing, error) {
     return nil, nil
@@ -0,0 +1,45 @@
+-- go.mod --
+module app
+
+-- encore.app --
+{"id": ""}
+
+-- svc/svc.go --
+package svc
+
+type User struct {
+    ID   int
+    Name string
+}
+
+type UserV2 struct {
+    ID        int
+    FirstName string
+    LastName  string
+}
+
+-- svc/api.go --
+package svc
+
+import (
+    "context"
+)
+
+// GetUser returns a user.
+// Deprecated: use GetUserV2 instead.
+//encore:api public method=GET path=/users/:id version=1 deprecated=2023-01-15 sunset=2023-06-30
+func GetUser(ctx context.Context, id int) (*User, error) {
+    return nil, nil
+}
+
+// GetUserV2 returns a user with separate first and last names.
+//encore:api public method=GET path=/users/:id version=2
+func GetUserV2(ctx context.Context, id int) (*UserV2, error) {
+    return nil, nil
+}
+
+// Ping is an unversioned endpoint.
+//encore:api public method=GET path=/ping
+func Ping(ctx context.Context) error {
+    return nil
+}
@@ -29,6 +29,10 @@ func getNamedTypes(md *meta.Data) *typeRegistry {
 		r.Visit(md.AuthHandler.Params)
 	}
 
+	for _, det := range md.ErrDetails {
+		r.Visit(det.Type)
+	}
+
 	return r
 }
 
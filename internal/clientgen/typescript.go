@@ -168,8 +168,8 @@ func (ts *typescript) writeService(svc *meta.Service) error {
 		ts.WriteByte('\n')
 
 		// Doc string
-		if rpc.Doc != "" {
-			scanner := bufio.NewScanner(strings.NewReader(rpc.Doc))
+		if doc := rpcDoc(ts.md, rpc); doc != "" {
+			scanner := bufio.NewScanner(strings.NewReader(doc))
 			indent()
 			ts.WriteString("/**\n")
 			for scanner.Scan() {
@@ -252,6 +252,15 @@ func (ts *typescript) writeService(svc *meta.Service) error {
 			ts.WriteString("body?: BodyInit, options?: CallParameters")
 		}
 
+		// Raw endpoints take the full CallParameters above,
+		// other endpoints only allow controlling the call itself.
+		if rpc.Proto != meta.RPC_RAW {
+			if nParams > 0 || rpc.RequestSchema != nil {
+				ts.WriteString(", ")
+			}
+			ts.WriteString("options?: CallOptions")
+		}
+
 		ts.WriteString("): Promise<")
 		if rpc.ResponseSchema != nil {
 			ts.writeTyp(ns, rpc.ResponseSchema, 0)
@@ -369,27 +378,26 @@ func (ts *typescript) rpcCallSite(ns string, w *indentWriter, rpc *meta.RPC, rpc
 		rpcEncoding.DefaultMethod,
 		rpcPath,
 	)
-	if body != "" || headers != "" || query != "" {
-		if body == "" {
-			callAPI += ", undefined"
-		} else {
-			callAPI += ", " + body
-		}
-
-		if headers != "" || query != "" {
-			callAPI += ", {" + headers
-
-			if headers != "" && query != "" {
-				callAPI += ", "
-			}
+	if body == "" {
+		callAPI += ", undefined"
+	} else {
+		callAPI += ", " + body
+	}
 
-			if query != "" {
-				callAPI += query
-			}
+	if headers != "" || query != "" {
+		callAPI += ", {...options, " + headers
 
-			callAPI += "}"
+		if headers != "" && query != "" {
+			callAPI += ", "
+		}
 
+		if query != "" {
+			callAPI += query
 		}
+
+		callAPI += "}"
+	} else {
+		callAPI += ", options"
 	}
 	callAPI += ")"
 
@@ -431,7 +439,7 @@ func (ts *typescript) rpcCallSite(ns string, w *indentWriter, rpc *meta.RPC, rpc
 func (ts *typescript) nonReservedId(id string) string {
 	switch id {
 	// our reserved keywords (or ID's we use within the generated client functions)
-	case "params", "headers", "query", "body", "resp", "rtn":
+	case "params", "headers", "query", "body", "resp", "rtn", "options":
 		return "_" + id
 
 	// Typescript & Javascript keywords
@@ -612,6 +620,25 @@ export interface ClientOptions {
      * code on each API request made or response received.
      */
     fetcher?: Fetcher
+
+    /**
+     * The number of milliseconds after which a request is aborted and an APIError
+     * with the DeadlineExceeded code is thrown. Individual calls can override this
+     * using CallOptions. By default requests do not time out.
+     */
+    timeout?: number
+
+    /**
+     * The retry policy to use for failed requests. Individual calls can override
+     * this using CallOptions. By default requests are not retried.
+     */
+    retry?: RetryPolicy
+
+    /**
+     * Interceptors which are run on each request before it is sent and on each
+     * response after it is received, in the order they are given.
+     */
+    interceptors?: Interceptors
 `)
 
 	if ts.hasAuth {
@@ -649,14 +676,71 @@ func (ts *typescript) writeBaseClient(appSlug string) error {
 	userAgent := fmt.Sprintf("%s-Generated-TS-Client (Encore/%s)", appSlug, version.Version)
 
 	ts.WriteString(`
+/**
+ * CallOptions allows you to control the behaviour of an individual API call.
+ */
+export interface CallOptions {
+    /** A signal which can be used to abort the request */
+    signal?: AbortSignal
+
+    /** The number of milliseconds after which the request is aborted, overriding the client's timeout */
+    timeout?: number
+
+    /** The retry policy for this call, overriding the client's retry policy */
+    retry?: RetryPolicy
+
+    /**
+     * Called with the HTTP headers of the response, including for calls throwing an APIError.
+     * This can be used to read headers which are not part of the response type, like those set by middleware.
+     */
+    onResponseHeaders?: (headers: Headers) => void
+}
+
 // CallParameters is the type of the parameters to a method call, but require headers to be a Record type
-type CallParameters = Omit<RequestInit, "method" | "body"> & {
+type CallParameters = Omit<RequestInit, "method" | "body"> & CallOptions & {
     /** Any headers to be sent with the request */
     headers?: Record<string, string>;
 
     /** Any query parameters to be sent with the request */
     query?: Record<string, string | string[]>
 }
+
+/**
+ * RetryPolicy describes when and how failed requests are retried.
+ *
+ * Only requests using one of the given methods are retried, and only when they
+ * failed with a network error or one of the given error codes.
+ */
+export interface RetryPolicy {
+    /** The maximum number of attempts, including the initial request */
+    maxAttempts: number
+
+    /** The delay in milliseconds before the first retry, doubled for every subsequent retry. Defaults to 100 */
+    initialBackoff?: number
+
+    /** The maximum delay in milliseconds between retries. Defaults to 5000 */
+    maxBackoff?: number
+
+    /** The HTTP methods which are safe to retry. Defaults to the idempotent methods */
+    methods?: string[]
+
+    /** The error codes which are retried. Defaults to ErrCode.Unavailable and ErrCode.ResourceExhausted */
+    codes?: ErrCode[]
+}
+
+// RequestInterceptor is called with each request before it is sent, and returns the request to send
+export type RequestInterceptor = (url: string, init: RequestInit) => RequestInit | Promise<RequestInit>
+
+// ResponseInterceptor is called with each response before it is processed, and returns the response to process
+export type ResponseInterceptor = (response: Response) => Response | Promise<Response>
+
+/**
+ * Interceptors allows you to run code on each request made and response received by the client.
+ */
+export interface Interceptors {
+    request?: RequestInterceptor[]
+    response?: ResponseInterceptor[]
+}
 `)
 
 	if ts.hasAuth {
@@ -677,7 +761,10 @@ const boundFetch = fetch.bind(this);
 class BaseClient {
     readonly baseURL: string
     readonly fetcher: Fetcher
-    readonly headers: Record<string, string>`)
+    readonly headers: Record<string, string>
+    readonly timeout?: number
+    readonly retry?: RetryPolicy
+    readonly interceptors: Interceptors`)
 
 	if ts.hasAuth {
 		ts.WriteString("\n    readonly authGenerator?: AuthDataGenerator")
@@ -697,7 +784,11 @@ class BaseClient {
             this.fetcher = options.fetcher
         } else {
             this.fetcher = boundFetch
-        }`)
+        }
+
+        this.timeout = options.timeout
+        this.retry = options.retry
+        this.interceptors = options.interceptors ?? {}`)
 
 	if ts.hasAuth {
 		ts.WriteString(`
@@ -719,7 +810,7 @@ class BaseClient {
 
     // callAPI is used by each generated API method to actually make the request
     public async callAPI(method: string, path: string, body?: BodyInit, params?: CallParameters): Promise<Response> {
-        let { query, ...rest } = params ?? {}
+        let { query, timeout, retry, onResponseHeaders, ...rest } = params ?? {}
         const init = {
             ...rest,
             method,
@@ -791,9 +882,72 @@ if (authData) {
 	}
 
 	ts.WriteString(`
-        // Make the actual request
         const queryString = query ? '?' + encodeQuery(query) : ''
-        const response = await this.fetcher(this.baseURL+path+queryString, init)
+        const url = this.baseURL+path+queryString
+
+        // Only retry requests which are safe to retry and whose body can be sent again
+        const policy = retry ?? this.retry
+        const replayable = body === undefined || typeof body === "string"
+        const maxAttempts = policy && replayable && retryableMethods(policy).includes(method) ? policy.maxAttempts : 1
+
+        for (let attempt = 1; ; attempt++) {
+            try {
+                return await this.doRequest(url, init, timeout ?? this.timeout, onResponseHeaders)
+            } catch (err) {
+                if (attempt >= maxAttempts || !isRetryableError(policy!, err)) {
+                    throw err
+                }
+                await sleep(retryBackoff(policy!, attempt), init.signal)
+            }
+        }
+    }
+
+    // doRequest makes a single attempt at the request, running the interceptors and enforcing the timeout
+    private async doRequest(url: string, init: RequestInit, timeout?: number, onResponseHeaders?: (headers: Headers) => void): Promise<Response> {
+        for (const interceptor of this.interceptors.request ?? []) {
+            init = await interceptor(url, init)
+        }
+
+        // Abort the request if it takes longer than the timeout, while still respecting the caller's signal
+        let timer: ReturnType<typeof setTimeout> | undefined
+        let timedOut = false
+        const signal = init.signal
+        let onAbort: (() => void) | undefined
+        if (timeout !== undefined) {
+            const controller = new AbortController()
+            if (signal?.aborted) {
+                controller.abort()
+            } else if (signal) {
+                onAbort = () => controller.abort()
+                signal.addEventListener("abort", onAbort, { once: true })
+            }
+            timer = setTimeout(() => {
+                timedOut = true
+                controller.abort()
+            }, timeout)
+            init = {...init, signal: controller.signal}
+        }
+
+        // Make the actual request
+        let response: Response
+        try {
+            response = await this.fetcher(url, init)
+        } catch (err) {
+            if (timedOut) {
+                throw new APIError(504, { code: ErrCode.DeadlineExceeded, message: ` + "`request timed out after ${timeout}ms`" + ` })
+            }
+            throw err
+        } finally {
+            clearTimeout(timer)
+            if (onAbort) {
+                signal?.removeEventListener("abort", onAbort)
+            }
+        }
+
+        for (const interceptor of this.interceptors.response ?? []) {
+            response = await interceptor(response)
+        }
+        onResponseHeaders?.(response.headers)
 
         // handle any error responses
         if (!response.ok) {
@@ -837,6 +991,48 @@ export type JSONValue = string | number | boolean | null | JSONValue[] | {[key:
 
 	ts.WriteString(`
 
+// retryableMethods returns the HTTP methods the given retry policy applies to
+function retryableMethods(policy: RetryPolicy): string[] {
+    return policy.methods ?? ["GET", "HEAD", "OPTIONS", "PUT", "DELETE"]
+}
+
+// isRetryableError reports whether a request which failed with the given error should be retried
+function isRetryableError(policy: RetryPolicy, err: any): boolean {
+    if (err instanceof APIError) {
+        return (policy.codes ?? [ErrCode.Unavailable, ErrCode.ResourceExhausted]).includes(err.code)
+    }
+
+    // fetch rejects with a TypeError on network failures
+    return err instanceof TypeError
+}
+
+// retryBackoff returns how long to wait before retrying the given attempt, using exponential backoff with full jitter
+function retryBackoff(policy: RetryPolicy, attempt: number): number {
+    const initial = policy.initialBackoff ?? 100
+    const max = policy.maxBackoff ?? 5000
+    return Math.random() * Math.min(max, initial * Math.pow(2, attempt - 1))
+}
+
+// sleep waits for ms milliseconds, ending early with a Canceled error if the signal is aborted.
+function sleep(ms: number, signal?: AbortSignal | null): Promise<void> {
+    return new Promise((resolve, reject) => {
+        const canceled = () => new APIError(499, { code: ErrCode.Canceled, message: "request canceled" })
+        if (signal?.aborted) {
+            reject(canceled())
+            return
+        }
+        const onAbort = () => {
+            clearTimeout(timer)
+            reject(canceled())
+        }
+        const timer = setTimeout(() => {
+            signal?.removeEventListener("abort", onAbort)
+            resolve()
+        }, ms)
+        signal?.addEventListener("abort", onAbort, { once: true })
+    })
+}
+
 function encodeQuery(parts: Record<string, string | string[]>): string {
     const pairs: string[] = []
     for (const key in parts) {
@@ -1164,7 +1360,11 @@ interface APIErrorResponse {
     code: ErrCode
     message: string
     details?: any
-}
+`)
+	if len(ts.md.ErrDetails) > 0 {
+		w.WriteString("    details_type?: string\n")
+	}
+	w.WriteString(`}
 
 function isAPIErrorResponse(err: any): err is APIErrorResponse {
     return (
@@ -1197,7 +1397,17 @@ export class APIError extends Error {
      * The error details
      */
     public readonly details?: any
-
+`)
+	if len(ts.md.ErrDetails) > 0 {
+		w.WriteString(`
+    /**
+     * The error details, typed according to the type of details returned by the API.
+     * It is undefined if the error has no details or the details are of an unknown type.
+     */
+    public readonly typedDetails?: ErrDetails
+`)
+	}
+	w.WriteString(`
     constructor(status: number, response: APIErrorResponse) {
         // extending errors causes issues after you construct them, unless you apply the following fixes
         super(response.message);
@@ -1225,7 +1435,11 @@ export class APIError extends Error {
         this.status = status
         this.code = response.code
         this.details = response.details
-    }
+`)
+	if len(ts.md.ErrDetails) > 0 {
+		w.WriteString("        this.typedDetails = toErrDetails(response)\n")
+	}
+	w.WriteString(`    }
 }
 
 /**
@@ -1234,7 +1448,9 @@ export class APIError extends Error {
 export function isAPIError(err: any): err is APIError {
     return err instanceof APIError;
 }
-
+`)
+	ts.writeErrDetailsType()
+	w.WriteString(`
 export enum ErrCode {
     /**
      * OK indicates the operation was successful.
@@ -1432,6 +1648,43 @@ export enum ErrCode {
 `)
 }
 
+// writeErrDetailsType writes the ErrDetails union of all the error details types
+// declared in the app, discriminated by the details type reported by the API.
+func (ts *typescript) writeErrDetailsType() {
+	if len(ts.md.ErrDetails) == 0 {
+		return
+	}
+
+	ts.WriteString(`
+/**
+ * ErrDetails is a union of the error details types the API may return,
+ * discriminated by the type field.
+ */
+export type ErrDetails =`)
+	for _, det := range ts.md.ErrDetails {
+		ts.WriteString("\n    | { type: " + ts.Quote(det.Name) + ", value: ")
+		ts.writeTyp("", det.Type, 0)
+		ts.WriteString(" }")
+	}
+	ts.WriteString(`
+
+function toErrDetails(response: APIErrorResponse): ErrDetails | undefined {
+    if (response.details === undefined || response.details === null) {
+        return undefined
+    }
+    switch (response.details_type) {
+`)
+	for _, det := range ts.md.ErrDetails {
+		ts.WriteString("    case " + ts.Quote(det.Name) + ":\n")
+	}
+	ts.WriteString(`        return { type: response.details_type, value: response.details }
+    default:
+        return undefined
+    }
+}
+`)
+}
+
 func stringIsOnly(str string, predicate func(r rune) bool) bool {
 	for _, r := range str {
 		if !predicate(r) {
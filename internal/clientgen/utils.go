@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"encr.dev/internal/version"
+	"encr.dev/parser/encoding"
 	meta "encr.dev/proto/encore/parser/meta/v1"
 )
 
@@ -22,6 +23,29 @@ func hasPublicRPC(svc *meta.Service) bool {
 	return false
 }
 
+// rpcDoc returns the documentation of rpc for use in generated clients.
+// If the app has several auth handlers it also lists
+// the auth schemes the endpoint accepts.
+func rpcDoc(md *meta.Data, rpc *meta.RPC) string {
+	if len(encoding.AuthHandlers(md)) < 2 {
+		return rpc.Doc
+	}
+
+	var schemes []string
+	for _, h := range encoding.RPCAuthHandlers(md, rpc) {
+		schemes = append(schemes, h.Scheme)
+	}
+	if len(schemes) == 0 {
+		return rpc.Doc
+	}
+
+	doc := strings.TrimSpace(rpc.Doc)
+	if doc != "" {
+		doc += "\n\n"
+	}
+	return doc + "Accepted auth schemes: " + strings.Join(schemes, ", ") + "."
+}
+
 type indentWriter struct {
 	w                *bytes.Buffer
 	depth            int
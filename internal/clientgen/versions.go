@@ -0,0 +1,102 @@
+package clientgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// resolveVersions prepares the metadata of an app with versioned APIs
+// for client generation.
+//
+// Versioned endpoints are called with their version as a path prefix ("/v2/foo"),
+// so that generated clients always call the version they were generated for.
+//
+// If target is non-zero, the client is generated for that API version:
+// of the versions of each versioned API only the latest version that is
+// less than or equal to target is kept. Unversioned endpoints are always kept.
+//
+// The input metadata is not modified.
+func resolveVersions(md *meta.Data, target int) *meta.Data {
+	if !hasVersionedRPCs(md) {
+		return md
+	}
+	md = proto.Clone(md).(*meta.Data)
+
+	for _, svc := range md.Svcs {
+		if target > 0 {
+			svc.Rpcs = targetVersion(svc.Rpcs, target)
+		}
+		for _, rpc := range svc.Rpcs {
+			if rpc.Version > 0 {
+				prefix := &meta.PathSegment{
+					Type:      meta.PathSegment_LITERAL,
+					Value:     fmt.Sprintf("v%d", rpc.Version),
+					ValueType: meta.PathSegment_STRING,
+				}
+				rpc.Path.Segments = append([]*meta.PathSegment{prefix}, rpc.Path.Segments...)
+			}
+		}
+	}
+	return md
+}
+
+// targetVersion filters rpcs to only include the latest version
+// of each versioned API that is less than or equal to target.
+func targetVersion(rpcs []*meta.RPC, target int) []*meta.RPC {
+	// Different versions of the same API share the same methods and path.
+	apiKey := func(rpc *meta.RPC) string {
+		methods := append([]string(nil), rpc.HttpMethods...)
+		sort.Strings(methods)
+		return strings.Join(methods, ",") + " " + pathString(rpc.Path)
+	}
+
+	latest := make(map[string]*meta.RPC)
+	for _, rpc := range rpcs {
+		if rpc.Version == 0 || int(rpc.Version) > target {
+			continue
+		}
+		key := apiKey(rpc)
+		if curr, ok := latest[key]; !ok || rpc.Version > curr.Version {
+			latest[key] = rpc
+		}
+	}
+
+	filtered := make([]*meta.RPC, 0, len(rpcs))
+	for _, rpc := range rpcs {
+		if rpc.Version == 0 || latest[apiKey(rpc)] == rpc {
+			filtered = append(filtered, rpc)
+		}
+	}
+	return filtered
+}
+
+func hasVersionedRPCs(md *meta.Data) bool {
+	for _, svc := range md.Svcs {
+		for _, rpc := range svc.Rpcs {
+			if rpc.Version > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func pathString(path *meta.Path) string {
+	var b strings.Builder
+	for _, seg := range path.Segments {
+		b.WriteByte('/')
+		switch seg.Type {
+		case meta.PathSegment_PARAM:
+			b.WriteByte(':')
+		case meta.PathSegment_WILDCARD:
+			b.WriteByte('*')
+		}
+		b.WriteString(seg.Value)
+	}
+	return b.String()
+}
@@ -0,0 +1,56 @@
+package clientgen
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+func TestResolveVersions(t *testing.T) {
+	c := qt.New(t)
+
+	rpc := func(name string, version uint32, path ...string) *meta.RPC {
+		p := &meta.Path{}
+		for _, s := range path {
+			p.Segments = append(p.Segments, &meta.PathSegment{Type: meta.PathSegment_LITERAL, Value: s})
+		}
+		return &meta.RPC{Name: name, Version: version, HttpMethods: []string{"GET"}, Path: p}
+	}
+	md := &meta.Data{Svcs: []*meta.Service{{
+		Name: "svc",
+		Rpcs: []*meta.RPC{
+			rpc("FooV1", 1, "foo"),
+			rpc("FooV2", 2, "foo"),
+			rpc("FooV3", 3, "foo"),
+			rpc("BarV3", 3, "bar"),
+			rpc("Baz", 0, "baz"),
+		},
+	}}}
+
+	tests := []struct {
+		target    int
+		wantNames []string
+		wantPaths []string
+	}{
+		{0, []string{"FooV1", "FooV2", "FooV3", "BarV3", "Baz"}, []string{"/v1/foo", "/v2/foo", "/v3/foo", "/v3/bar", "/baz"}},
+		{1, []string{"FooV1", "Baz"}, []string{"/v1/foo", "/baz"}},
+		{2, []string{"FooV2", "Baz"}, []string{"/v2/foo", "/baz"}},
+		{5, []string{"FooV3", "BarV3", "Baz"}, []string{"/v3/foo", "/v3/bar", "/baz"}},
+	}
+	for _, test := range tests {
+		got := resolveVersions(md, test.target)
+		var names, paths []string
+		for _, rpc := range got.Svcs[0].Rpcs {
+			names = append(names, rpc.Name)
+			paths = append(paths, pathString(rpc.Path))
+		}
+		c.Assert(names, qt.DeepEquals, test.wantNames, qt.Commentf("target %d", test.target))
+		c.Assert(paths, qt.DeepEquals, test.wantPaths, qt.Commentf("target %d", test.target))
+	}
+
+	// The input metadata must not be modified.
+	c.Assert(md.Svcs[0].Rpcs, qt.HasLen, 5)
+	c.Assert(pathString(md.Svcs[0].Rpcs[0].Path), qt.Equals, "/foo")
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"strconv"
 	"strings"
+	"time"
 
 	"encr.dev/parser/est"
 	"encr.dev/parser/paths"
@@ -114,6 +116,8 @@ func parseDirective(pos token.Pos, line string) (directive, error) {
 				rpc.Access = est.Auth
 			case "raw":
 				rpc.Raw = true
+			case "deprecated":
+				rpc.Deprecated = true
 			default:
 				if strings.HasPrefix(field, "tag:") {
 					sel, err := selector.Parse(field)
@@ -134,6 +138,23 @@ func parseDirective(pos token.Pos, line string) (directive, error) {
 						}
 					case "method":
 						rpc.Method = strings.Split(parts[1], ",")
+					case "version":
+						v, err := strconv.Atoi(parts[1])
+						if err != nil || v < 1 {
+							return nil, fmt.Errorf("invalid API version %q: must be a positive integer", parts[1])
+						}
+						rpc.Version = v
+					case "deprecated":
+						if err := validateDate(parts[1]); err != nil {
+							return nil, fmt.Errorf("invalid deprecation date: %v", err)
+						}
+						rpc.Deprecated = true
+						rpc.DeprecatedSince = parts[1]
+					case "sunset":
+						if err := validateDate(parts[1]); err != nil {
+							return nil, fmt.Errorf("invalid sunset date: %v", err)
+						}
+						rpc.Sunset = parts[1]
 					default:
 						return nil, fmt.Errorf("unrecognized encore:api directive field: %q", parts[0])
 					}
@@ -226,6 +247,12 @@ func validateRPCDirective(d *rpcDirective) error {
 		return errors.New("private APIs cannot be declared raw")
 	}
 
+	if d.Sunset != "" && !d.Deprecated {
+		return errors.New("APIs with a sunset date must also be marked deprecated")
+	} else if d.Sunset != "" && d.DeprecatedSince != "" && d.Sunset < d.DeprecatedSince {
+		return errors.New("the sunset date cannot be before the deprecation date")
+	}
+
 	for _, m := range d.Method {
 		for _, c := range m {
 			if !(c >= 'A' && c <= 'Z') && !(c >= 'a' && c <= 'z') {
@@ -239,6 +266,14 @@ func validateRPCDirective(d *rpcDirective) error {
 	return nil
 }
 
+// validateDate validates that s is a date in the form "YYYY-MM-DD".
+func validateDate(s string) error {
+	if _, err := time.Parse("2006-01-02", s); err != nil {
+		return fmt.Errorf("%q is not in the form YYYY-MM-DD", s)
+	}
+	return nil
+}
+
 // The directive interface is a marker interface for the directive types we support.
 type directive interface {
 	Pos() token.Pos
@@ -253,6 +288,11 @@ type rpcDirective struct {
 	Method   []string
 	Path     *paths.Path // nil if not specified
 	Tags     selector.Set
+
+	Version         int    // 0 if unversioned
+	Deprecated      bool   // whether the API is deprecated
+	DeprecatedSince string // "YYYY-MM-DD", or "" if not specified
+	Sunset          string // "YYYY-MM-DD", or "" if not specified
 }
 
 // An authHandlerDirective is the parsed representation of the encore:authhandler directive.
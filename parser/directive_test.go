@@ -99,6 +99,39 @@ func TestParseDirective(t *testing.T) {
 			line:        "api public tag:foo.bar",
 			expectedErr: `invalid tag format "tag:foo.bar": invalid value`,
 		},
+		{
+			desc:        "api with version and deprecation",
+			line:        "api public version=2 deprecated=2023-01-15 sunset=2023-06-30",
+			expectedErr: "",
+			expected: &rpcDirective{
+				Access:          est.Public,
+				TokenPos:        staticPos,
+				Version:         2,
+				Deprecated:      true,
+				DeprecatedSince: "2023-01-15",
+				Sunset:          "2023-06-30",
+			},
+		},
+		{
+			desc:        "api deprecated without date",
+			line:        "api public deprecated",
+			expectedErr: "",
+			expected: &rpcDirective{
+				Access:     est.Public,
+				TokenPos:   staticPos,
+				Deprecated: true,
+			},
+		},
+		{
+			desc:        "api with invalid version",
+			line:        "api public version=v2",
+			expectedErr: `invalid API version "v2": must be a positive integer`,
+		},
+		{
+			desc:        "api with invalid sunset date",
+			line:        "api public deprecated sunset=30/06/2023",
+			expectedErr: `invalid sunset date: "30/06/2023" is not in the form YYYY-MM-DD`,
+		},
 		{
 			desc: "middleware",
 			line: "middleware target=tag:foo,tag:bar",
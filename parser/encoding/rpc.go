@@ -493,6 +493,38 @@ func DefaultClientHttpMethod(rpc *meta.RPC) string {
 
 }
 
+// AuthHandlers returns all the auth handlers of the application, in the order they are tried.
+func AuthHandlers(appMetaData *meta.Data) []*meta.AuthHandler {
+	if len(appMetaData.AuthHandlers) > 0 {
+		return appMetaData.AuthHandlers
+	} else if appMetaData.AuthHandler != nil {
+		return []*meta.AuthHandler{appMetaData.AuthHandler}
+	}
+	return nil
+}
+
+// RPCAuthHandlers returns the auth handlers that may authenticate calls to rpc,
+// in the order they are tried. It returns nil for private endpoints.
+func RPCAuthHandlers(appMetaData *meta.Data, rpc *meta.RPC) []*meta.AuthHandler {
+	if rpc.AccessType == meta.RPC_PRIVATE {
+		return nil
+	}
+	handlers := AuthHandlers(appMetaData)
+	if len(rpc.AuthSchemes) == 0 {
+		return handlers
+	}
+
+	var result []*meta.AuthHandler
+	for _, scheme := range rpc.AuthSchemes {
+		for _, h := range handlers {
+			if h.Scheme == scheme {
+				result = append(result, h)
+			}
+		}
+	}
+	return result
+}
+
 // DescribeAuth generates a ParameterEncoding per field of the auth struct and returns it as
 // the AuthEncoding. If authSchema is nil it returns nil, nil.
 func DescribeAuth(appMetaData *meta.Data, authSchema *schema.Type, options *Options) (*AuthEncoding, error) {
@@ -0,0 +1,69 @@
+package parser
+
+import (
+	"go/ast"
+	"strings"
+
+	"encr.dev/parser/est"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+// parseErrDetails finds all types implementing errs.ErrDetails,
+// so that they can be described in the app metadata and exposed to clients.
+func (p *parser) parseErrDetails() {
+	seen := make(map[string]*est.ErrDetails)
+	for _, pkg := range p.pkgs {
+		for _, f := range pkg.Files {
+			if strings.HasSuffix(f.Name, "_test.go") {
+				continue
+			}
+			for _, decl := range f.AST.Decls {
+				fd, ok := decl.(*ast.FuncDecl)
+				if !ok || !isErrDetailsMethod(fd) {
+					continue
+				}
+
+				recv := fd.Recv.List[0].Type
+				if star, ok := recv.(*ast.StarExpr); ok {
+					recv = star.X
+				}
+				ident, ok := recv.(*ast.Ident)
+				if !ok {
+					p.errf(recv.Pos(), "error details types cannot be generic")
+					continue
+				}
+
+				typ := p.resolveType(pkg, f, ident, nil)
+				named, ok := typ.Typ.(*schema.Type_Named)
+				if !ok {
+					continue
+				}
+				name := pkg.Name + "." + p.decls[named.Named.Id].Name
+				if prev, ok := seen[name]; ok {
+					pp := p.fset.Position(prev.Method.Pos())
+					p.errf(fd.Pos(), "error details type %s is already declared in another package with the same name at %s",
+						name, pp)
+					continue
+				}
+
+				det := &est.ErrDetails{
+					Name:   name,
+					Type:   typ,
+					File:   f,
+					Method: fd,
+				}
+				seen[name] = det
+				p.errDetails = append(p.errDetails, det)
+			}
+		}
+	}
+}
+
+// isErrDetailsMethod reports whether fd is a declaration of the
+// errs.ErrDetails marker method.
+func isErrDetailsMethod(fd *ast.FuncDecl) bool {
+	return fd.Recv != nil && len(fd.Recv.List) == 1 &&
+		fd.Name.Name == "ErrDetails" &&
+		fd.Type.Params.NumFields() == 0 &&
+		fd.Type.Results.NumFields() == 0
+}
@@ -28,6 +28,7 @@ type Application struct {
 	AuthHandler   *AuthHandler
 	Middleware    []*Middleware
 	Metrics       []*Metric
+	ErrDetails    []*ErrDetails
 }
 
 type File struct {
@@ -225,12 +226,20 @@ type RPC struct {
 	Request     *Param // request data; nil for Raw RPCs
 	Response    *Param // response data; nil for Raw RPCs
 	Tags        selector.Set
+	Version     int          // API version; 0 if unversioned
+	Deprecation *Deprecation // nil if not deprecated
 
 	// SvcStruct is the service struct this RPC is defined on,
 	// or nil otherwise. It is always a pointer receiver.
 	SvcStruct *ServiceStruct
 }
 
+// Deprecation describes the deprecation of an RPC.
+type Deprecation struct {
+	Since  string // "YYYY-MM-DD", or "" if unspecified
+	Sunset string // "YYYY-MM-DD", or "" if unspecified
+}
+
 type NodeType int
 
 const (
@@ -278,6 +287,14 @@ type AuthHandler struct {
 	AuthData *Param
 }
 
+// ErrDetails is a type implementing errs.ErrDetails.
+type ErrDetails struct {
+	Name   string        // the name identifying the type on the wire ("pkgname.TypeName")
+	Type   *schema.Type  // the named type
+	File   *File         // the file the marker method is declared in
+	Method *ast.FuncDecl // the ErrDetails marker method
+}
+
 type Middleware struct {
 	Name   string
 	Doc    string
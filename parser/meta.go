@@ -116,6 +116,13 @@ func ParseMeta(appRevision string, appHasUncommittedChanges bool, appRoot string
 		data.Metrics = append(data.Metrics, parseMetric(m))
 	}
 
+	for _, det := range app.ErrDetails {
+		data.ErrDetails = append(data.ErrDetails, &meta.ErrDetails{
+			Name: det.Name,
+			Type: det.Type,
+		})
+	}
+
 	if exp != nil {
 		for _, expName := range exp.List() {
 			data.Experiments = append(data.Experiments, string(expName))
@@ -202,6 +209,13 @@ func parseSvc(appRoot string, svc *est.Service) (*meta.Service, error) {
 		return nil, fmt.Errorf("%s: could not parse sqldb migrations: %v", svc.Root.RelPath, err)
 	}
 	s.Migrations = migs
+	if len(migs) > 0 {
+		engine, err := parseSQLEngine(filepath.Join(appRoot, relPath, migs[0].Filename))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", filepath.Join(relPath, migs[0].Filename), err)
+		}
+		s.SqlEngine = engine
+	}
 
 	// Compute which databases this connects to
 	seenDBs := make(map[string]bool)
@@ -262,6 +276,16 @@ func parseRPC(rpc *est.RPC) (*meta.RPC, error) {
 		Path:           rpc.Path.ToProto(),
 		HttpMethods:    rpc.HTTPMethods,
 		Tags:           rpc.Tags.ToProto(),
+		Version:        uint32(rpc.Version),
+	}
+	if d := rpc.Deprecation; d != nil {
+		r.Deprecation = &meta.RPC_Deprecation{}
+		if d.Since != "" {
+			r.Deprecation.Since = &d.Since
+		}
+		if d.Sunset != "" {
+			r.Deprecation.Sunset = &d.Sunset
+		}
 	}
 	return r, nil
 }
@@ -362,6 +386,38 @@ func parseMigrations(appRoot, relPath string) ([]*meta.DBMigration, error) {
 	return migrations, nil
 }
 
+// engineDirectiveRe matches database engine directives like "-- encore:engine mysql".
+var engineDirectiveRe = regexp.MustCompile(`^--\s*encore:engine(?:\s+(\S+))?\s*$`)
+
+// parseSQLEngine parses the database engine from the "-- encore:engine"
+// directive in the leading comments of the migration at path.
+// It defaults to PostgreSQL.
+func parseSQLEngine(path string) (meta.Service_SQLEngine, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			break
+		}
+		if m := engineDirectiveRe.FindStringSubmatch(line); m != nil {
+			switch m[1] {
+			case "postgres":
+				return meta.Service_POSTGRES, nil
+			case "mysql":
+				return meta.Service_MYSQL, nil
+			case "sqlite":
+				return meta.Service_SQLITE, nil
+			default:
+				return 0, fmt.Errorf("unknown database engine %q: must be one of postgres, mysql or sqlite", m[1])
+			}
+		}
+	}
+	return meta.Service_POSTGRES, nil
+}
+
 func parseAuthHandler(h *est.AuthHandler) *meta.AuthHandler {
 	pb := &meta.AuthHandler{
 		Name:    h.Name,
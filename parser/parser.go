@@ -59,9 +59,11 @@ type parser struct {
 	authHandler         *est.AuthHandler
 	middleware          []*est.Middleware
 	metrics             []*est.Metric
+	errDetails          []*est.ErrDetails
 	declMap             map[string]*schema.Decl // pkg/path.Name -> decl
 	decls               []*schema.Decl
 	paths               paths.Set                          // RPC paths
+	pathVersions        map[*paths.Path]int                // RPC path -> API version, for versioned RPCs
 	resourceMap         map[string]map[string]est.Resource // pkg/path -> name -> resource
 	hasUnexportedFields map[*schema.Struct]*ast.Field      // A struct will be in this map if it has unexported fields
 
@@ -99,17 +101,19 @@ func Parse(cfg *Config) (*Result, error) {
 		resourceMap:         make(map[string]map[string]est.Resource),
 		hasUnexportedFields: make(map[*schema.Struct]*ast.Field),
 		schemaToAST:         make(map[any]ast.Node),
+		pathVersions:        make(map[*paths.Path]int),
 	}
 	return p.Parse()
 }
 
 const (
-	sqldbImportPath = "encore.dev/storage/sqldb"
-	rlogImportPath  = "encore.dev/rlog"
-	uuidImportPath  = "encore.dev/types/uuid"
-	authImportPath  = "encore.dev/beta/auth"
-	cronImportPath  = "encore.dev/cron"
-	testImportPath  = "encore.dev/et"
+	sqldbImportPath  = "encore.dev/storage/sqldb"
+	rlogImportPath   = "encore.dev/rlog"
+	uuidImportPath   = "encore.dev/types/uuid"
+	authImportPath   = "encore.dev/beta/auth"
+	cronImportPath   = "encore.dev/cron"
+	testImportPath   = "encore.dev/et"
+	secretImportPath = "encore.dev/secret"
 )
 
 var defaultTrackedPackages = names.TrackedPackages{
@@ -118,6 +122,8 @@ var defaultTrackedPackages = names.TrackedPackages{
 	authImportPath: "auth",
 	cronImportPath: "cron",
 
+	secretImportPath: "secret",
+
 	"net/http":      "http",
 	"context":       "context",
 	"encoding/json": "json",
@@ -162,6 +168,7 @@ func (p *parser) Parse() (res *Result, err error) {
 	p.parseResourceUsage()
 	p.parseReferences()
 	p.parseSecrets()
+	p.parseErrDetails()
 	p.validateMiddleware()
 	p.validateApp()
 
@@ -187,6 +194,7 @@ func (p *parser) Parse() (res *Result, err error) {
 		AuthHandler:   p.authHandler,
 		Middleware:    p.middleware,
 		Metrics:       p.metrics,
+		ErrDetails:    p.errDetails,
 	}
 
 	md, nodes, err := ParseMeta(p.cfg.AppRevision, p.cfg.AppHasUncommittedChanges, p.cfg.AppRoot, app, p.fset, p.cfg.Experiments)
@@ -556,7 +564,10 @@ func (p *parser) parseSecrets() {
 }
 
 func (p *parser) parsePackageSecrets(pkg *est.Package) {
-	var secretsDecl *ast.StructType
+	var (
+		secretsDecl *ast.StructType
+		secretsFile *est.File
+	)
 SpecLoop:
 	for _, f := range pkg.Files {
 		for _, decl := range f.AST.Decls {
@@ -580,6 +591,7 @@ SpecLoop:
 							return
 						}
 						secretsDecl = typ
+						secretsFile = f
 						f.References[spec] = &est.Node{Type: est.SecretsNode}
 						break SpecLoop
 					}
@@ -596,6 +608,19 @@ SpecLoop:
 	names := p.names[pkg]
 	var secretNames []string
 	for _, field := range secretsDecl.Fields.List {
+		if idx, ok := field.Type.(*ast.IndexExpr); ok {
+			if path, obj := pkgObj(names.Files[secretsFile], idx.X); path == secretImportPath && obj == "Value" {
+				if !isSecretValueType(idx.Index) {
+					p.errf(idx.Index.Pos(), "field %s is not of type secret.Value[string] or secret.Value[[]byte]", field.Names[0].Name)
+					return
+				}
+				for _, name := range field.Names {
+					secretNames = append(secretNames, name.Name)
+				}
+				continue
+			}
+		}
+
 		if typ, ok := field.Type.(*ast.Ident); !ok || typ.Name != "string" {
 			p.errf(typ.Pos(), "field %s is not of type string", field.Names[0].Name)
 			return
@@ -613,6 +638,18 @@ SpecLoop:
 	pkg.Secrets = secretNames
 }
 
+// isSecretValueType reports whether typ is a valid type argument for secret.Value.
+func isSecretValueType(typ ast.Expr) bool {
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		return typ.Name == "string"
+	case *ast.ArrayType:
+		elem, ok := typ.Elt.(*ast.Ident)
+		return typ.Len == nil && ok && elem.Name == "byte"
+	}
+	return false
+}
+
 // validateApp performs full-app validation after everything has been parsed.
 func (p *parser) validateApp() {
 	// Error if we have auth endpoints without an auth handlers
@@ -188,6 +188,10 @@ func (p *parser) parseFuncs(pkg *est.Package, svc *est.Service) (isService bool)
 					Path:        path,
 					HTTPMethods: dir.Method,
 					Tags:        dir.Tags,
+					Version:     dir.Version,
+				}
+				if dir.Deprecated {
+					rpc.Deprecation = &est.Deprecation{Since: dir.DeprecatedSince, Sunset: dir.Sunset}
 				}
 				p.initRPC(rpc)
 
@@ -246,16 +250,43 @@ func (p *parser) initRPC(rpc *est.RPC) {
 		p.initTypedRPC(rpc)
 	}
 
+	if rpc.Version > 0 {
+		p.pathVersions[rpc.Path] = rpc.Version
+	}
+
+	ok := p.addRPCPath(rpc, rpc.Path)
+	if ok && rpc.Version > 0 {
+		// Versioned APIs are additionally reachable with the version as a path prefix.
+		p.addRPCPath(rpc, rpc.Path.WithVersion(rpc.Version))
+	}
+}
+
+// addRPCPath adds path to the set of RPC paths for each of the RPC's HTTP methods.
+// Different versions of the same API are allowed to share a path.
+// It reports whether the path was added without errors.
+func (p *parser) addRPCPath(rpc *est.RPC, path *paths.Path) (ok bool) {
+	ok = true
 	for _, m := range rpc.HTTPMethods {
-		if err := p.paths.Add(m, rpc.Path); err != nil {
-			if e, ok := err.(*paths.ConflictError); ok {
+		if err := p.paths.Add(m, path); err != nil {
+			ok = false
+			if e, isConflict := err.(*paths.ConflictError); isConflict {
+				if v, found := p.pathVersions[e.Other]; found && rpc.Version > 0 && e.Context == "duplicate path" && v != rpc.Version {
+					if e.Path.String() == e.Other.String() {
+						ok = true
+						continue
+					}
+					p.errf(e.Path.Pos, "invalid API path: versions of the same API must use identical paths (%s and %s, other declaration at %s)",
+						e.Path, e.Other, p.fset.Position(e.Other.Pos))
+					continue
+				}
 				p.errf(e.Path.Pos, "invalid API path: "+e.Context+" (other declaration at %s)",
 					p.fset.Position(e.Other.Pos))
 			} else {
-				p.errf(e.Path.Pos, "invalid API path: %v", e)
+				p.errf(path.Pos, "invalid API path: %v", err)
 			}
 		}
 	}
+	return ok
 }
 
 func (p *parser) initTypedRPC(rpc *est.RPC) {
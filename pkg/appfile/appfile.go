@@ -38,6 +38,25 @@ type File struct {
 
 	// CgoEnabled enables building with cgo.
 	CgoEnabled bool `json:"cgo_enabled,omitempty"`
+
+	// Compression configures compression of API responses.
+	Compression *Compression `json:"compression,omitempty"`
+}
+
+// Compression configures compression of API responses.
+type Compression struct {
+	// Enabled enables compressing API responses, based on the
+	// Accept-Encoding header of the request.
+	Enabled bool `json:"enabled"`
+
+	// Encodings are the content encodings to use, in order of preference.
+	// Supported encodings are "br", "zstd" and "gzip".
+	// If empty it defaults to all of them.
+	Encodings []string `json:"encodings,omitempty"`
+
+	// MinSize is the minimum size of a response body, in bytes,
+	// for it to be compressed. If zero it defaults to 1024.
+	MinSize int `json:"min_size,omitempty"`
 }
 
 type CORS struct {
@@ -123,3 +142,13 @@ func GlobalCORS(appRoot string) (*CORS, error) {
 	}
 	return f.GlobalCORS, nil
 }
+
+// ResponseCompression returns the response compression settings
+// for the app located at appRoot.
+func ResponseCompression(appRoot string) (*Compression, error) {
+	f, err := ParseFile(filepath.Join(appRoot, Name))
+	if err != nil {
+		return nil, err
+	}
+	return f.Compression, nil
+}
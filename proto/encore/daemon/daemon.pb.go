@@ -21,6 +21,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DockerExportParams_OutputFormat int32
+
+const (
+	DockerExportParams_NONE DockerExportParams_OutputFormat = 0
+	// OCI_LAYOUT writes the image as an OCI image layout directory.
+	DockerExportParams_OCI_LAYOUT DockerExportParams_OutputFormat = 1
+	// TARBALL writes the image as a tarball loadable with `docker load`.
+	DockerExportParams_TARBALL DockerExportParams_OutputFormat = 2
+)
+
+// Enum value maps for DockerExportParams_OutputFormat.
+var (
+	DockerExportParams_OutputFormat_name = map[int32]string{
+		0: "NONE",
+		1: "OCI_LAYOUT",
+		2: "TARBALL",
+	}
+	DockerExportParams_OutputFormat_value = map[string]int32{
+		"NONE":       0,
+		"OCI_LAYOUT": 1,
+		"TARBALL":    2,
+	}
+)
+
+func (x DockerExportParams_OutputFormat) Enum() *DockerExportParams_OutputFormat {
+	p := new(DockerExportParams_OutputFormat)
+	*p = x
+	return p
+}
+
+func (x DockerExportParams_OutputFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DockerExportParams_OutputFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_encore_daemon_daemon_proto_enumTypes[0].Descriptor()
+}
+
+func (DockerExportParams_OutputFormat) Type() protoreflect.EnumType {
+	return &file_encore_daemon_daemon_proto_enumTypes[0]
+}
+
+func (x DockerExportParams_OutputFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DockerExportParams_OutputFormat.Descriptor instead.
+func (DockerExportParams_OutputFormat) EnumDescriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{10, 0}
+}
+
 type CommandMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -666,6 +717,8 @@ type ExportRequest struct {
 	Environ []string `protobuf:"bytes,5,rep,name=environ,proto3" json:"environ,omitempty"`
 	// Types that are assignable to Format:
 	//	*ExportRequest_Docker
+	//	*ExportRequest_Kubernetes
+	//	*ExportRequest_Compose
 	Format isExportRequest_Format `protobuf_oneof:"format"`
 }
 
@@ -750,6 +803,20 @@ func (x *ExportRequest) GetDocker() *DockerExportParams {
 	return nil
 }
 
+func (x *ExportRequest) GetKubernetes() *ManifestExportParams {
+	if x, ok := x.GetFormat().(*ExportRequest_Kubernetes); ok {
+		return x.Kubernetes
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetCompose() *ManifestExportParams {
+	if x, ok := x.GetFormat().(*ExportRequest_Compose); ok {
+		return x.Compose
+	}
+	return nil
+}
+
 type isExportRequest_Format interface {
 	isExportRequest_Format()
 }
@@ -759,8 +826,80 @@ type ExportRequest_Docker struct {
 	Docker *DockerExportParams `protobuf:"bytes,6,opt,name=docker,proto3,oneof"`
 }
 
+type ExportRequest_Kubernetes struct {
+	// kubernetes specifies to export Kubernetes manifests for deploying the app.
+	Kubernetes *ManifestExportParams `protobuf:"bytes,7,opt,name=kubernetes,proto3,oneof"`
+}
+
+type ExportRequest_Compose struct {
+	// compose specifies to export a Docker Compose file for deploying the app.
+	Compose *ManifestExportParams `protobuf:"bytes,8,opt,name=compose,proto3,oneof"`
+}
+
 func (*ExportRequest_Docker) isExportRequest_Format() {}
 
+func (*ExportRequest_Kubernetes) isExportRequest_Format() {}
+
+func (*ExportRequest_Compose) isExportRequest_Format() {}
+
+type ManifestExportParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// image_tag is the docker image of the app to deploy,
+	// as built by exporting the app as a docker image.
+	ImageTag string `protobuf:"bytes,1,opt,name=image_tag,json=imageTag,proto3" json:"image_tag,omitempty"`
+	// output_dir is the directory to write the generated files to.
+	OutputDir string `protobuf:"bytes,2,opt,name=output_dir,json=outputDir,proto3" json:"output_dir,omitempty"`
+}
+
+func (x *ManifestExportParams) Reset() {
+	*x = ManifestExportParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestExportParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestExportParams) ProtoMessage() {}
+
+func (x *ManifestExportParams) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestExportParams.ProtoReflect.Descriptor instead.
+func (*ManifestExportParams) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ManifestExportParams) GetImageTag() string {
+	if x != nil {
+		return x.ImageTag
+	}
+	return ""
+}
+
+func (x *ManifestExportParams) GetOutputDir() string {
+	if x != nil {
+		return x.OutputDir
+	}
+	return ""
+}
+
 type DockerExportParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -776,12 +915,45 @@ type DockerExportParams struct {
 	PushDestinationTag string `protobuf:"bytes,2,opt,name=push_destination_tag,json=pushDestinationTag,proto3" json:"push_destination_tag,omitempty"`
 	// base_image_tag is the base image to build the image from.
 	BaseImageTag string `protobuf:"bytes,3,opt,name=base_image_tag,json=baseImageTag,proto3" json:"base_image_tag,omitempty"`
+	// infra_config_path is the path to an infrastructure config file
+	// describing the infrastructure the app runs on. If set, the runtime
+	// config computed from it is written to runtime_config_path.
+	InfraConfigPath string `protobuf:"bytes,4,opt,name=infra_config_path,json=infraConfigPath,proto3" json:"infra_config_path,omitempty"`
+	// service_groups splits the app into one image per group of services,
+	// each specified as a comma-separated list of service names.
+	// Requires infra_config_path to be set.
+	ServiceGroups []string `protobuf:"bytes,5,rep,name=service_groups,json=serviceGroups,proto3" json:"service_groups,omitempty"`
+	// split_services builds one image per service.
+	// Requires infra_config_path to be set.
+	SplitServices bool `protobuf:"varint,6,opt,name=split_services,json=splitServices,proto3" json:"split_services,omitempty"`
+	// output_format specifies the format to write the image to disk in.
+	// If NONE the image is not written to disk.
+	OutputFormat DockerExportParams_OutputFormat `protobuf:"varint,7,opt,name=output_format,json=outputFormat,proto3,enum=encore.daemon.DockerExportParams_OutputFormat" json:"output_format,omitempty"`
+	// output_path is the path to write the image to.
+	// Required if output_format is not NONE.
+	OutputPath string `protobuf:"bytes,8,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`
+	// output_tag is the tag to record for the image written to disk.
+	// Required if output_format is TARBALL.
+	OutputTag string `protobuf:"bytes,9,opt,name=output_tag,json=outputTag,proto3" json:"output_tag,omitempty"`
+	// platforms are the platforms to build the image for, as "os/arch[/variant]".
+	// If more than one is given the images are combined into a multi-platform
+	// image index. If empty the image is built for the goos and goarch of the request.
+	Platforms []string `protobuf:"bytes,10,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	// sbom adds a software bill of materials to the image,
+	// listing the Go modules compiled into the application.
+	Sbom bool `protobuf:"varint,11,opt,name=sbom,proto3" json:"sbom,omitempty"`
+	// runtime_config_path is the path to write the runtime config computed
+	// from the infrastructure config to, as an env file setting ENCORE_RUNTIME_CONFIG.
+	// The runtime config contains credentials, so it is not included in the image
+	// but provided to the containers when deploying them.
+	// Required if infra_config_path is set.
+	RuntimeConfigPath string `protobuf:"bytes,12,opt,name=runtime_config_path,json=runtimeConfigPath,proto3" json:"runtime_config_path,omitempty"`
 }
 
 func (x *DockerExportParams) Reset() {
 	*x = DockerExportParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -794,7 +966,7 @@ func (x *DockerExportParams) String() string {
 func (*DockerExportParams) ProtoMessage() {}
 
 func (x *DockerExportParams) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,7 +979,7 @@ func (x *DockerExportParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerExportParams.ProtoReflect.Descriptor instead.
 func (*DockerExportParams) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{9}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DockerExportParams) GetLocalDaemonTag() string {
@@ -831,6 +1003,69 @@ func (x *DockerExportParams) GetBaseImageTag() string {
 	return ""
 }
 
+func (x *DockerExportParams) GetInfraConfigPath() string {
+	if x != nil {
+		return x.InfraConfigPath
+	}
+	return ""
+}
+
+func (x *DockerExportParams) GetServiceGroups() []string {
+	if x != nil {
+		return x.ServiceGroups
+	}
+	return nil
+}
+
+func (x *DockerExportParams) GetSplitServices() bool {
+	if x != nil {
+		return x.SplitServices
+	}
+	return false
+}
+
+func (x *DockerExportParams) GetOutputFormat() DockerExportParams_OutputFormat {
+	if x != nil {
+		return x.OutputFormat
+	}
+	return DockerExportParams_NONE
+}
+
+func (x *DockerExportParams) GetOutputPath() string {
+	if x != nil {
+		return x.OutputPath
+	}
+	return ""
+}
+
+func (x *DockerExportParams) GetOutputTag() string {
+	if x != nil {
+		return x.OutputTag
+	}
+	return ""
+}
+
+func (x *DockerExportParams) GetPlatforms() []string {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+func (x *DockerExportParams) GetSbom() bool {
+	if x != nil {
+		return x.Sbom
+	}
+	return false
+}
+
+func (x *DockerExportParams) GetRuntimeConfigPath() string {
+	if x != nil {
+		return x.RuntimeConfigPath
+	}
+	return ""
+}
+
 type ResetDBRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -843,7 +1078,7 @@ type ResetDBRequest struct {
 func (x *ResetDBRequest) Reset() {
 	*x = ResetDBRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[10]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -856,7 +1091,7 @@ func (x *ResetDBRequest) String() string {
 func (*ResetDBRequest) ProtoMessage() {}
 
 func (x *ResetDBRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[10]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,7 +1104,7 @@ func (x *ResetDBRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetDBRequest.ProtoReflect.Descriptor instead.
 func (*ResetDBRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{10}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ResetDBRequest) GetAppRoot() string {
@@ -899,7 +1134,7 @@ type DBConnectRequest struct {
 func (x *DBConnectRequest) Reset() {
 	*x = DBConnectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[11]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -912,7 +1147,7 @@ func (x *DBConnectRequest) String() string {
 func (*DBConnectRequest) ProtoMessage() {}
 
 func (x *DBConnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[11]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,7 +1160,7 @@ func (x *DBConnectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBConnectRequest.ProtoReflect.Descriptor instead.
 func (*DBConnectRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{11}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DBConnectRequest) GetAppRoot() string {
@@ -960,7 +1195,7 @@ type DBConnectResponse struct {
 func (x *DBConnectResponse) Reset() {
 	*x = DBConnectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[12]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -973,7 +1208,7 @@ func (x *DBConnectResponse) String() string {
 func (*DBConnectResponse) ProtoMessage() {}
 
 func (x *DBConnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[12]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,7 +1221,7 @@ func (x *DBConnectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBConnectResponse.ProtoReflect.Descriptor instead.
 func (*DBConnectResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{12}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DBConnectResponse) GetDsn() string {
@@ -1009,7 +1244,7 @@ type DBProxyRequest struct {
 func (x *DBProxyRequest) Reset() {
 	*x = DBProxyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[13]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1022,7 +1257,7 @@ func (x *DBProxyRequest) String() string {
 func (*DBProxyRequest) ProtoMessage() {}
 
 func (x *DBProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[13]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1035,7 +1270,7 @@ func (x *DBProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBProxyRequest.ProtoReflect.Descriptor instead.
 func (*DBProxyRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{13}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DBProxyRequest) GetAppRoot() string {
@@ -1071,7 +1306,7 @@ type DBResetRequest struct {
 func (x *DBResetRequest) Reset() {
 	*x = DBResetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[14]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1084,7 +1319,7 @@ func (x *DBResetRequest) String() string {
 func (*DBResetRequest) ProtoMessage() {}
 
 func (x *DBResetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[14]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,7 +1332,7 @@ func (x *DBResetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBResetRequest.ProtoReflect.Descriptor instead.
 func (*DBResetRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{14}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DBResetRequest) GetAppRoot() string {
@@ -1114,6 +1349,61 @@ func (x *DBResetRequest) GetServices() []string {
 	return nil
 }
 
+type DBSeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppRoot  string   `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	Services []string `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"` // services to seed; all if empty
+}
+
+func (x *DBSeedRequest) Reset() {
+	*x = DBSeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBSeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBSeedRequest) ProtoMessage() {}
+
+func (x *DBSeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBSeedRequest.ProtoReflect.Descriptor instead.
+func (*DBSeedRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DBSeedRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *DBSeedRequest) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
 type GenClientRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1123,12 +1413,15 @@ type GenClientRequest struct {
 	EnvName  string `protobuf:"bytes,2,opt,name=env_name,json=envName,proto3" json:"env_name,omitempty"`
 	Lang     string `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`
 	Filepath string `protobuf:"bytes,4,opt,name=filepath,proto3" json:"filepath,omitempty"`
+	// api_version is the API version to generate the client for,
+	// or 0 to include all versions.
+	ApiVersion uint32 `protobuf:"varint,5,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
 }
 
 func (x *GenClientRequest) Reset() {
 	*x = GenClientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[15]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1141,7 +1434,7 @@ func (x *GenClientRequest) String() string {
 func (*GenClientRequest) ProtoMessage() {}
 
 func (x *GenClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[15]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1154,7 +1447,7 @@ func (x *GenClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenClientRequest.ProtoReflect.Descriptor instead.
 func (*GenClientRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{15}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GenClientRequest) GetAppId() string {
@@ -1185,6 +1478,13 @@ func (x *GenClientRequest) GetFilepath() string {
 	return ""
 }
 
+func (x *GenClientRequest) GetApiVersion() uint32 {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return 0
+}
+
 type GenClientResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1196,7 +1496,7 @@ type GenClientResponse struct {
 func (x *GenClientResponse) Reset() {
 	*x = GenClientResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[16]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1209,7 +1509,7 @@ func (x *GenClientResponse) String() string {
 func (*GenClientResponse) ProtoMessage() {}
 
 func (x *GenClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[16]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1522,7 @@ func (x *GenClientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenClientResponse.ProtoReflect.Descriptor instead.
 func (*GenClientResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{16}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GenClientResponse) GetCode() []byte {
@@ -1232,6 +1532,69 @@ func (x *GenClientResponse) GetCode() []byte {
 	return nil
 }
 
+type MockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppRoot     string `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	Port        int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`                                 // optional
+	FixturesDir string `protobuf:"bytes,3,opt,name=fixtures_dir,json=fixturesDir,proto3" json:"fixtures_dir,omitempty"` // optional; directory of example responses
+}
+
+func (x *MockRequest) Reset() {
+	*x = MockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockRequest) ProtoMessage() {}
+
+func (x *MockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockRequest.ProtoReflect.Descriptor instead.
+func (*MockRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MockRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *MockRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *MockRequest) GetFixturesDir() string {
+	if x != nil {
+		return x.FixturesDir
+	}
+	return ""
+}
+
 type GenWrappersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1243,7 +1606,7 @@ type GenWrappersRequest struct {
 func (x *GenWrappersRequest) Reset() {
 	*x = GenWrappersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[17]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1256,7 +1619,7 @@ func (x *GenWrappersRequest) String() string {
 func (*GenWrappersRequest) ProtoMessage() {}
 
 func (x *GenWrappersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[17]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,7 +1632,7 @@ func (x *GenWrappersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenWrappersRequest.ProtoReflect.Descriptor instead.
 func (*GenWrappersRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{17}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GenWrappersRequest) GetAppRoot() string {
@@ -1288,7 +1651,7 @@ type GenWrappersResponse struct {
 func (x *GenWrappersResponse) Reset() {
 	*x = GenWrappersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[18]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1301,7 +1664,7 @@ func (x *GenWrappersResponse) String() string {
 func (*GenWrappersResponse) ProtoMessage() {}
 
 func (x *GenWrappersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[18]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,7 +1677,7 @@ func (x *GenWrappersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenWrappersResponse.ProtoReflect.Descriptor instead.
 func (*GenWrappersResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{18}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{21}
 }
 
 type SecretsRefreshRequest struct {
@@ -1330,7 +1693,7 @@ type SecretsRefreshRequest struct {
 func (x *SecretsRefreshRequest) Reset() {
 	*x = SecretsRefreshRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[19]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1343,7 +1706,7 @@ func (x *SecretsRefreshRequest) String() string {
 func (*SecretsRefreshRequest) ProtoMessage() {}
 
 func (x *SecretsRefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[19]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1356,7 +1719,7 @@ func (x *SecretsRefreshRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretsRefreshRequest.ProtoReflect.Descriptor instead.
 func (*SecretsRefreshRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{19}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SecretsRefreshRequest) GetAppRoot() string {
@@ -1389,7 +1752,7 @@ type SecretsRefreshResponse struct {
 func (x *SecretsRefreshResponse) Reset() {
 	*x = SecretsRefreshResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[20]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1402,7 +1765,7 @@ func (x *SecretsRefreshResponse) String() string {
 func (*SecretsRefreshResponse) ProtoMessage() {}
 
 func (x *SecretsRefreshResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[20]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,7 +1778,102 @@ func (x *SecretsRefreshResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretsRefreshResponse.ProtoReflect.Descriptor instead.
 func (*SecretsRefreshResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{20}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{23}
+}
+
+type SecretsListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppRoot string `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+}
+
+func (x *SecretsListRequest) Reset() {
+	*x = SecretsListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretsListRequest) ProtoMessage() {}
+
+func (x *SecretsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretsListRequest.ProtoReflect.Descriptor instead.
+func (*SecretsListRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SecretsListRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+type SecretsListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keys are the secret keys used by the application, in sorted order.
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SecretsListResponse) Reset() {
+	*x = SecretsListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretsListResponse) ProtoMessage() {}
+
+func (x *SecretsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretsListResponse.ProtoReflect.Descriptor instead.
+func (*SecretsListResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SecretsListResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
 }
 
 type VersionResponse struct {
@@ -1430,7 +1888,7 @@ type VersionResponse struct {
 func (x *VersionResponse) Reset() {
 	*x = VersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[21]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1443,7 +1901,7 @@ func (x *VersionResponse) String() string {
 func (*VersionResponse) ProtoMessage() {}
 
 func (x *VersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[21]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1456,7 +1914,7 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{21}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *VersionResponse) GetVersion() string {
@@ -1555,7 +2013,7 @@ var file_encore_daemon_daemon_proto_rawDesc = []byte{
 	0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65, 0x54, 0x65, 0x73, 0x74, 0x73,
 	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x22, 0xd8, 0x01, 0x0a, 0x0d, 0x45,
+	0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x22, 0xe0, 0x02, 0x0a, 0x0d, 0x45,
 	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
 	0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
 	0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6f, 0x6f, 0x73, 0x18,
@@ -1568,128 +2026,201 @@ var file_encore_daemon_daemon_proto_rawDesc = []byte{
 	0x0a, 0x06, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
 	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44,
 	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x10,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x22, 0x47,
-	0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x10, 0x44, 0x42, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
-	0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x25, 0x0a, 0x11, 0x44, 0x42,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73,
-	0x6e, 0x22, 0x5a, 0x0a, 0x0e, 0x44, 0x42, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x65, 0x6e, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x47, 0x0a,
-	0x0e, 0x44, 0x42, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x73, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x6b,
+	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x52, 0x0a,
+	0x14, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x54,
+	0x61, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x69, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69,
+	0x72, 0x22, 0xbe, 0x04, 0x0a, 0x12, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x54,
+	0x61, 0x67, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61,
+	0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x65, 0x6e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0c, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x62, 0x6f, 0x6d, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x62, 0x6f, 0x6d, 0x12, 0x2e, 0x0a, 0x13, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x22, 0x35, 0x0a, 0x0c, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x43, 0x49, 0x5f, 0x4c, 0x41, 0x59,
+	0x4f, 0x55, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x41, 0x52, 0x42, 0x41, 0x4c, 0x4c,
+	0x10, 0x02, 0x22, 0x47, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x44, 0x42, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x10, 0x44,
+	0x42, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70,
-	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6c, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x22, 0x27, 0x0a, 0x11,
-	0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2f, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x25,
+	0x0a, 0x11, 0x44, 0x42, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x73, 0x6e, 0x22, 0x5a, 0x0a, 0x0e, 0x44, 0x42, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x47, 0x0a, 0x0e, 0x44, 0x42, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x0d, 0x44, 0x42,
+	0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
 	0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a,
-	0x15, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x61, 0x73,
-	0x68, 0x32, 0x9f, 0x07, 0x0a, 0x06, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x03,
-	0x52, 0x75, 0x6e, 0x12, 0x19, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x6e, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x47, 0x65,
+	0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x5f, 0x0a, 0x0b, 0x4d, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x44, 0x69, 0x72, 0x22, 0x2f, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70,
+	0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x15,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2f, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52,
+	0x6f, 0x6f, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x4c,
+	0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x32, 0x83, 0x09, 0x0a,
+	0x06, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x19,
+	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
+	0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x04, 0x54, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
 	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43,
 	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12,
-	0x43, 0x0a, 0x04, 0x54, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x12, 0x20, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1b,
-	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x06,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x42, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x12, 0x1f, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x44, 0x42, 0x50, 0x72, 0x6f, 0x78, 0x79,
-	0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x44, 0x42, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x4f, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x20, 0x2e,
+	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
 	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01,
-	0x12, 0x49, 0x0a, 0x07, 0x44, 0x42, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x6e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63,
+	0x12, 0x45, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01,
+	0x12, 0x4e, 0x0a, 0x09, 0x44, 0x42, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x2e,
+	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44,
+	0x42, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x07, 0x44, 0x42, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1d, 0x2e, 0x65, 0x6e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63,
 	0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x09, 0x47,
-	0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x47,
-	0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x57, 0x72,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x07, 0x44,
+	0x42, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x06, 0x44, 0x42, 0x53, 0x65, 0x65, 0x64,
+	0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x44, 0x42, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12,
+	0x4e, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x65,
+	0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
 	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
-	0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x12, 0x24, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x41, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x1e, 0x5a, 0x1c, 0x65, 0x6e, 0x63, 0x72, 0x2e, 0x64, 0x65, 0x76, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x54, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x12, 0x21,
+	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47,
+	0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x4d, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e,
+	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x0e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x24, 0x2e, 0x65,
+	0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x6e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x1e, 0x5a, 0x1c, 0x65, 0x6e, 0x63, 0x72, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1704,66 +2235,82 @@ func file_encore_daemon_daemon_proto_rawDescGZIP() []byte {
 	return file_encore_daemon_daemon_proto_rawDescData
 }
 
-var file_encore_daemon_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_encore_daemon_daemon_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_encore_daemon_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_encore_daemon_daemon_proto_goTypes = []interface{}{
-	(*CommandMessage)(nil),         // 0: encore.daemon.CommandMessage
-	(*CommandOutput)(nil),          // 1: encore.daemon.CommandOutput
-	(*CommandExit)(nil),            // 2: encore.daemon.CommandExit
-	(*CommandDisplayErrors)(nil),   // 3: encore.daemon.CommandDisplayErrors
-	(*RunRequest)(nil),             // 4: encore.daemon.RunRequest
-	(*TestRequest)(nil),            // 5: encore.daemon.TestRequest
-	(*ExecScriptRequest)(nil),      // 6: encore.daemon.ExecScriptRequest
-	(*CheckRequest)(nil),           // 7: encore.daemon.CheckRequest
-	(*ExportRequest)(nil),          // 8: encore.daemon.ExportRequest
-	(*DockerExportParams)(nil),     // 9: encore.daemon.DockerExportParams
-	(*ResetDBRequest)(nil),         // 10: encore.daemon.ResetDBRequest
-	(*DBConnectRequest)(nil),       // 11: encore.daemon.DBConnectRequest
-	(*DBConnectResponse)(nil),      // 12: encore.daemon.DBConnectResponse
-	(*DBProxyRequest)(nil),         // 13: encore.daemon.DBProxyRequest
-	(*DBResetRequest)(nil),         // 14: encore.daemon.DBResetRequest
-	(*GenClientRequest)(nil),       // 15: encore.daemon.GenClientRequest
-	(*GenClientResponse)(nil),      // 16: encore.daemon.GenClientResponse
-	(*GenWrappersRequest)(nil),     // 17: encore.daemon.GenWrappersRequest
-	(*GenWrappersResponse)(nil),    // 18: encore.daemon.GenWrappersResponse
-	(*SecretsRefreshRequest)(nil),  // 19: encore.daemon.SecretsRefreshRequest
-	(*SecretsRefreshResponse)(nil), // 20: encore.daemon.SecretsRefreshResponse
-	(*VersionResponse)(nil),        // 21: encore.daemon.VersionResponse
-	(*emptypb.Empty)(nil),          // 22: google.protobuf.Empty
+	(DockerExportParams_OutputFormat)(0), // 0: encore.daemon.DockerExportParams.OutputFormat
+	(*CommandMessage)(nil),               // 1: encore.daemon.CommandMessage
+	(*CommandOutput)(nil),                // 2: encore.daemon.CommandOutput
+	(*CommandExit)(nil),                  // 3: encore.daemon.CommandExit
+	(*CommandDisplayErrors)(nil),         // 4: encore.daemon.CommandDisplayErrors
+	(*RunRequest)(nil),                   // 5: encore.daemon.RunRequest
+	(*TestRequest)(nil),                  // 6: encore.daemon.TestRequest
+	(*ExecScriptRequest)(nil),            // 7: encore.daemon.ExecScriptRequest
+	(*CheckRequest)(nil),                 // 8: encore.daemon.CheckRequest
+	(*ExportRequest)(nil),                // 9: encore.daemon.ExportRequest
+	(*ManifestExportParams)(nil),         // 10: encore.daemon.ManifestExportParams
+	(*DockerExportParams)(nil),           // 11: encore.daemon.DockerExportParams
+	(*ResetDBRequest)(nil),               // 12: encore.daemon.ResetDBRequest
+	(*DBConnectRequest)(nil),             // 13: encore.daemon.DBConnectRequest
+	(*DBConnectResponse)(nil),            // 14: encore.daemon.DBConnectResponse
+	(*DBProxyRequest)(nil),               // 15: encore.daemon.DBProxyRequest
+	(*DBResetRequest)(nil),               // 16: encore.daemon.DBResetRequest
+	(*DBSeedRequest)(nil),                // 17: encore.daemon.DBSeedRequest
+	(*GenClientRequest)(nil),             // 18: encore.daemon.GenClientRequest
+	(*GenClientResponse)(nil),            // 19: encore.daemon.GenClientResponse
+	(*MockRequest)(nil),                  // 20: encore.daemon.MockRequest
+	(*GenWrappersRequest)(nil),           // 21: encore.daemon.GenWrappersRequest
+	(*GenWrappersResponse)(nil),          // 22: encore.daemon.GenWrappersResponse
+	(*SecretsRefreshRequest)(nil),        // 23: encore.daemon.SecretsRefreshRequest
+	(*SecretsRefreshResponse)(nil),       // 24: encore.daemon.SecretsRefreshResponse
+	(*SecretsListRequest)(nil),           // 25: encore.daemon.SecretsListRequest
+	(*SecretsListResponse)(nil),          // 26: encore.daemon.SecretsListResponse
+	(*VersionResponse)(nil),              // 27: encore.daemon.VersionResponse
+	(*emptypb.Empty)(nil),                // 28: google.protobuf.Empty
 }
 var file_encore_daemon_daemon_proto_depIdxs = []int32{
-	1,  // 0: encore.daemon.CommandMessage.output:type_name -> encore.daemon.CommandOutput
-	2,  // 1: encore.daemon.CommandMessage.exit:type_name -> encore.daemon.CommandExit
-	3,  // 2: encore.daemon.CommandMessage.errors:type_name -> encore.daemon.CommandDisplayErrors
-	9,  // 3: encore.daemon.ExportRequest.docker:type_name -> encore.daemon.DockerExportParams
-	4,  // 4: encore.daemon.Daemon.Run:input_type -> encore.daemon.RunRequest
-	5,  // 5: encore.daemon.Daemon.Test:input_type -> encore.daemon.TestRequest
-	6,  // 6: encore.daemon.Daemon.ExecScript:input_type -> encore.daemon.ExecScriptRequest
-	7,  // 7: encore.daemon.Daemon.Check:input_type -> encore.daemon.CheckRequest
-	8,  // 8: encore.daemon.Daemon.Export:input_type -> encore.daemon.ExportRequest
-	11, // 9: encore.daemon.Daemon.DBConnect:input_type -> encore.daemon.DBConnectRequest
-	13, // 10: encore.daemon.Daemon.DBProxy:input_type -> encore.daemon.DBProxyRequest
-	14, // 11: encore.daemon.Daemon.DBReset:input_type -> encore.daemon.DBResetRequest
-	15, // 12: encore.daemon.Daemon.GenClient:input_type -> encore.daemon.GenClientRequest
-	17, // 13: encore.daemon.Daemon.GenWrappers:input_type -> encore.daemon.GenWrappersRequest
-	19, // 14: encore.daemon.Daemon.SecretsRefresh:input_type -> encore.daemon.SecretsRefreshRequest
-	22, // 15: encore.daemon.Daemon.Version:input_type -> google.protobuf.Empty
-	0,  // 16: encore.daemon.Daemon.Run:output_type -> encore.daemon.CommandMessage
-	0,  // 17: encore.daemon.Daemon.Test:output_type -> encore.daemon.CommandMessage
-	0,  // 18: encore.daemon.Daemon.ExecScript:output_type -> encore.daemon.CommandMessage
-	0,  // 19: encore.daemon.Daemon.Check:output_type -> encore.daemon.CommandMessage
-	0,  // 20: encore.daemon.Daemon.Export:output_type -> encore.daemon.CommandMessage
-	12, // 21: encore.daemon.Daemon.DBConnect:output_type -> encore.daemon.DBConnectResponse
-	0,  // 22: encore.daemon.Daemon.DBProxy:output_type -> encore.daemon.CommandMessage
-	0,  // 23: encore.daemon.Daemon.DBReset:output_type -> encore.daemon.CommandMessage
-	16, // 24: encore.daemon.Daemon.GenClient:output_type -> encore.daemon.GenClientResponse
-	18, // 25: encore.daemon.Daemon.GenWrappers:output_type -> encore.daemon.GenWrappersResponse
-	20, // 26: encore.daemon.Daemon.SecretsRefresh:output_type -> encore.daemon.SecretsRefreshResponse
-	21, // 27: encore.daemon.Daemon.Version:output_type -> encore.daemon.VersionResponse
-	16, // [16:28] is the sub-list for method output_type
-	4,  // [4:16] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	2,  // 0: encore.daemon.CommandMessage.output:type_name -> encore.daemon.CommandOutput
+	3,  // 1: encore.daemon.CommandMessage.exit:type_name -> encore.daemon.CommandExit
+	4,  // 2: encore.daemon.CommandMessage.errors:type_name -> encore.daemon.CommandDisplayErrors
+	11, // 3: encore.daemon.ExportRequest.docker:type_name -> encore.daemon.DockerExportParams
+	10, // 4: encore.daemon.ExportRequest.kubernetes:type_name -> encore.daemon.ManifestExportParams
+	10, // 5: encore.daemon.ExportRequest.compose:type_name -> encore.daemon.ManifestExportParams
+	0,  // 6: encore.daemon.DockerExportParams.output_format:type_name -> encore.daemon.DockerExportParams.OutputFormat
+	5,  // 7: encore.daemon.Daemon.Run:input_type -> encore.daemon.RunRequest
+	6,  // 8: encore.daemon.Daemon.Test:input_type -> encore.daemon.TestRequest
+	7,  // 9: encore.daemon.Daemon.ExecScript:input_type -> encore.daemon.ExecScriptRequest
+	8,  // 10: encore.daemon.Daemon.Check:input_type -> encore.daemon.CheckRequest
+	9,  // 11: encore.daemon.Daemon.Export:input_type -> encore.daemon.ExportRequest
+	13, // 12: encore.daemon.Daemon.DBConnect:input_type -> encore.daemon.DBConnectRequest
+	15, // 13: encore.daemon.Daemon.DBProxy:input_type -> encore.daemon.DBProxyRequest
+	16, // 14: encore.daemon.Daemon.DBReset:input_type -> encore.daemon.DBResetRequest
+	17, // 15: encore.daemon.Daemon.DBSeed:input_type -> encore.daemon.DBSeedRequest
+	18, // 16: encore.daemon.Daemon.GenClient:input_type -> encore.daemon.GenClientRequest
+	21, // 17: encore.daemon.Daemon.GenWrappers:input_type -> encore.daemon.GenWrappersRequest
+	20, // 18: encore.daemon.Daemon.Mock:input_type -> encore.daemon.MockRequest
+	23, // 19: encore.daemon.Daemon.SecretsRefresh:input_type -> encore.daemon.SecretsRefreshRequest
+	25, // 20: encore.daemon.Daemon.SecretsList:input_type -> encore.daemon.SecretsListRequest
+	28, // 21: encore.daemon.Daemon.Version:input_type -> google.protobuf.Empty
+	1,  // 22: encore.daemon.Daemon.Run:output_type -> encore.daemon.CommandMessage
+	1,  // 23: encore.daemon.Daemon.Test:output_type -> encore.daemon.CommandMessage
+	1,  // 24: encore.daemon.Daemon.ExecScript:output_type -> encore.daemon.CommandMessage
+	1,  // 25: encore.daemon.Daemon.Check:output_type -> encore.daemon.CommandMessage
+	1,  // 26: encore.daemon.Daemon.Export:output_type -> encore.daemon.CommandMessage
+	14, // 27: encore.daemon.Daemon.DBConnect:output_type -> encore.daemon.DBConnectResponse
+	1,  // 28: encore.daemon.Daemon.DBProxy:output_type -> encore.daemon.CommandMessage
+	1,  // 29: encore.daemon.Daemon.DBReset:output_type -> encore.daemon.CommandMessage
+	1,  // 30: encore.daemon.Daemon.DBSeed:output_type -> encore.daemon.CommandMessage
+	19, // 31: encore.daemon.Daemon.GenClient:output_type -> encore.daemon.GenClientResponse
+	22, // 32: encore.daemon.Daemon.GenWrappers:output_type -> encore.daemon.GenWrappersResponse
+	1,  // 33: encore.daemon.Daemon.Mock:output_type -> encore.daemon.CommandMessage
+	24, // 34: encore.daemon.Daemon.SecretsRefresh:output_type -> encore.daemon.SecretsRefreshResponse
+	26, // 35: encore.daemon.Daemon.SecretsList:output_type -> encore.daemon.SecretsListResponse
+	27, // 36: encore.daemon.Daemon.Version:output_type -> encore.daemon.VersionResponse
+	22, // [22:37] is the sub-list for method output_type
+	7,  // [7:22] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_encore_daemon_daemon_proto_init() }
@@ -1881,7 +2428,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DockerExportParams); i {
+			switch v := v.(*ManifestExportParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1893,7 +2440,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetDBRequest); i {
+			switch v := v.(*DockerExportParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1905,7 +2452,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBConnectRequest); i {
+			switch v := v.(*ResetDBRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1917,7 +2464,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBConnectResponse); i {
+			switch v := v.(*DBConnectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1929,7 +2476,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBProxyRequest); i {
+			switch v := v.(*DBConnectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1941,7 +2488,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBResetRequest); i {
+			switch v := v.(*DBProxyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1953,7 +2500,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenClientRequest); i {
+			switch v := v.(*DBResetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1965,7 +2512,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenClientResponse); i {
+			switch v := v.(*DBSeedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1977,7 +2524,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenWrappersRequest); i {
+			switch v := v.(*GenClientRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1989,7 +2536,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenWrappersResponse); i {
+			switch v := v.(*GenClientResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2001,7 +2548,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretsRefreshRequest); i {
+			switch v := v.(*MockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2013,7 +2560,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretsRefreshResponse); i {
+			switch v := v.(*GenWrappersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2025,6 +2572,66 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenWrappersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsRefreshRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsRefreshResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VersionResponse); i {
 			case 0:
 				return &v.state
@@ -2047,19 +2654,22 @@ func file_encore_daemon_daemon_proto_init() {
 	file_encore_daemon_daemon_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	file_encore_daemon_daemon_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*ExportRequest_Docker)(nil),
+		(*ExportRequest_Kubernetes)(nil),
+		(*ExportRequest_Compose)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_encore_daemon_daemon_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   22,
+			NumEnums:      1,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_encore_daemon_daemon_proto_goTypes,
 		DependencyIndexes: file_encore_daemon_daemon_proto_depIdxs,
+		EnumInfos:         file_encore_daemon_daemon_proto_enumTypes,
 		MessageInfos:      file_encore_daemon_daemon_proto_msgTypes,
 	}.Build()
 	File_encore_daemon_daemon_proto = out.File
@@ -40,13 +40,19 @@ type DaemonClient interface {
 	DBProxy(ctx context.Context, in *DBProxyRequest, opts ...grpc.CallOption) (Daemon_DBProxyClient, error)
 	// DBReset resets the given databases, recreating them from scratch.
 	DBReset(ctx context.Context, in *DBResetRequest, opts ...grpc.CallOption) (Daemon_DBResetClient, error)
+	// DBSeed runs the seed scripts of the given databases.
+	DBSeed(ctx context.Context, in *DBSeedRequest, opts ...grpc.CallOption) (Daemon_DBSeedClient, error)
 	// GenClient generates a client based on the app's API.
 	GenClient(ctx context.Context, in *GenClientRequest, opts ...grpc.CallOption) (*GenClientResponse, error)
 	// GenWrappers generates user-facing wrapper code.
 	GenWrappers(ctx context.Context, in *GenWrappersRequest, opts ...grpc.CallOption) (*GenWrappersResponse, error)
+	// Mock serves a mock version of the app's API, synthesized from the app's metadata.
+	Mock(ctx context.Context, in *MockRequest, opts ...grpc.CallOption) (Daemon_MockClient, error)
 	// SecretsRefresh tells the daemon to refresh the local development secrets
 	// for the given application.
 	SecretsRefresh(ctx context.Context, in *SecretsRefreshRequest, opts ...grpc.CallOption) (*SecretsRefreshResponse, error)
+	// SecretsList lists the keys of the secrets used by the given application.
+	SecretsList(ctx context.Context, in *SecretsListRequest, opts ...grpc.CallOption) (*SecretsListResponse, error)
 	// Version reports the daemon version.
 	Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
 }
@@ -292,6 +298,38 @@ func (x *daemonDBResetClient) Recv() (*CommandMessage, error) {
 	return m, nil
 }
 
+func (c *daemonClient) DBSeed(ctx context.Context, in *DBSeedRequest, opts ...grpc.CallOption) (Daemon_DBSeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[7], "/encore.daemon.Daemon/DBSeed", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonDBSeedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_DBSeedClient interface {
+	Recv() (*CommandMessage, error)
+	grpc.ClientStream
+}
+
+type daemonDBSeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonDBSeedClient) Recv() (*CommandMessage, error) {
+	m := new(CommandMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *daemonClient) GenClient(ctx context.Context, in *GenClientRequest, opts ...grpc.CallOption) (*GenClientResponse, error) {
 	out := new(GenClientResponse)
 	err := c.cc.Invoke(ctx, "/encore.daemon.Daemon/GenClient", in, out, opts...)
@@ -310,6 +348,38 @@ func (c *daemonClient) GenWrappers(ctx context.Context, in *GenWrappersRequest,
 	return out, nil
 }
 
+func (c *daemonClient) Mock(ctx context.Context, in *MockRequest, opts ...grpc.CallOption) (Daemon_MockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[8], "/encore.daemon.Daemon/Mock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonMockClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_MockClient interface {
+	Recv() (*CommandMessage, error)
+	grpc.ClientStream
+}
+
+type daemonMockClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonMockClient) Recv() (*CommandMessage, error) {
+	m := new(CommandMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *daemonClient) SecretsRefresh(ctx context.Context, in *SecretsRefreshRequest, opts ...grpc.CallOption) (*SecretsRefreshResponse, error) {
 	out := new(SecretsRefreshResponse)
 	err := c.cc.Invoke(ctx, "/encore.daemon.Daemon/SecretsRefresh", in, out, opts...)
@@ -319,6 +389,15 @@ func (c *daemonClient) SecretsRefresh(ctx context.Context, in *SecretsRefreshReq
 	return out, nil
 }
 
+func (c *daemonClient) SecretsList(ctx context.Context, in *SecretsListRequest, opts ...grpc.CallOption) (*SecretsListResponse, error) {
+	out := new(SecretsListResponse)
+	err := c.cc.Invoke(ctx, "/encore.daemon.Daemon/SecretsList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
 	out := new(VersionResponse)
 	err := c.cc.Invoke(ctx, "/encore.daemon.Daemon/Version", in, out, opts...)
@@ -349,13 +428,19 @@ type DaemonServer interface {
 	DBProxy(*DBProxyRequest, Daemon_DBProxyServer) error
 	// DBReset resets the given databases, recreating them from scratch.
 	DBReset(*DBResetRequest, Daemon_DBResetServer) error
+	// DBSeed runs the seed scripts of the given databases.
+	DBSeed(*DBSeedRequest, Daemon_DBSeedServer) error
 	// GenClient generates a client based on the app's API.
 	GenClient(context.Context, *GenClientRequest) (*GenClientResponse, error)
 	// GenWrappers generates user-facing wrapper code.
 	GenWrappers(context.Context, *GenWrappersRequest) (*GenWrappersResponse, error)
+	// Mock serves a mock version of the app's API, synthesized from the app's metadata.
+	Mock(*MockRequest, Daemon_MockServer) error
 	// SecretsRefresh tells the daemon to refresh the local development secrets
 	// for the given application.
 	SecretsRefresh(context.Context, *SecretsRefreshRequest) (*SecretsRefreshResponse, error)
+	// SecretsList lists the keys of the secrets used by the given application.
+	SecretsList(context.Context, *SecretsListRequest) (*SecretsListResponse, error)
 	// Version reports the daemon version.
 	Version(context.Context, *emptypb.Empty) (*VersionResponse, error)
 	mustEmbedUnimplementedDaemonServer()
@@ -389,15 +474,24 @@ func (UnimplementedDaemonServer) DBProxy(*DBProxyRequest, Daemon_DBProxyServer)
 func (UnimplementedDaemonServer) DBReset(*DBResetRequest, Daemon_DBResetServer) error {
 	return status.Errorf(codes.Unimplemented, "method DBReset not implemented")
 }
+func (UnimplementedDaemonServer) DBSeed(*DBSeedRequest, Daemon_DBSeedServer) error {
+	return status.Errorf(codes.Unimplemented, "method DBSeed not implemented")
+}
 func (UnimplementedDaemonServer) GenClient(context.Context, *GenClientRequest) (*GenClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenClient not implemented")
 }
 func (UnimplementedDaemonServer) GenWrappers(context.Context, *GenWrappersRequest) (*GenWrappersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenWrappers not implemented")
 }
+func (UnimplementedDaemonServer) Mock(*MockRequest, Daemon_MockServer) error {
+	return status.Errorf(codes.Unimplemented, "method Mock not implemented")
+}
 func (UnimplementedDaemonServer) SecretsRefresh(context.Context, *SecretsRefreshRequest) (*SecretsRefreshResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SecretsRefresh not implemented")
 }
+func (UnimplementedDaemonServer) SecretsList(context.Context, *SecretsListRequest) (*SecretsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretsList not implemented")
+}
 func (UnimplementedDaemonServer) Version(context.Context, *emptypb.Empty) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
@@ -579,6 +673,27 @@ func (x *daemonDBResetServer) Send(m *CommandMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Daemon_DBSeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DBSeedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).DBSeed(m, &daemonDBSeedServer{stream})
+}
+
+type Daemon_DBSeedServer interface {
+	Send(*CommandMessage) error
+	grpc.ServerStream
+}
+
+type daemonDBSeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonDBSeedServer) Send(m *CommandMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Daemon_GenClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenClientRequest)
 	if err := dec(in); err != nil {
@@ -615,6 +730,27 @@ func _Daemon_GenWrappers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_Mock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MockRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).Mock(m, &daemonMockServer{stream})
+}
+
+type Daemon_MockServer interface {
+	Send(*CommandMessage) error
+	grpc.ServerStream
+}
+
+type daemonMockServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonMockServer) Send(m *CommandMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Daemon_SecretsRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SecretsRefreshRequest)
 	if err := dec(in); err != nil {
@@ -633,6 +769,24 @@ func _Daemon_SecretsRefresh_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_SecretsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SecretsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/encore.daemon.Daemon/SecretsList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SecretsList(ctx, req.(*SecretsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -674,6 +828,10 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SecretsRefresh",
 			Handler:    _Daemon_SecretsRefresh_Handler,
 		},
+		{
+			MethodName: "SecretsList",
+			Handler:    _Daemon_SecretsList_Handler,
+		},
 		{
 			MethodName: "Version",
 			Handler:    _Daemon_Version_Handler,
@@ -715,6 +873,16 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Daemon_DBReset_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DBSeed",
+			Handler:       _Daemon_DBSeed_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Mock",
+			Handler:       _Daemon_Mock_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "encore/daemon/daemon.proto",
 }
@@ -28,6 +28,10 @@ export interface Data {
   cache_clusters: CacheCluster[];
   experiments: string[];
   metrics: Metric[];
+  /** types implementing errs.ErrDetails */
+  err_details: ErrDetails[];
+  /** all auth handlers, in the order they are tried; auth_handler is the first */
+  auth_handlers: AuthHandler[];
 }
 
 /**
@@ -68,6 +72,15 @@ export interface Service {
   databases: string[];
   /** true if the service has uses config */
   has_config: boolean;
+  /** the engine of the service's database, if it has migrations */
+  sql_engine: Service_SQLEngine;
+}
+
+export enum Service_SQLEngine {
+  POSTGRES = "POSTGRES",
+  MYSQL = "MYSQL",
+  SQLITE = "SQLITE",
+  UNRECOGNIZED = "UNRECOGNIZED",
 }
 
 export interface Selector {
@@ -110,6 +123,12 @@ export interface RPC {
   path: Path;
   http_methods: string[];
   tags: Selector[];
+  /** the API version of the endpoint, or 0 if unversioned */
+  version: number;
+  /** deprecation information, or nil if not deprecated */
+  deprecation?: RPC_Deprecation | undefined;
+  /** the auth schemes the endpoint accepts, in order; empty means all */
+  auth_schemes: string[];
 }
 
 export enum RPC_AccessType {
@@ -125,6 +144,13 @@ export enum RPC_Protocol {
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
+export interface RPC_Deprecation {
+  /** the date the endpoint was deprecated ("YYYY-MM-DD"), if known */
+  since?: string | undefined;
+  /** the date the endpoint will be removed ("YYYY-MM-DD"), if known */
+  sunset?: string | undefined;
+}
+
 export interface AuthHandler {
   name: string;
   doc: string;
@@ -137,6 +163,8 @@ export interface AuthHandler {
   auth_data?: Type | undefined;
   /** builtin string or named type */
   params?: Type | undefined;
+  /** the auth scheme name, or "" for an unnamed auth handler */
+  scheme: string;
 }
 
 export interface Middleware {
@@ -383,3 +411,14 @@ export interface Metric_Label {
   type: Builtin;
   doc: string;
 }
+
+/**
+ * ErrDetails describes a type implementing errs.ErrDetails,
+ * which may be returned to clients as part of an error.
+ */
+export interface ErrDetails {
+  /** the name identifying the type on the wire ("pkgname.TypeName") */
+  name: string;
+  /** the named type */
+  type: Type | undefined;
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	jsoniter "github.com/json-iterator/go"
+	"golang.org/x/exp/slices"
 
 	"encore.dev/appruntime/exported/model"
 	"encore.dev/beta/errs"
@@ -19,7 +20,8 @@ type AuthHandlerDesc[Params any] struct {
 	SvcNum      uint16
 	Endpoint    string
 	DefLoc      int32
-	HasAuthData bool // whether the handler returns custom auth data
+	HasAuthData bool   // whether the handler returns custom auth data
+	Scheme      string // the auth scheme the handler implements, or "" if unnamed
 
 	DecodeAuth  func(*http.Request) (Params, error)
 	AuthHandler func(context.Context, Params) (model.AuthInfo, error)
@@ -29,9 +31,12 @@ type AuthHandlerDesc[Params any] struct {
 }
 
 type AuthHandler interface {
+	AuthScheme() string
 	Authenticate(IncomingContext) (model.AuthInfo, error)
 }
 
+func (d *AuthHandlerDesc[Params]) AuthScheme() string { return d.Scheme }
+
 // Authenticate authenticates the request.
 // If err != nil it should be written back as the response.
 func (d *AuthHandlerDesc[Params]) Authenticate(c IncomingContext) (model.AuthInfo, error) {
@@ -81,6 +86,7 @@ func (d *AuthHandlerDesc[Params]) Authenticate(c IncomingContext) (model.AuthInf
 		}
 
 		info, authErr = d.AuthHandler(c.req.Context(), param)
+		info.Scheme = d.Scheme
 
 		if authErr != nil {
 			authErr = errs.RoundTrip(authErr)
@@ -96,11 +102,16 @@ func (d *AuthHandlerDesc[Params]) Authenticate(c IncomingContext) (model.AuthInf
 	return info, authErr
 }
 
-// runAuthHandler runs the auth handler, if provided.
+// runAuthHandler runs the auth handlers for the endpoint's auth schemes, if any.
+// The auth handlers are tried in order until one of them authenticates the request,
+// moving on to the next one as long as they return an Unauthenticated error.
 // It reports whether to proceed with calling the handler.
 func (s *Server) runAuthHandler(h Handler, c IncomingContext) (info model.AuthInfo, proceed bool) {
 	requiresAuth := h.AccessType() == RequiresAuth
-	if s.authHandler == nil {
+	handlers, err := s.authHandlersFor(h)
+	if err != nil {
+		panic(fmt.Sprintf("internal error: API %s.%s: %v", h.ServiceName(), h.EndpointName(), err))
+	} else if len(handlers) == 0 {
 		if requiresAuth {
 			panic(fmt.Sprintf("internal error: API %s.%s requires auth but no auth handler set",
 				h.ServiceName(), h.EndpointName()))
@@ -108,20 +119,41 @@ func (s *Server) runAuthHandler(h Handler, c IncomingContext) (info model.AuthIn
 		return model.AuthInfo{}, true
 	}
 
-	var err error
-	info, err = s.authHandler.Authenticate(c)
-	if err != nil {
-		// If the auth handler returned Unauthenticated and the endpoint doesn't actually require auth,
-		// continue as if no auth information was provided.
-		if errs.Code(err) == errs.Unauthenticated && !requiresAuth {
-			return model.AuthInfo{}, true
-		} else {
+	for _, ah := range handlers {
+		info, err = ah.Authenticate(c)
+		if err == nil {
+			return info, true
+		} else if errs.Code(err) != errs.Unauthenticated {
 			errs.HTTPError(c.w, err)
 			return model.AuthInfo{}, false
 		}
 	}
 
-	return info, true
+	// If all the auth handlers returned Unauthenticated and the endpoint doesn't actually require auth,
+	// continue as if no auth information was provided.
+	if !requiresAuth {
+		return model.AuthInfo{}, true
+	}
+	errs.HTTPError(c.w, err)
+	return model.AuthInfo{}, false
+}
+
+// authHandlersFor returns the auth handlers to try for the endpoint h, in order.
+func (s *Server) authHandlersFor(h Handler) ([]AuthHandler, error) {
+	schemes := h.APIAuthSchemes()
+	if len(schemes) == 0 {
+		return s.authHandlers, nil
+	}
+
+	handlers := make([]AuthHandler, 0, len(schemes))
+	for _, scheme := range schemes {
+		idx := slices.IndexFunc(s.authHandlers, func(ah AuthHandler) bool { return ah.AuthScheme() == scheme })
+		if idx == -1 {
+			return nil, fmt.Errorf("no auth handler registered for auth scheme %q", scheme)
+		}
+		handlers = append(handlers, s.authHandlers[idx])
+	}
+	return handlers, nil
 }
 
 // rpcDesc returns the RPC description for this endpoint,
@@ -29,8 +29,9 @@ func newRawRequestBodyCapturer(req *http.Request) *rawRequestBodyCapturer {
 	buf.Reset()
 
 	var state captureState
-	// Don't capture WebSockets etc
-	if isUpgradeRequest(req) {
+	// Don't capture WebSockets etc, or compressed bodies
+	// since they aren't readable in traces.
+	if isUpgradeRequest(req) || isEncoded(req.Header) {
 		state = notCapturing
 	} else {
 		state = shouldCaptureContentType(req.Header.Get("Content-Type"), false)
@@ -176,6 +177,13 @@ func isUpgradeRequest(req *http.Request) bool {
 	return req.Header.Get("Upgrade") != ""
 }
 
+// isEncoded reports whether the headers specify
+// a Content-Encoding other than the identity encoding.
+func isEncoded(h http.Header) bool {
+	enc := h.Get("Content-Encoding")
+	return enc != "" && !strings.EqualFold(enc, "identity")
+}
+
 type rawResponseCapturer struct {
 	state captureState
 	w     http.ResponseWriter
@@ -307,6 +315,14 @@ func (c *rawResponseCapturer) markHeadersWritten(code int) {
 	for k, v := range src {
 		c.Header[k] = v
 	}
+
+	// Don't capture responses compressed by the handler,
+	// since they aren't readable in traces.
+	if isEncoded(src) {
+		c.bufMu.Lock()
+		c.state = notCapturing
+		c.bufMu.Unlock()
+	}
 }
 
 // FinishCapturing finishes the capturing, returning the captured bytes thus far.
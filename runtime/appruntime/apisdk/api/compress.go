@@ -0,0 +1,328 @@
+package api
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/beta/errs"
+)
+
+// Supported content encodings.
+const (
+	encodingGzip   = "gzip"
+	encodingBrotli = "br"
+	encodingZstd   = "zstd"
+)
+
+// defaultCompressionMinSize is the default minimum size of a response body
+// for it to be compressed. Smaller responses are not worth compressing.
+const defaultCompressionMinSize = 1 << 10 // 1 KiB
+
+// maxDecompressedRequestSize is the maximum size of a compressed request body
+// once decompressed. It guards against small payloads that decompress into
+// very large bodies.
+const maxDecompressedRequestSize = 32 << 20 // 32 MiB
+
+// errRequestTooLarge is reported when reading a decompressed request body
+// exceeding maxDecompressedRequestSize.
+var errRequestTooLarge = errors.New("decompressed request body too large")
+
+// compressionConfig is the parsed configuration for response compression.
+type compressionConfig struct {
+	encodings []string // in order of preference
+	minSize   int
+}
+
+// newCompressionConfig parses the compression configuration.
+// It reports nil if compression is disabled.
+func newCompressionConfig(cfg *config.Compression) (*compressionConfig, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	c := &compressionConfig{
+		encodings: cfg.Encodings,
+		minSize:   cfg.MinSize,
+	}
+	if len(c.encodings) == 0 {
+		c.encodings = []string{encodingBrotli, encodingZstd, encodingGzip}
+	}
+	for _, enc := range c.encodings {
+		switch enc {
+		case encodingGzip, encodingBrotli, encodingZstd:
+		default:
+			return nil, fmt.Errorf("unsupported compression encoding %q", enc)
+		}
+	}
+	if c.minSize <= 0 {
+		c.minSize = defaultCompressionMinSize
+	}
+	return c, nil
+}
+
+// negotiate selects the content encoding to use for a response based on
+// the request's Accept-Encoding header. It reports "" if the response
+// should not be compressed.
+func (c *compressionConfig) negotiate(acceptEncoding string) string {
+	if acceptEncoding == "" {
+		return ""
+	}
+
+	// Parse the accepted encodings and their quality values.
+	accepted := make(map[string]float64)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		q := 1.0
+		if key, val, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.TrimSpace(key) == "q" {
+			if f, err := strconv.ParseFloat(strings.TrimSpace(val), 64); err == nil {
+				q = f
+			}
+		}
+		accepted[name] = q
+	}
+
+	// Pick the encoding with the highest quality value,
+	// breaking ties by our order of preference.
+	best, bestQ := "", 0.0
+	for _, enc := range c.encodings {
+		q, ok := accepted[enc]
+		if !ok {
+			q, ok = accepted["*"]
+		}
+		if ok && q > bestQ {
+			best, bestQ = enc, q
+		}
+	}
+	return best
+}
+
+// compressWriter is an http.ResponseWriter that compresses the response body
+// using the given encoding, if it is at least minSize bytes.
+//
+// Smaller responses are buffered and written uncompressed when Close is called.
+type compressWriter struct {
+	w        http.ResponseWriter
+	encoding string
+	minSize  int
+
+	code        int
+	buf         []byte
+	enc         io.WriteCloser // non-nil once compression has started
+	passthrough bool           // write the response as-is
+}
+
+func newCompressWriter(w http.ResponseWriter, encoding string, minSize int) *compressWriter {
+	// The response depends on the Accept-Encoding header, whether or not we end up compressing it.
+	w.Header().Add("Vary", "Accept-Encoding")
+	return &compressWriter{w: w, encoding: encoding, minSize: minSize, code: http.StatusOK}
+}
+
+// Header implements http.ResponseWriter.
+func (cw *compressWriter) Header() http.Header {
+	return cw.w.Header()
+}
+
+// WriteHeader implements http.ResponseWriter.
+// The header is written once we know whether the response is compressed.
+func (cw *compressWriter) WriteHeader(code int) {
+	cw.code = code
+	// Responses already encoded by the handler are written as-is.
+	if cw.w.Header().Get("Content-Encoding") != "" {
+		cw.startPassthrough()
+	}
+}
+
+// Write implements http.ResponseWriter.
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	switch {
+	case cw.enc != nil:
+		return cw.enc.Write(p)
+	case cw.passthrough:
+		return cw.w.Write(p)
+	}
+
+	cw.buf = append(cw.buf, p...)
+	if len(cw.buf) >= cw.minSize {
+		if err := cw.startCompressing(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (cw *compressWriter) startCompressing() error {
+	h := cw.w.Header()
+	h.Set("Content-Encoding", cw.encoding)
+	h.Del("Content-Length")
+	cw.w.WriteHeader(cw.code)
+
+	cw.enc = getEncoder(cw.encoding, cw.w)
+	buf := cw.buf
+	cw.buf = nil
+	_, err := cw.enc.Write(buf)
+	return err
+}
+
+func (cw *compressWriter) startPassthrough() {
+	if cw.passthrough || cw.enc != nil {
+		return
+	}
+	cw.passthrough = true
+	cw.w.WriteHeader(cw.code)
+}
+
+// Close finishes writing the response.
+func (cw *compressWriter) Close() error {
+	if cw.enc != nil {
+		err := cw.enc.Close()
+		putEncoder(cw.encoding, cw.enc)
+		cw.enc = nil
+		return err
+	}
+
+	cw.startPassthrough()
+	if len(cw.buf) > 0 {
+		buf := cw.buf
+		cw.buf = nil
+		_, err := cw.w.Write(buf)
+		return err
+	}
+	return nil
+}
+
+var (
+	gzipWriterPool   sync.Pool
+	brotliWriterPool sync.Pool
+	zstdWriterPool   sync.Pool
+)
+
+// getEncoder returns an encoder for the given encoding writing to w.
+func getEncoder(encoding string, w io.Writer) io.WriteCloser {
+	switch encoding {
+	case encodingGzip:
+		if enc, ok := gzipWriterPool.Get().(*gzip.Writer); ok {
+			enc.Reset(w)
+			return enc
+		}
+		return gzip.NewWriter(w)
+	case encodingBrotli:
+		if enc, ok := brotliWriterPool.Get().(*brotli.Writer); ok {
+			enc.Reset(w)
+			return enc
+		}
+		return brotli.NewWriterLevel(w, brotli.DefaultCompression)
+	case encodingZstd:
+		if enc, ok := zstdWriterPool.Get().(*zstd.Encoder); ok {
+			enc.Reset(w)
+			return enc
+		}
+		// The error can only be non-nil for invalid options.
+		enc, _ := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		return enc
+	default:
+		panic(fmt.Sprintf("unsupported encoding %q", encoding))
+	}
+}
+
+// putEncoder returns the encoder to the pool after it has been closed.
+func putEncoder(encoding string, enc io.WriteCloser) {
+	switch encoding {
+	case encodingGzip:
+		gzipWriterPool.Put(enc)
+	case encodingBrotli:
+		brotliWriterPool.Put(enc)
+	case encodingZstd:
+		zstdWriterPool.Put(enc)
+	}
+}
+
+// decompressRequest replaces the body of req with a decompressed version
+// if it is compressed with a supported content encoding.
+//
+// Reading more than maxDecompressedRequestSize bytes from the decompressed
+// body fails with errRequestTooLarge.
+func decompressRequest(req *http.Request) error {
+	encoding := strings.ToLower(strings.TrimSpace(req.Header.Get("Content-Encoding")))
+	if encoding == "" || encoding == "identity" || req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	var body *decompressedBody
+	switch encoding {
+	case encodingGzip, "x-gzip":
+		r, err := gzip.NewReader(req.Body)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Cause(err).Msg("invalid gzip request body").Err()
+		}
+		body = &decompressedBody{Reader: r, closeDecoder: r.Close, underlying: req.Body}
+	case encodingBrotli:
+		body = &decompressedBody{Reader: brotli.NewReader(req.Body), underlying: req.Body}
+	case encodingZstd:
+		r, err := zstd.NewReader(req.Body,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(maxDecompressedRequestSize))
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Cause(err).Msg("invalid zstd request body").Err()
+		}
+		body = &decompressedBody{Reader: r, closeDecoder: func() error { r.Close(); return nil }, underlying: req.Body}
+	default:
+		return errs.B().Code(errs.InvalidArgument).Msgf("unsupported Content-Encoding %q", encoding).Err()
+	}
+
+	body.Reader = &limitedReader{r: body.Reader, n: maxDecompressedRequestSize}
+	req.Body = body
+	req.Header.Del("Content-Encoding")
+	req.Header.Del("Content-Length")
+	req.ContentLength = -1
+	return nil
+}
+
+// decompressedBody is a decompressed request body.
+type decompressedBody struct {
+	io.Reader
+	closeDecoder func() error // may be nil
+	underlying   io.ReadCloser
+}
+
+// Close implements io.Closer.
+func (b *decompressedBody) Close() error {
+	if b.closeDecoder != nil {
+		_ = b.closeDecoder()
+	}
+	return b.underlying.Close()
+}
+
+// limitedReader reads from r, failing with errRequestTooLarge
+// if more than n bytes are available.
+type limitedReader struct {
+	r io.Reader
+	n int64 // bytes remaining
+}
+
+// Read implements io.Reader.
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n < 0 {
+		return 0, errRequestTooLarge
+	}
+	// Read one byte past the limit to detect bodies exceeding it.
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n - 1, errRequestTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+func TestCompressionNegotiate(t *testing.T) {
+	cfg, err := newCompressionConfig(&config.Compression{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{"", ""},
+		{"identity", ""},
+		{"gzip", "gzip"},
+		{"gzip, deflate, br", "br"},
+		{"gzip, zstd", "zstd"},
+		{"br;q=0.5, gzip", "gzip"},
+		{"br;q=0, gzip;q=0", ""},
+		{"*", "br"},
+		{"*;q=0.1, gzip;q=0.5", "gzip"},
+		{"GZIP", "gzip"},
+	}
+	for _, test := range tests {
+		if got := cfg.negotiate(test.acceptEncoding); got != test.want {
+			t.Errorf("negotiate(%q) = %q, want %q", test.acceptEncoding, got, test.want)
+		}
+	}
+
+	if _, err := newCompressionConfig(&config.Compression{Encodings: []string{"deflate"}}); err == nil {
+		t.Errorf("expected error for unsupported encoding")
+	}
+}
+
+func TestCompressWriter(t *testing.T) {
+	large := strings.Repeat(`{"message":"hello"}`, 100)
+	small := `{"message":"hello"}`
+
+	decoders := map[string]func(io.Reader) io.Reader{
+		"gzip": func(r io.Reader) io.Reader {
+			gr, err := gzip.NewReader(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return gr
+		},
+		"br": func(r io.Reader) io.Reader { return brotli.NewReader(r) },
+		"zstd": func(r io.Reader) io.Reader {
+			zr, err := zstd.NewReader(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return zr
+		},
+	}
+
+	for encoding, decode := range decoders {
+		// Large responses are compressed.
+		w := httptest.NewRecorder()
+		cw := newCompressWriter(w, encoding, 1024)
+		cw.WriteHeader(http.StatusCreated)
+		_, _ = cw.Write([]byte(large))
+		if err := cw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if got := w.Header().Get("Content-Encoding"); got != encoding {
+			t.Fatalf("%s: got Content-Encoding %q", encoding, got)
+		} else if w.Code != http.StatusCreated {
+			t.Fatalf("%s: got code %d, want %d", encoding, w.Code, http.StatusCreated)
+		}
+		data, err := io.ReadAll(decode(w.Body))
+		if err != nil {
+			t.Fatalf("%s: decode: %v", encoding, err)
+		} else if string(data) != large {
+			t.Fatalf("%s: got body %q, want %q", encoding, data, large)
+		}
+
+		// Small responses are not.
+		w = httptest.NewRecorder()
+		cw = newCompressWriter(w, encoding, 1024)
+		_, _ = cw.Write([]byte(small))
+		if err := cw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if got := w.Header().Get("Content-Encoding"); got != "" {
+			t.Fatalf("%s: got Content-Encoding %q for small response", encoding, got)
+		} else if w.Body.String() != small {
+			t.Fatalf("%s: got body %q, want %q", encoding, w.Body.String(), small)
+		} else if got := w.Header().Get("Vary"); got != "Accept-Encoding" {
+			t.Fatalf("%s: got Vary %q, want Accept-Encoding", encoding, got)
+		}
+	}
+}
+
+func TestDecompressRequest(t *testing.T) {
+	const body = `{"message":"hello"}`
+
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	_, _ = gw.Write([]byte(body))
+	_ = gw.Close()
+
+	var br bytes.Buffer
+	bw := brotli.NewWriter(&br)
+	_, _ = bw.Write([]byte(body))
+	_ = bw.Close()
+
+	var zs bytes.Buffer
+	zw, _ := zstd.NewWriter(&zs)
+	_, _ = zw.Write([]byte(body))
+	_ = zw.Close()
+
+	for encoding, data := range map[string][]byte{
+		"":     []byte(body),
+		"gzip": gz.Bytes(),
+		"br":   br.Bytes(),
+		"zstd": zs.Bytes(),
+	} {
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(data))
+		if encoding != "" {
+			req.Header.Set("Content-Encoding", encoding)
+		}
+		if err := decompressRequest(req); err != nil {
+			t.Fatalf("%s: %v", encoding, err)
+		}
+		got, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("%s: read body: %v", encoding, err)
+		} else if string(got) != body {
+			t.Fatalf("%s: got body %q, want %q", encoding, got, body)
+		} else if req.Header.Get("Content-Encoding") != "" {
+			t.Fatalf("%s: Content-Encoding header not removed", encoding)
+		}
+		_ = req.Body.Close()
+	}
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	req.Header.Set("Content-Encoding", "deflate")
+	if err := decompressRequest(req); err == nil {
+		t.Fatalf("expected error for unsupported encoding")
+	}
+}
+
+func TestDecompressRequestLimit(t *testing.T) {
+	// A small compressed payload that decompresses to more than the limit.
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	_, _ = gw.Write(make([]byte, maxDecompressedRequestSize+1))
+	_ = gw.Close()
+
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(gz.Bytes()))
+	req.Header.Set("Content-Encoding", "gzip")
+	if err := decompressRequest(req); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadAll(req.Body); !errors.Is(err, errRequestTooLarge) {
+		t.Fatalf("got err %v, want %v", err, errRequestTooLarge)
+	}
+
+	// Bodies of exactly the limit are allowed.
+	gz.Reset()
+	gw.Reset(&gz)
+	_, _ = gw.Write(make([]byte, maxDecompressedRequestSize))
+	_ = gw.Close()
+
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(gz.Bytes()))
+	req.Header.Set("Content-Encoding", "gzip")
+	if err := decompressRequest(req); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := io.ReadAll(req.Body); err != nil {
+		t.Fatal(err)
+	} else if len(got) != maxDecompressedRequestSize {
+		t.Fatalf("got %d bytes, want %d", len(got), maxDecompressedRequestSize)
+	}
+}
@@ -12,6 +12,7 @@ import (
 func (s *Server) registerEncoreRoutes() {
 	s.encore.HandlerFunc(wildcardMethod, "/healthz", s.handleHealthz)
 	s.encore.Handle("POST", "/pubsub/push/:subscription_id", s.handlePubsubPush)
+	s.encore.Handle("POST", "/call/:service/:endpoint", s.handleRemoteCall)
 }
 
 // handleHealthz returns the current health and deployment details of the running Encore application
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"reflect"
 	"runtime/debug"
@@ -62,6 +63,18 @@ type Desc[Req, Resp any] struct {
 	// Access describes the access type for this API.
 	Access Access
 
+	// Version is the API version of the endpoint, or 0 if it is unversioned.
+	Version int
+
+	// Deprecation describes the deprecation of the endpoint,
+	// or nil if it is not deprecated.
+	Deprecation *Deprecation
+
+	// AuthSchemes are the auth schemes the endpoint accepts, in the order
+	// their auth handlers are tried. If empty all auth handlers are tried,
+	// which is only the case when the app has at most one.
+	AuthSchemes []string
+
 	// If raw is true, RawHandler is set and AppHandler and EncodeResp are nil.
 	Raw bool
 
@@ -76,6 +89,10 @@ type Desc[Req, Resp any] struct {
 	EncodeResp func(http.ResponseWriter, jsoniter.API, Resp) error
 	CloneResp  func(Resp) (Resp, error)
 
+	// RespHeaders returns the HTTP headers of the response payload.
+	// It is nil if the endpoint is raw or the response has no header fields.
+	RespHeaders func(Resp) http.Header
+
 	// GlobalMiddlewareIDs is the ordered list of global middleware IDs
 	// to invoke before calling the API handler.
 	GlobalMiddlewareIDs []string
@@ -88,18 +105,26 @@ type Desc[Req, Resp any] struct {
 	cachedRPCDesc *model.RPCDesc
 }
 
-func (d *Desc[Req, Resp]) AccessType() Access     { return d.Access }
-func (d *Desc[Req, Resp]) ServiceName() string    { return d.Service }
-func (d *Desc[Req, Resp]) EndpointName() string   { return d.Endpoint }
-func (d *Desc[Req, Resp]) HTTPMethods() []string  { return d.Methods }
-func (d *Desc[Req, Resp]) SemanticPath() string   { return d.Path }
-func (d *Desc[Req, Resp]) HTTPRouterPath() string { return d.RawPath }
+func (d *Desc[Req, Resp]) AccessType() Access           { return d.Access }
+func (d *Desc[Req, Resp]) ServiceName() string          { return d.Service }
+func (d *Desc[Req, Resp]) EndpointName() string         { return d.Endpoint }
+func (d *Desc[Req, Resp]) HTTPMethods() []string        { return d.Methods }
+func (d *Desc[Req, Resp]) SemanticPath() string         { return d.Path }
+func (d *Desc[Req, Resp]) HTTPRouterPath() string       { return d.RawPath }
+func (d *Desc[Req, Resp]) APIVersion() int              { return d.Version }
+func (d *Desc[Req, Resp]) APIDeprecation() *Deprecation { return d.Deprecation }
+func (d *Desc[Req, Resp]) APIAuthSchemes() []string     { return d.AuthSchemes }
 
 func (d *Desc[Req, Resp]) Handle(c IncomingContext) {
 	if d.Raw {
 		c.capturer = newRawRequestBodyCapturer(c.req)
 		c.req.Body = c.capturer
 		defer c.capturer.Dispose()
+	} else if err := decompressRequest(c.req); err != nil {
+		// Raw endpoints receive the request body as-is,
+		// but we need to decode the body of other endpoints.
+		errs.HTTPError(c.w, err)
+		return
 	}
 
 	reqData, beginErr := d.begin(c)
@@ -108,7 +133,11 @@ func (d *Desc[Req, Resp]) Handle(c IncomingContext) {
 		return
 	}
 
-	resp, respData := d.handleIncoming(c, reqData)
+	resp, respData, respHeader := d.handleIncoming(c, reqData)
+	if !d.Raw {
+		// Raw endpoints have already written their headers.
+		writeHeader(c.w.Header(), respHeader)
+	}
 	if resp.Err != nil {
 		c.server.finishRequest(resp)
 
@@ -121,9 +150,23 @@ func (d *Desc[Req, Resp]) Handle(c IncomingContext) {
 	}
 
 	if !d.Raw {
-		c.w.Header().Set("Content-Type", "application/json")
-		c.w.Header().Set("X-Content-Type-Options", "nosniff")
-		resp.Err = d.EncodeResp(c.w, c.server.json, respData)
+		w := c.w
+		var cw *compressWriter
+		if cfg := c.server.compression; cfg != nil && c.req.Method != http.MethodHead {
+			if enc := cfg.negotiate(c.req.Header.Get("Accept-Encoding")); enc != "" {
+				cw = newCompressWriter(c.w, enc, cfg.minSize)
+				w = cw
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		resp.Err = d.EncodeResp(w, c.server.json, respData)
+		if cw != nil {
+			if err := cw.Close(); err != nil && resp.Err == nil {
+				resp.Err = err
+			}
+		}
 	}
 	c.server.finishRequest(resp)
 }
@@ -164,6 +207,7 @@ func (d *Desc[Req, Resp]) begin(c IncomingContext) (reqData Req, beginErr error)
 			NonRawPayload:      nonRawPayload,
 			UserID:             c.auth.UID,
 			AuthData:           c.auth.UserData,
+			AuthScheme:         c.auth.Scheme,
 			RequestHeaders:     c.req.Header,
 			FromEncorePlatform: platformauth.IsEncorePlatformRequest(c.req.Context()),
 		},
@@ -183,7 +227,10 @@ func (d *Desc[Req, Resp]) begin(c IncomingContext) (reqData Req, beginErr error)
 		}
 	}()
 
-	if decodeErr != nil {
+	if errors.Is(decodeErr, errRequestTooLarge) {
+		beginErr = errs.WrapCode(decodeErr, errs.ResourceExhausted, "request body too large")
+		return
+	} else if decodeErr != nil {
 		beginErr = errs.WrapCode(decodeErr, errs.InvalidArgument, "decode request")
 		return
 	}
@@ -192,9 +239,9 @@ func (d *Desc[Req, Resp]) begin(c IncomingContext) (reqData Req, beginErr error)
 }
 
 // handleIncoming executes the given handler, running middleware in the process.
-func (d *Desc[Req, Resp]) handleIncoming(c IncomingContext, reqData Req) (resp *model.Response, respData Resp) {
+func (d *Desc[Req, Resp]) handleIncoming(c IncomingContext, reqData Req) (resp *model.Response, respData Resp, respHeader http.Header) {
 	if err := d.validate(reqData); err != nil {
-		return newErrResp(err, 0), respData
+		return newErrResp(err, 0), respData, nil
 	}
 
 	var respCapturer *rawResponseCapturer
@@ -208,14 +255,14 @@ func (d *Desc[Req, Resp]) handleIncoming(c IncomingContext, reqData Req) (resp *
 		}
 	}
 
-	respData, httpStatus, err := d.executeEndpoint(c.execContext, invokeHandler)
+	respData, httpStatus, respHeader, err := d.executeEndpoint(c.execContext, invokeHandler)
 
 	resp = newResp(respData, httpStatus, err, d.Raw, c.capturer, respCapturer, c.server.json)
-	return resp, respData
+	return resp, respData, respHeader
 }
 
 // executeEndpoint executes the given handler, running middleware in the process.
-func (d *Desc[Req, Resp]) executeEndpoint(c execContext, invokeHandler func(middleware.Request) middleware.Response) (resp Resp, httpStatus int, respErr error) {
+func (d *Desc[Req, Resp]) executeEndpoint(c execContext, invokeHandler func(middleware.Request) middleware.Response) (resp Resp, httpStatus int, respHeader http.Header, respErr error) {
 	var counter int
 	var nextFn middleware.Next
 
@@ -236,6 +283,9 @@ func (d *Desc[Req, Resp]) executeEndpoint(c execContext, invokeHandler func(midd
 			if resp.HTTPStatus == 0 {
 				resp.HTTPStatus = errs.HTTPStatus(resp.Err)
 			}
+			if resp.Header == nil {
+				resp.Header = make(http.Header)
+			}
 		}()
 
 		idx := counter
@@ -287,14 +337,14 @@ func (d *Desc[Req, Resp]) executeEndpoint(c execContext, invokeHandler func(midd
 	mwResp := nextFn(mwReq)
 
 	if mwResp.Err != nil {
-		return resp, mwResp.HTTPStatus, mwResp.Err
+		return resp, mwResp.HTTPStatus, mwResp.Header, mwResp.Err
 	} else {
 		if resp, ok := mwResp.Payload.(Resp); ok || isVoid[Resp]() {
-			return resp, mwResp.HTTPStatus, mwResp.Err
+			return resp, mwResp.HTTPStatus, mwResp.Header, mwResp.Err
 		}
 	}
 
-	return resp, 500, errs.B().Code(errs.Internal).Msgf(
+	return resp, 500, mwResp.Header, errs.B().Code(errs.Internal).Msgf(
 		"invalid middleware: cannot return payload of type %T for endpoint %s.%s (expected type %T)",
 		mwResp.Payload, d.Service, d.Endpoint, resp,
 	).Err()
@@ -315,6 +365,9 @@ func (d *Desc[Req, Resp]) invokeHandlerNonRaw(mwReq middleware.Request, reqData
 		if !isVoid[Resp]() {
 			mwResp.Payload = handlerResp
 		}
+		if d.RespHeaders != nil {
+			mwResp.Header = d.RespHeaders(handlerResp)
+		}
 		mwResp.HTTPStatus = 200
 	}
 	return mwResp
@@ -344,6 +397,7 @@ func (d *Desc[Req, Resp]) invokeHandlerRaw(mwReq middleware.Request, c IncomingC
 	}
 
 	mwResp.HTTPStatus = capturer.Code
+	mwResp.Header = capturer.Header.Clone()
 	return mwResp
 }
 
@@ -370,6 +424,11 @@ func (d *Desc[Req, Resp]) Call(c CallContext, req Req) (respData Resp, respErr e
 		return
 	}
 
+	// Services hosted by another instance are called over HTTP.
+	if !c.server.isHosted(d.Service) {
+		return d.callRemote(c, req)
+	}
+
 	req, err := d.CloneReq(req)
 	if err != nil {
 		c.server.rootLogger.Err(err).Msg("unable to clone request")
@@ -436,8 +495,12 @@ func (d *Desc[Req, Resp]) Call(c CallContext, req Req) (respData Resp, respErr e
 			return
 		}
 
-		ec := c.server.newExecContext(c.ctx, params, reqObj.TraceID, model.AuthInfo{reqObj.RPCData.UserID, reqObj.RPCData.AuthData})
-		r, httpStatus, rpcErr := d.executeEndpoint(ec, func(mwReq middleware.Request) middleware.Response {
+		ec := c.server.newExecContext(c.ctx, params, reqObj.TraceID, model.AuthInfo{
+			UID:      reqObj.RPCData.UserID,
+			UserData: reqObj.RPCData.AuthData,
+			Scheme:   reqObj.RPCData.AuthScheme,
+		})
+		r, httpStatus, _, rpcErr := d.executeEndpoint(ec, func(mwReq middleware.Request) middleware.Response {
 			return d.invokeHandlerNonRaw(mwReq, req)
 		})
 
@@ -469,6 +532,16 @@ func (d *Desc[Req, Resp]) Call(c CallContext, req Req) (respData Resp, respErr e
 	return
 }
 
+// writeHeader sets the response headers src on dst, replacing any existing values.
+func writeHeader(dst, src http.Header) {
+	for k, vs := range src {
+		dst.Del(k)
+		for _, v := range vs {
+			dst.Add(k, v)
+		}
+	}
+}
+
 // validate validates the request, and returns a validation error on failure.
 // If the user payload does not implement Validator, it returns nil.
 func (d *Desc[Req, Resp]) validate(req Req) error {
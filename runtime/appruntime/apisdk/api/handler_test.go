@@ -29,6 +29,7 @@ import (
 	"encore.dev/appruntime/shared/traceprovider/mock_trace"
 	"encore.dev/beta/errs"
 	usermetrics "encore.dev/metrics"
+	"encore.dev/middleware"
 	"encore.dev/pubsub"
 )
 
@@ -152,6 +153,80 @@ func TestDesc_EndToEnd(t *testing.T) {
 	}
 }
 
+func TestDesc_MiddlewareHeaders(t *testing.T) {
+	server, _, _ := testServer(t, clock.New(), false)
+
+	tests := []struct {
+		name        string
+		handlerErr  error
+		status      int
+		respHeaders http.Header
+	}{
+		{
+			name:   "ok",
+			status: 200,
+			respHeaders: http.Header{
+				"X-Message":     []string{"overridden"},
+				"X-Original":    []string{"foo"},
+				"X-Request-Foo": []string{"bar"},
+				"Content-Type":  []string{"application/json"},
+			},
+		},
+		{
+			name:       "error",
+			handlerErr: errs.B().Code(errs.ResourceExhausted).Msg("slow down").Err(),
+			status:     429,
+			respHeaders: http.Header{
+				"X-Message":     []string{"overridden"},
+				"X-Original":    nil,
+				"X-Request-Foo": []string{"bar"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			desc := newMockAPIDesc(api.Public)
+			handler := desc.AppHandler
+			desc.AppHandler = func(ctx context.Context, req *mockReq) (*mockResp, error) {
+				if test.handlerErr != nil {
+					return nil, test.handlerErr
+				}
+				return handler(ctx, req)
+			}
+			desc.RespHeaders = func(resp *mockResp) http.Header {
+				return http.Header{"X-Message": []string{resp.Message}}
+			}
+			desc.ServiceMiddleware = []*api.Middleware{{
+				PkgName: "pkg",
+				Name:    "headers",
+				Invoke: func(req middleware.Request, next middleware.Next) middleware.Response {
+					resp := next(req)
+					if orig := resp.Header.Get("X-Message"); orig != "" {
+						resp.Header.Set("X-Original", orig)
+					}
+					resp.Header.Set("X-Message", "overridden")
+					resp.Header.Set("X-Request-Foo", req.Data().Headers.Get("X-Foo"))
+					return resp
+				},
+			}}
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/", strings.NewReader(`{"Body": "foo"}`))
+			req.Header.Set("X-Foo", "bar")
+			desc.Handle(server.NewIncomingContext(w, req, api.UnnamedParams{"value"}, model.TraceID{}, model.AuthInfo{}))
+			if w.Code != test.status {
+				t.Fatalf("got code %d, want %d", w.Code, test.status)
+			}
+			for key, val := range test.respHeaders {
+				if diff := cmp.Diff(val, w.Header()[key]); diff != "" {
+					t.Errorf("header %s: unexpected response header value (-want +got):\n%s", key, diff)
+				}
+			}
+		})
+	}
+}
+
 func findMetric(collected []usermetrics.CollectedMetric, name string, labels []usermetrics.KeyValue) *usermetrics.CollectedMetric {
 	for _, metric := range collected {
 		if metric.Info.Name() == name &&
@@ -7,7 +7,7 @@ func RegisterEndpoint(handler Handler) {
 }
 
 func RegisterAuthHandler(handler AuthHandler) {
-	Singleton.setAuthHandler(handler)
+	Singleton.addAuthHandler(handler)
 }
 
 var RegisteredAuthDataType reflect.Type
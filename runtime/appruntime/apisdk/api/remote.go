@@ -0,0 +1,202 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+
+	"encore.dev/appruntime/exported/model"
+	"encore.dev/appruntime/shared/platform"
+	"encore.dev/beta/errs"
+	"encore.dev/internal/platformauth"
+)
+
+// remoteCall is the request body of a service-to-service call
+// to an endpoint hosted by another instance of the application.
+type remoteCall struct {
+	// Req is the JSON-encoded request.
+	Req json.RawMessage `json:"req"`
+
+	// UID, AuthData and AuthScheme are the authentication information
+	// of the calling request, if any.
+	UID        model.UID       `json:"uid,omitempty"`
+	AuthData   json.RawMessage `json:"auth_data,omitempty"`
+	AuthScheme string          `json:"auth_scheme,omitempty"`
+}
+
+// remoteCallHandler is implemented by handlers that can be called
+// from other instances of the application.
+type remoteCallHandler interface {
+	Handler
+	handleRemoteCall(s *Server, w http.ResponseWriter, req *http.Request, call *remoteCall)
+}
+
+// handleRemoteCall handles a service-to-service call made by
+// another instance of the application.
+func (s *Server) handleRemoteCall(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	// Only other instances of the application, which sign their requests
+	// with the application's auth key, are allowed to make calls.
+	if !platformauth.IsEncorePlatformRequest(req.Context()) {
+		errs.HTTPError(w, errs.B().Code(errs.Unauthenticated).Msg("unauthenticated service-to-service call").Err())
+		return
+	}
+
+	svc, endpoint := ps.ByName("service"), ps.ByName("endpoint")
+	h, ok := s.remoteCallHandlers[svc+"."+endpoint]
+	if !ok {
+		errs.HTTPError(w, errs.B().Code(errs.NotFound).Msgf("endpoint %s.%s not found", svc, endpoint).Err())
+		return
+	}
+
+	// The request signature covers the body hash, not the body itself,
+	// so make sure the body is the one that was signed.
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		errs.HTTPError(w, errs.B().Code(errs.InvalidArgument).Msg("could not read service-to-service call").Err())
+		return
+	} else if !platform.VerifyBodyHash(req, body) {
+		errs.HTTPError(w, errs.B().Code(errs.Unauthenticated).Msg("invalid service-to-service call signature").Err())
+		return
+	}
+
+	var call remoteCall
+	if err := json.Unmarshal(body, &call); err != nil {
+		errs.HTTPError(w, errs.B().Code(errs.InvalidArgument).Msg("invalid service-to-service call").Err())
+		return
+	}
+	h.handleRemoteCall(s, w, req, &call)
+}
+
+func (d *Desc[Req, Resp]) handleRemoteCall(s *Server, w http.ResponseWriter, httpReq *http.Request, call *remoteCall) {
+	var req Req
+	if err := s.json.Unmarshal(call.Req, &req); err != nil {
+		errs.HTTPError(w, errs.B().Code(errs.InvalidArgument).Msg("invalid request").Err())
+		return
+	}
+
+	ctx := httpReq.Context()
+	if call.UID != "" {
+		auth := &model.AuthInfo{UID: call.UID, Scheme: call.AuthScheme}
+		if RegisteredAuthDataType != nil && len(call.AuthData) > 0 {
+			data := reflect.New(RegisteredAuthDataType)
+			if err := s.json.Unmarshal(call.AuthData, data.Interface()); err != nil {
+				errs.HTTPError(w, errs.B().Code(errs.InvalidArgument).Msg("invalid auth data").Err())
+				return
+			}
+			auth.UserData = data.Elem().Interface()
+		}
+		ctx = WithCallOptions(ctx, &CallOptions{Auth: auth})
+	}
+
+	resp, err := d.Call(s.NewCallContext(ctx), req)
+	if err != nil {
+		errs.HTTPError(w, err)
+		return
+	}
+
+	data, err := s.json.Marshal(resp)
+	if err != nil {
+		errs.HTTPError(w, errs.B().Cause(err).Code(errs.Internal).Msg("could not marshal response").Err())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// callRemote calls an endpoint of a service hosted by another
+// instance of the application.
+func (d *Desc[Req, Resp]) callRemote(c CallContext, req Req) (respData Resp, respErr error) {
+	call, err := c.server.beginCall(d.DefLoc)
+	if err != nil {
+		c.server.rootLogger.Err(err).Msg("unable to begin call")
+		return respData, errs.Convert(err)
+	}
+	defer func() { c.server.finishCall(call, respErr) }()
+
+	respErr = c.server.doRemoteCall(c.ctx, d.Service, d.Endpoint, req, &respData)
+	return respData, respErr
+}
+
+// doRemoteCall makes a call to the given endpoint hosted by another
+// instance of the application, decoding the response into resp.
+func (s *Server) doRemoteCall(ctx context.Context, svc, endpoint string, req, resp any) error {
+	disc := s.runtime.ServiceDiscovery[svc]
+	if disc == nil || disc.URL == "" {
+		return errs.B().Code(errs.Unavailable).Msgf("service %s is not hosted by this instance and has no service discovery configuration", svc).Err()
+	} else if s.pc == nil {
+		return errs.B().Code(errs.Internal).Msg("cannot make service-to-service calls without auth keys").Err()
+	}
+
+	call := &remoteCall{}
+	var err error
+	if call.Req, err = s.json.Marshal(req); err != nil {
+		return errs.B().Cause(err).Code(errs.Internal).Msg("could not marshal request").Err()
+	}
+
+	// Propagate the authentication information of the current request.
+	if auth := GetCallOptions(ctx).Auth; auth != nil {
+		call.UID = auth.UID
+		call.AuthScheme = auth.Scheme
+		if auth.UserData != nil {
+			call.AuthData, err = s.json.Marshal(auth.UserData)
+		}
+	} else if curr := s.rt.Current(); curr.Req != nil && curr.Req.RPCData != nil && curr.Req.RPCData.UserID != "" {
+		call.UID = curr.Req.RPCData.UserID
+		call.AuthScheme = curr.Req.RPCData.AuthScheme
+		if curr.Req.RPCData.AuthData != nil {
+			call.AuthData, err = s.json.Marshal(curr.Req.RPCData.AuthData)
+		}
+	}
+	if err != nil {
+		return errs.B().Cause(err).Code(errs.Internal).Msg("could not marshal auth data").Err()
+	}
+
+	body, err := json.Marshal(call)
+	if err != nil {
+		return errs.B().Cause(err).Code(errs.Internal).Msg("could not marshal request").Err()
+	}
+
+	u := strings.TrimSuffix(disc.URL, "/") + "/__encore/call/" + url.PathEscape(svc) + "/" + url.PathEscape(endpoint)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", u, bytes.NewReader(body))
+	if err != nil {
+		return errs.B().Cause(err).Code(errs.Internal).Msg("could not create request").Err()
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if err := s.pc.SignRequest(httpReq, body); err != nil {
+		return errs.B().Cause(err).Code(errs.Internal).Msg("could not sign request").Err()
+	}
+
+	httpResp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return errs.B().Cause(err).Code(errs.Unavailable).Msgf("could not call service %s", svc).Err()
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	data, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return errs.B().Cause(err).Code(errs.Unavailable).Msg("could not read response").Err()
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		var e struct {
+			Code    errs.ErrCode `json:"code"`
+			Message string       `json:"message"`
+		}
+		if err := json.Unmarshal(data, &e); err != nil || e.Code == errs.OK {
+			return errs.B().Code(errs.HTTPStatusToCode(httpResp.StatusCode)).Msgf("service %s returned %s", svc, httpResp.Status).Err()
+		}
+		return errs.B().Code(e.Code).Msg(e.Message).Err()
+	}
+
+	if err := s.json.Unmarshal(data, resp); err != nil {
+		return errs.B().Cause(err).Code(errs.Internal).Msg("could not unmarshal response").Err()
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/rs/zerolog"
+
+	encore "encore.dev"
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/shared/platform"
+	"encore.dev/appruntime/shared/reqtrack"
+	"encore.dev/appruntime/shared/testsupport"
+	"encore.dev/appruntime/shared/traceprovider"
+	"encore.dev/beta/errs"
+	"encore.dev/metrics"
+	"encore.dev/pubsub"
+)
+
+type remoteReq struct {
+	Body string
+}
+
+type remoteResp struct {
+	Message string
+}
+
+func TestRemoteCall(t *testing.T) {
+	keys := []config.EncoreAuthKey{{KeyID: 1, Data: []byte("secret")}}
+	desc := newRemoteTestDesc()
+
+	callee := newRemoteTestServer(t, &config.Runtime{
+		AuthKeys:       keys,
+		HostedServices: []string{"billing"},
+	})
+	callee.registerEndpoint(desc)
+	srv := httptest.NewServer(callee.httpsrv.Handler)
+	defer srv.Close()
+
+	caller := newRemoteTestServer(t, &config.Runtime{
+		AuthKeys:         keys,
+		HostedServices:   []string{"orders"},
+		ServiceDiscovery: map[string]*config.ServiceDiscovery{"billing": {URL: srv.URL}},
+	})
+	caller.registerEndpoint(desc)
+	if n := len(caller.RegisteredHandlers()); n != 0 {
+		t.Fatalf("got %d registered handlers on caller, want 0", n)
+	}
+
+	ctx := context.Background()
+	resp, err := desc.Call(caller.NewCallContext(ctx), &remoteReq{Body: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	} else if resp.Message != "hello" {
+		t.Fatalf("got message %q, want %q", resp.Message, "hello")
+	}
+
+	// Errors are propagated with their code and message.
+	_, err = desc.Call(caller.NewCallContext(ctx), &remoteReq{Body: "missing"})
+	if got := errs.Code(err); got != errs.NotFound {
+		t.Fatalf("got code %v, want %v", got, errs.NotFound)
+	} else if got := errs.Convert(err).(*errs.Error).Message; got != "no such invoice" {
+		t.Fatalf("got message %q, want %q", got, "no such invoice")
+	}
+
+	// Calls signed with an unknown key are rejected.
+	caller.runtime.AuthKeys = []config.EncoreAuthKey{{KeyID: 2, Data: []byte("other")}}
+	_, err = desc.Call(caller.NewCallContext(ctx), &remoteReq{Body: "hello"})
+	if got := errs.Code(err); got != errs.Unauthenticated {
+		t.Fatalf("got code %v, want %v", got, errs.Unauthenticated)
+	}
+
+	// Services without service discovery configuration are unavailable.
+	caller.runtime.ServiceDiscovery = nil
+	_, err = desc.Call(caller.NewCallContext(ctx), &remoteReq{Body: "hello"})
+	if got := errs.Code(err); got != errs.Unavailable {
+		t.Fatalf("got code %v, want %v", got, errs.Unavailable)
+	}
+}
+
+func TestRemoteCallTamperedBody(t *testing.T) {
+	keys := []config.EncoreAuthKey{{KeyID: 1, Data: []byte("secret")}}
+	callee := newRemoteTestServer(t, &config.Runtime{
+		AuthKeys:       keys,
+		HostedServices: []string{"billing"},
+	})
+	callee.registerEndpoint(newRemoteTestDesc())
+	srv := httptest.NewServer(callee.httpsrv.Handler)
+	defer srv.Close()
+
+	pc := platform.NewClient(&config.Static{}, &config.Runtime{AuthKeys: keys})
+	signed := []byte(`{"req":{"Body":"hello"}}`)
+	send := func(method string, body []byte) int {
+		req, err := http.NewRequest(method, srv.URL+"/__encore/call/billing/GetInvoice", bytes.NewReader(signed))
+		if err != nil {
+			t.Fatal(err)
+		} else if err := pc.SignRequest(req, signed); err != nil {
+			t.Fatal(err)
+		}
+		req.Method = method
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if code := send("POST", signed); code != http.StatusOK {
+		t.Fatalf("got status %d for signed call, want %d", code, http.StatusOK)
+	}
+
+	// Replaying the signature with a different body is rejected.
+	tampered := []byte(`{"req":{"Body":"hello"},"uid":"admin"}`)
+	if code := send("POST", tampered); code != http.StatusUnauthorized {
+		t.Fatalf("got status %d for tampered body, want %d", code, http.StatusUnauthorized)
+	}
+
+	// So is replaying it with a different method.
+	if code := send("PUT", signed); code != http.StatusUnauthorized {
+		t.Fatalf("got status %d for tampered method, want %d", code, http.StatusUnauthorized)
+	}
+}
+
+func newRemoteTestServer(t *testing.T, runtime *config.Runtime) *Server {
+	static := &config.Static{}
+	logger := zerolog.New(os.Stdout)
+	rt := reqtrack.New(logger, nil, &traceprovider.DefaultFactory{})
+	reg := metrics.NewRegistry(rt, 0)
+	json := jsoniter.ConfigCompatibleWithStandardLibrary
+	encoreMgr := encore.NewManager(static, runtime, rt)
+	tsMgr := testsupport.NewManager(static, rt, logger)
+	pubsubMgr := pubsub.NewManager(static, runtime, rt, tsMgr, logger, json)
+	pc := platform.NewClient(static, runtime)
+	return NewServer(static, runtime, rt, pc, encoreMgr, pubsubMgr, logger, reg, json, clock.New())
+}
+
+func newRemoteTestDesc() *Desc[*remoteReq, *remoteResp] {
+	return &Desc[*remoteReq, *remoteResp]{
+		Service:  "billing",
+		Endpoint: "GetInvoice",
+		Methods:  []string{"POST"},
+		Path:     "/billing.GetInvoice",
+		RawPath:  "/billing.GetInvoice",
+		Access:   Private,
+
+		CloneReq: func(req *remoteReq) (*remoteReq, error) {
+			clone := *req
+			return &clone, nil
+		},
+		ReqPath: func(req *remoteReq) (string, UnnamedParams, error) {
+			return "/billing.GetInvoice", nil, nil
+		},
+		ReqUserPayload: func(req *remoteReq) any {
+			return req
+		},
+		AppHandler: func(ctx context.Context, req *remoteReq) (*remoteResp, error) {
+			if req.Body == "missing" {
+				return nil, errs.B().Code(errs.NotFound).Msg("no such invoice").Err()
+			}
+			return &remoteResp{Message: req.Body}, nil
+		},
+		CloneResp: func(resp *remoteResp) (*remoteResp, error) {
+			clone := *resp
+			return &clone, nil
+		},
+	}
+}
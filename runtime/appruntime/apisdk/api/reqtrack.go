@@ -81,6 +81,7 @@ func (s *Server) beginRequest(ctx context.Context, p *beginRequestParams) (*mode
 			}
 			data.UserID = a.UID
 			data.AuthData = a.UserData
+			data.AuthScheme = a.Scheme
 		}
 	}
 
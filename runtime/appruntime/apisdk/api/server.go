@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/benbjohnson/clock"
@@ -58,6 +59,9 @@ type Handler interface {
 	SemanticPath() string
 	HTTPRouterPath() string
 	HTTPMethods() []string
+	APIVersion() int
+	APIDeprecation() *Deprecation
+	APIAuthSchemes() []string
 	Handle(c IncomingContext)
 }
 
@@ -78,17 +82,29 @@ type Server struct {
 	rootLogger     zerolog.Logger
 	json           jsoniter.API
 	tracingEnabled bool
+	compression    *compressionConfig // nil if responses are not compressed
 
-	authHandler AuthHandler
+	authHandlers []AuthHandler
 
 	globalMiddleware   map[string]*Middleware
 	registeredHandlers []Handler
 
+	// hostedServices are the services hosted by this instance,
+	// or nil if all services are hosted.
+	hostedServices map[string]bool
+
+	// remoteCallHandlers are the handlers that can be called
+	// from other instances of the application, keyed by "service.endpoint".
+	remoteCallHandlers map[string]remoteCallHandler
+
 	public  *httprouter.Router
 	private *httprouter.Router
 	encore  *httprouter.Router
 	httpsrv *http.Server
 
+	// versionedRoutes are the routes shared by multiple versions of an API.
+	versionedRoutes map[versionedRouteKey]*versionedRoute
+
 	callCtr uint64
 
 	pubsubSubscriptions map[string]func(r *http.Request) error
@@ -148,6 +164,19 @@ func NewServer(
 		encore:  encore,
 	}
 
+	if len(runtime.HostedServices) > 0 {
+		s.hostedServices = make(map[string]bool, len(runtime.HostedServices))
+		for _, svc := range runtime.HostedServices {
+			s.hostedServices[svc] = true
+		}
+	}
+
+	compression, err := newCompressionConfig(runtime.Compression)
+	if err != nil {
+		rootLogger.Error().Err(err).Msg("invalid compression configuration; disabling response compression")
+	}
+	s.compression = compression
+
 	// Configure CORS
 	corsCfg := &config.CORS{}
 	if runtime.CORS != nil {
@@ -168,10 +197,10 @@ func NewServer(
 	return s
 }
 
-// setAuthHandler sets the auth handler to use.
-// If h is nil it means no auth handler is used.
-func (s *Server) setAuthHandler(h AuthHandler) {
-	s.authHandler = h
+// addAuthHandler adds an auth handler to use.
+// Endpoints select the auth handlers to use by their auth scheme.
+func (s *Server) addAuthHandler(h AuthHandler) {
+	s.authHandlers = append(s.authHandlers, h)
 }
 
 func (s *Server) RegisteredHandlers() []Handler {
@@ -181,9 +210,30 @@ func (s *Server) RegisteredHandlers() []Handler {
 // wildcardMethod is an internal method name we register wildcard methods under.
 const wildcardMethod = "__ENCORE_WILDCARD__"
 
+// isHosted reports whether the given service is hosted by this instance.
+func (s *Server) isHosted(svc string) bool {
+	return s.hostedServices == nil || s.hostedServices[svc]
+}
+
 func (s *Server) registerEndpoint(h Handler) {
+	// Endpoints of services hosted elsewhere are not served by this instance.
+	if !s.isHosted(h.ServiceName()) {
+		return
+	}
 	s.registeredHandlers = append(s.registeredHandlers, h)
 
+	if rh, ok := h.(remoteCallHandler); ok {
+		if s.remoteCallHandlers == nil {
+			s.remoteCallHandlers = make(map[string]remoteCallHandler)
+		}
+		s.remoteCallHandlers[h.ServiceName()+"."+h.EndpointName()] = rh
+	}
+
+	var deprecationHdr, sunsetHdr string
+	if dep := h.APIDeprecation(); dep != nil {
+		deprecationHdr, sunsetHdr = dep.headers()
+	}
+
 	for _, m := range h.HTTPMethods() {
 		if m == "*" {
 			m = wildcardMethod
@@ -220,17 +270,60 @@ func (s *Server) registerEndpoint(h Handler) {
 			// Always send the trace id back.
 			w.Header().Set("X-Encore-Trace-ID", traceIDStr)
 
+			if deprecationHdr != "" {
+				w.Header().Set("Deprecation", deprecationHdr)
+			}
+			if sunsetHdr != "" {
+				w.Header().Set("Sunset", sunsetHdr)
+			}
+
 			s.processRequest(h, s.NewIncomingContext(w, req, params, traceID, model2.AuthInfo{}))
 		}
 
 		routerPath := h.HTTPRouterPath()
-		s.private.Handle(m, routerPath, adapter)
-		if access := h.AccessType(); access == Public || access == RequiresAuth {
-			s.public.Handle(m, routerPath, adapter)
+		isPublic := h.AccessType() == Public || h.AccessType() == RequiresAuth
+		version := h.APIVersion()
+		if version == 0 {
+			s.private.Handle(m, routerPath, adapter)
+			if isPublic {
+				s.public.Handle(m, routerPath, adapter)
+			}
+			continue
+		}
+
+		// Versioned endpoints are reachable both with the version as a path prefix,
+		// and without it by specifying the version in the X-API-Version header.
+		prefixedPath := "/v" + strconv.Itoa(version) + routerPath
+		s.private.Handle(m, prefixedPath, adapter)
+		s.addVersionedRoute(s.private, m, routerPath, version, adapter)
+		if isPublic {
+			s.public.Handle(m, prefixedPath, adapter)
+			s.addVersionedRoute(s.public, m, routerPath, version, adapter)
 		}
 	}
 }
 
+type versionedRouteKey struct {
+	router       *httprouter.Router
+	method, path string
+}
+
+// addVersionedRoute adds a version of an API to the versioned route
+// for the given method and path, registering the route if necessary.
+func (s *Server) addVersionedRoute(r *httprouter.Router, method, path string, version int, h httprouter.Handle) {
+	if s.versionedRoutes == nil {
+		s.versionedRoutes = make(map[versionedRouteKey]*versionedRoute)
+	}
+	key := versionedRouteKey{router: r, method: method, path: path}
+	route, ok := s.versionedRoutes[key]
+	if !ok {
+		route = &versionedRoute{}
+		s.versionedRoutes[key] = route
+		r.Handle(method, path, route.serve)
+	}
+	route.add(version, h)
+}
+
 func (s *Server) registerGlobalMiddleware(mw *Middleware) {
 	if s.globalMiddleware == nil {
 		s.globalMiddleware = make(map[string]*Middleware)
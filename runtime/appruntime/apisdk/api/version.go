@@ -0,0 +1,84 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+
+	"encore.dev/beta/errs"
+)
+
+// VersionHeader is the request header used to select which version
+// of a versioned API to call when calling it without a version prefix.
+const VersionHeader = "X-API-Version"
+
+// Deprecation describes the deprecation of an API.
+type Deprecation struct {
+	// Since is the date the API was deprecated ("YYYY-MM-DD"),
+	// or "" if unspecified.
+	Since string
+
+	// Sunset is the date the API will be removed ("YYYY-MM-DD"),
+	// or "" if unspecified.
+	Sunset string
+}
+
+// headers computes the response headers to send for the deprecation,
+// following RFC 9745 (Deprecation) and RFC 8594 (Sunset).
+func (d *Deprecation) headers() (deprecation, sunset string) {
+	deprecation = "true"
+	if t, err := time.Parse("2006-01-02", d.Since); err == nil {
+		deprecation = "@" + strconv.FormatInt(t.Unix(), 10)
+	}
+	if t, err := time.Parse("2006-01-02", d.Sunset); err == nil {
+		sunset = t.Format(http.TimeFormat)
+	}
+	return deprecation, sunset
+}
+
+// versionedRoute is a route served by multiple versions of the same API.
+// It dispatches requests to a version based on the X-API-Version header.
+type versionedRoute struct {
+	handlers []versionedHandler // sorted by version
+}
+
+type versionedHandler struct {
+	version int
+	handle  httprouter.Handle
+}
+
+func (r *versionedRoute) add(version int, h httprouter.Handle) {
+	r.handlers = append(r.handlers, versionedHandler{version: version, handle: h})
+	sort.Slice(r.handlers, func(i, j int) bool {
+		return r.handlers[i].version < r.handlers[j].version
+	})
+}
+
+// serve dispatches the request to the highest version that is less than
+// or equal to the requested version. If no version is requested the lowest
+// version is used, so that existing callers are unaffected by new versions.
+func (r *versionedRoute) serve(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	hdr := req.Header.Get(VersionHeader)
+	if hdr == "" {
+		r.handlers[0].handle(w, req, ps)
+		return
+	}
+
+	want, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(hdr), "v"))
+	if err != nil || want < 1 {
+		errs.HTTPError(w, errs.B().Code(errs.InvalidArgument).Msgf("invalid %s header: %q", VersionHeader, hdr).Err())
+		return
+	}
+
+	for i := len(r.handlers) - 1; i >= 0; i-- {
+		if h := r.handlers[i]; h.version <= want {
+			h.handle(w, req, ps)
+			return
+		}
+	}
+	errs.HTTPError(w, errs.B().Code(errs.NotFound).Msgf("endpoint not found for API version %d", want).Err())
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestVersionedRoute(t *testing.T) {
+	route := &versionedRoute{}
+	for _, v := range []int{3, 1, 2} {
+		v := v
+		route.add(v, func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+			w.Header().Set("X-Served-Version", strconv.Itoa(v))
+		})
+	}
+
+	tests := []struct {
+		header      string
+		wantVersion string
+		wantCode    int
+	}{
+		{"", "1", http.StatusOK},
+		{"1", "1", http.StatusOK},
+		{"2", "2", http.StatusOK},
+		{"v3", "3", http.StatusOK},
+		{"10", "3", http.StatusOK},
+		{"foo", "", http.StatusBadRequest},
+		{"0", "", http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/foo", nil)
+		if test.header != "" {
+			req.Header.Set(VersionHeader, test.header)
+		}
+		route.serve(w, req, nil)
+		if w.Code != test.wantCode {
+			t.Errorf("version %q: got code=%d, want %d", test.header, w.Code, test.wantCode)
+		} else if got := w.Header().Get("X-Served-Version"); got != test.wantVersion {
+			t.Errorf("version %q: got served version %q, want %q", test.header, got, test.wantVersion)
+		}
+	}
+
+	// Requesting a version older than all versions is not found.
+	route = &versionedRoute{}
+	route.add(2, func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/foo", nil)
+	req.Header.Set(VersionHeader, "1")
+	route.serve(w, req, nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("got code=%d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestDeprecationHeaders(t *testing.T) {
+	tests := []struct {
+		dep                         Deprecation
+		wantDeprecation, wantSunset string
+	}{
+		{Deprecation{}, "true", ""},
+		{Deprecation{Since: "2023-01-15"}, "@1673740800", ""},
+		{Deprecation{Since: "2023-01-15", Sunset: "2023-06-30"}, "@1673740800", "Fri, 30 Jun 2023 00:00:00 GMT"},
+	}
+	for _, test := range tests {
+		deprecation, sunset := test.dep.headers()
+		if deprecation != test.wantDeprecation || sunset != test.wantSunset {
+			t.Errorf("%+v: got (%q, %q), want (%q, %q)", test.dep,
+				deprecation, sunset, test.wantDeprecation, test.wantSunset)
+		}
+	}
+}
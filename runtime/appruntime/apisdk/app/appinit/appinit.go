@@ -4,6 +4,7 @@ package appinit
 
 import (
 	"io"
+	"os"
 
 	"encore.dev/appruntime/apisdk/api"
 	"encore.dev/appruntime/apisdk/app"
@@ -15,6 +16,11 @@ import (
 
 // AppMain is the entrypoint to the Encore Application.
 func AppMain() {
+	// The migrate command applies database migrations instead of running the app.
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(migrateMain(os.Args[2:]))
+	}
+
 	inst := app.New(appconf.Runtime, service.Singleton, api.Singleton, shutdown.Singleton, logging.RootLogger)
 	if err := inst.Run(); err != nil && err != io.EOF {
 		logging.RootLogger.Fatal().Err(err).Msg("could not run")
@@ -0,0 +1,48 @@
+//go:build encore_app
+
+package appinit
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"encore.dev/storage/sqldb"
+)
+
+// defaultMigrationsDir is where ejected docker images store the app's migrations.
+const defaultMigrationsDir = "/encore/migrations"
+
+// migrateMain implements the migrate command, which applies the app's database
+// migrations to the databases in the runtime config. It returns the exit code.
+func migrateMain(args []string) int {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s migrate [flags] [database...]\n\n", os.Args[0])
+		fmt.Fprintln(fs.Output(), "Applies pending database migrations. If no databases are given, all databases are migrated.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	var opts sqldb.MigrateOptions
+	fs.StringVar(&opts.Dir, "dir", defaultMigrationsDir, "directory containing the migrations, with one subdirectory per database")
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "report the migrations that would be applied without applying them")
+	fs.BoolVar(&opts.Status, "status", false, "report the migration status of each database")
+	if err := fs.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return 0
+	} else if err != nil {
+		return 2
+	}
+	opts.Databases = fs.Args()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	if err := sqldb.Singleton.Migrate(ctx, os.Stdout, opts); err != nil {
+		fmt.Fprintln(os.Stderr, "migrate:", err)
+		return 1
+	}
+	return 0
+}
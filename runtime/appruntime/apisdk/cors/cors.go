@@ -40,6 +40,7 @@ func Options(cfg *config.CORS, staticAllowedHeaders, staticExposedHeaders []stri
 		"Content-Type",
 		"X-Request-ID",
 		"X-Correlation-ID",
+		"X-API-Version",
 	}
 	allowedHeaders = append(allowedHeaders, cfg.ExtraAllowedHeaders...)
 	allowedHeaders = append(allowedHeaders, staticAllowedHeaders...)
@@ -48,6 +49,8 @@ func Options(cfg *config.CORS, staticAllowedHeaders, staticExposedHeaders []stri
 		"X-Request-ID",
 		"X-Correlation-ID",
 		"X-Encore-Trace-ID",
+		"Deprecation",
+		"Sunset",
 	}
 	exposedHeaders = append(exposedHeaders, cfg.ExtraExposedHeaders...)
 	exposedHeaders = append(exposedHeaders, staticExposedHeaders...)
@@ -21,6 +21,9 @@ type Static struct {
 
 	// BundledServices are the services bundled in this binary.
 	BundledServices []string
+
+	// SecretKeys are the keys of all secrets used by the application.
+	SecretKeys []string
 }
 
 type Runtime struct {
@@ -36,6 +39,7 @@ type Runtime struct {
 	TraceEndpoint string          `json:"trace_endpoint,omitempty"`
 	AuthKeys      []EncoreAuthKey `json:"auth_keys,omitempty"`
 	CORS          *CORS           `json:"cors,omitempty"`
+	Compression   *Compression    `json:"compression,omitempty"` // nil means responses are not compressed
 
 	SQLDatabases    []*SQLDatabase          `json:"sql_databases,omitempty"`
 	SQLServers      []*SQLServer            `json:"sql_servers,omitempty"`
@@ -45,6 +49,29 @@ type Runtime struct {
 	RedisDatabases  []*RedisDatabase        `json:"redis_databases,omitempty"`
 	Metrics         *Metrics                `json:"metrics,omitempty"`
 
+	// SecretsProviders are the sources to resolve secrets from,
+	// in addition to the secrets provided by Encore.
+	// They are consulted in order; the first provider with a value for a secret wins.
+	SecretsProviders []*SecretsProvider `json:"secrets_providers,omitempty"`
+
+	// SecretsRefreshInterval is how often secrets declared as secret.Value
+	// are re-fetched from the secrets providers to pick up rotated values.
+	// If zero it defaults to 5 minutes.
+	SecretsRefreshInterval time.Duration `json:"secrets_refresh_interval,omitempty"`
+
+	// LiveConfig describes where to fetch live updates to config values from.
+	// If nil, config values are fixed at startup.
+	LiveConfig *LiveConfig `json:"live_config,omitempty"`
+
+	// HostedServices are the services hosted by this instance of the application.
+	// If empty, all services are hosted.
+	HostedServices []string `json:"hosted_services,omitempty"`
+
+	// ServiceDiscovery describes how to reach services that are not
+	// hosted by this instance, keyed by service name.
+	// Calls to those services are made over HTTP.
+	ServiceDiscovery map[string]*ServiceDiscovery `json:"service_discovery,omitempty"`
+
 	// ShutdownTimeout is the duration before non-graceful shutdown is initiated,
 	// meaning connections are closed even if outstanding requests are still in flight.
 	// If zero, it shuts down immediately.
@@ -95,6 +122,18 @@ type CORS struct {
 	AllowPrivateNetworkAccess bool `json:"allow_private_network_access,omitempty"`
 }
 
+// Compression configures compression of API responses.
+type Compression struct {
+	// Encodings are the content encodings to use, in order of preference.
+	// Supported encodings are "br", "zstd" and "gzip".
+	// If empty it defaults to all of them.
+	Encodings []string `json:"encodings,omitempty"`
+
+	// MinSize is the minimum size of a response body, in bytes,
+	// for it to be compressed. If zero it defaults to 1 KiB.
+	MinSize int `json:"min_size,omitempty"`
+}
+
 type CommitInfo struct {
 	Revision    string `json:"revision"`
 	Uncommitted bool   `json:"uncommitted"`
@@ -129,6 +168,12 @@ func (eak EncoreAuthKey) Copy() EncoreAuthKey {
 	return c
 }
 
+type ServiceDiscovery struct {
+	// URL is the base URL of the deployment hosting the service,
+	// such as "http://billing:8080".
+	URL string `json:"url"`
+}
+
 type PubsubProvider struct {
 	NSQ   *NSQProvider             `json:"nsq,omitempty"`   // set if the provider is NSQ
 	GCP   *GCPPubsubProvider       `json:"gcp,omitempty"`   // set if the provider is GCP
@@ -206,8 +251,13 @@ type StaticPubsubSubscription struct {
 type SQLServer struct {
 	// Host is the host to connect to.
 	// Valid formats are "hostname", "hostname:port", and "/path/to/unix.socket".
+	// For SQLite it is the directory containing the database files.
 	Host string `json:"host"`
 
+	// Engine is the database engine of the server: "postgres", "mysql" or "sqlite".
+	// If empty it defaults to "postgres".
+	Engine string `json:"engine,omitempty"`
+
 	// ServerCACert is the PEM-encoded server CA cert, or "" if not required.
 	ServerCACert string `json:"server_ca_cert,omitempty"`
 	// ClientCert is the PEM-encoded client cert, or "" if not required.
@@ -223,6 +273,13 @@ type SQLDatabase struct {
 	User         string `json:"user"`
 	Password     string `json:"password"`
 
+	// PasswordSecret, if set, is the name of a secret holding the password
+	// to use instead of Password. The secret is resolved through the secrets
+	// providers and refreshed in the background, and new connections
+	// always use its latest value, so rotated credentials are picked up
+	// when the connection pool reconnects.
+	PasswordSecret string `json:"password_secret,omitempty"`
+
 	// MinConnections is the minimum number of open connections to use
 	// for this database. If zero it defaults to 2.
 	MinConnections int `json:"min_connections"`
@@ -230,6 +287,30 @@ type SQLDatabase struct {
 	// MaxConnections is the maximum number of open connections to use
 	// for this database. If zero it defaults to 30.
 	MaxConnections int `json:"max_connections"`
+
+	// Replicas are read replicas of the database. Queries made with
+	// a context from sqldb.WithReplica are routed to the replicas.
+	Replicas []*SQLReplica `json:"replicas,omitempty"`
+
+	// MaxReplicaLag is the maximum replication lag for a replica to be used.
+	// Queries fall back to the primary when all replicas lag further behind.
+	// If zero it defaults to 10s.
+	MaxReplicaLag time.Duration `json:"max_replica_lag,omitempty"`
+
+	// TemplateName is the name of a database on the same server holding
+	// the migrated schema, that tests can clone to get an isolated database.
+	// It is only set when running tests, and not for MySQL databases.
+	TemplateName string `json:"template_name,omitempty"`
+}
+
+// SQLReplica describes a read replica of a SQL database.
+// It is connected to using the same credentials as the primary.
+type SQLReplica struct {
+	ServerID int `json:"server_id"` // the index into (*Runtime).SQLServers
+
+	// DatabaseName is the name of the database on the replica server.
+	// If empty it defaults to the primary's database name.
+	DatabaseName string `json:"database_name,omitempty"`
 }
 
 type RedisServer struct {
@@ -334,3 +415,79 @@ type TokenBucketLimiter struct {
 	PerSecondRate float64 `json:"rate"` // The rate at which to allow requests to pass through.
 	BucketSize    int     `json:"size"` // The size of the token bucket (starts full)
 }
+
+// LiveConfig describes a source of live updates to config values
+// declared as config.Value or config.Values.
+// Exactly one of Path and URL must be set.
+type LiveConfig struct {
+	// Path is the path to a JSON file containing the config values to update,
+	// keyed by service name. The file is watched for changes.
+	Path string `json:"path,omitempty"`
+
+	// URL is the URL of a config service serving the same JSON document as Path.
+	URL string `json:"url,omitempty"`
+
+	// PollInterval is how often the source is checked for changes.
+	// If zero it defaults to 30 seconds.
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
+}
+
+type SecretsProvider struct {
+	Env   *EnvSecretsProvider   `json:"env,omitempty"`   // set if secrets are read from environment variables
+	Files *FileSecretsProvider  `json:"files,omitempty"` // set if secrets are read from files
+	Vault *VaultSecretsProvider `json:"vault,omitempty"` // set if the provider is HashiCorp Vault
+	AWS   *AWSSecretsProvider   `json:"aws,omitempty"`   // set if the provider is AWS Secrets Manager
+	GCP   *GCPSecretsProvider   `json:"gcp,omitempty"`   // set if the provider is GCP Secret Manager
+}
+
+type EnvSecretsProvider struct {
+	// Prefix is prepended to the secret key to compute the environment variable name.
+	// For example, with the prefix "SECRET_" the secret "GitHubToken"
+	// is read from the environment variable "SECRET_GitHubToken".
+	Prefix string `json:"prefix,omitempty"`
+}
+
+type FileSecretsProvider struct {
+	// Dir is the directory containing the secrets, with one file per secret
+	// named after the secret key, such as a mounted Kubernetes secret volume.
+	Dir string `json:"dir"`
+}
+
+type VaultSecretsProvider struct {
+	// Address is the address of the Vault server, like "https://vault.example.com:8200".
+	Address string `json:"address"`
+
+	// Namespace is the Vault Enterprise namespace to use, if any.
+	Namespace string `json:"namespace,omitempty"`
+
+	// MountPath is the path of the KV version 2 secrets engine.
+	// If empty it defaults to "secret".
+	MountPath string `json:"mount_path,omitempty"`
+
+	// Path is the path of the secret within the secrets engine.
+	// Its keys are the secret keys.
+	Path string `json:"path"`
+
+	// Token is the Vault token to authenticate with.
+	// If empty the token is read from TokenFile if set,
+	// or otherwise from the VAULT_TOKEN environment variable.
+	Token     string `json:"token,omitempty"`
+	TokenFile string `json:"token_file,omitempty"`
+}
+
+type AWSSecretsProvider struct {
+	// Region is the AWS region of the secrets.
+	// If empty it defaults to the region of the environment.
+	Region string `json:"region,omitempty"`
+
+	// Prefix is prepended to the secret key to compute the secret name.
+	Prefix string `json:"prefix,omitempty"`
+}
+
+type GCPSecretsProvider struct {
+	// ProjectID is the GCP project id where the secrets exist.
+	ProjectID string `json:"project_id"`
+
+	// Prefix is prepended to the secret key to compute the secret name.
+	Prefix string `json:"prefix,omitempty"`
+}
@@ -97,6 +97,10 @@ type RPCData struct {
 	UserID   UID
 	AuthData any
 
+	// AuthScheme is the name of the auth scheme that authenticated the request,
+	// or "" if it's unauthenticated or the auth handler has no scheme name.
+	AuthScheme string
+
 	// Decoded request payload, for non-raw requests
 	TypedPayload any
 
@@ -189,4 +193,5 @@ type UID string
 type AuthInfo struct {
 	UID      UID
 	UserData any
+	Scheme   string // the auth scheme that authenticated the request, if named
 }
@@ -240,6 +240,7 @@ type DBQueryStartParams struct {
 	QueryID uint64
 	TxID    uint64
 	Stack   stack.Stack
+	Node    string // the database node serving the query, or "" if unknown
 }
 
 func (l *Log) DBQueryStart(p DBQueryStartParams) {
@@ -250,6 +251,7 @@ func (l *Log) DBQueryStart(p DBQueryStartParams) {
 	tb.UVarint(uint64(p.Goid))
 	tb.String(p.Query)
 	tb.Stack(p.Stack)
+	tb.String(p.Node)
 	l.Add(QueryStart, tb.Buf())
 }
 
@@ -3,4 +3,4 @@ package trace
 type Version int
 
 // CurrentVersion is the trace protocol version this package produces traces in.
-const CurrentVersion Version = 12
+const CurrentVersion Version = 13
@@ -0,0 +1,134 @@
+//go:build !encore_no_aws
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+func init() {
+	registerProvider(providerDesc{
+		name:    "aws",
+		matches: func(cfg *config.SecretsProvider) bool { return cfg.AWS != nil },
+		newProvider: func(cfg *config.SecretsProvider) (provider, error) {
+			return &awsProvider{cfg: cfg.AWS, client: &http.Client{Timeout: 30 * time.Second}}, nil
+		},
+	})
+}
+
+// awsProvider resolves secrets from AWS Secrets Manager.
+//
+// It calls the Secrets Manager API directly, signing requests
+// with the credentials from the environment.
+type awsProvider struct {
+	cfg    *config.AWSSecretsProvider
+	client *http.Client
+
+	once   sync.Once
+	awsCfg aws.Config
+	cfgErr error
+}
+
+func (p *awsProvider) name() string { return "aws" }
+
+func (p *awsProvider) fetch(ctx context.Context, keys []string) (map[string]string, error) {
+	p.once.Do(func() {
+		var opts []func(*awsconfig.LoadOptions) error
+		if p.cfg.Region != "" {
+			opts = append(opts, awsconfig.WithRegion(p.cfg.Region))
+		}
+		p.awsCfg, p.cfgErr = awsconfig.LoadDefaultConfig(ctx, opts...)
+	})
+	if p.cfgErr != nil {
+		return nil, fmt.Errorf("load aws config: %v", p.cfgErr)
+	}
+
+	vals := make(map[string]string, len(keys))
+	for _, key := range keys {
+		val, found, err := p.getSecretValue(ctx, p.cfg.Prefix+key)
+		if err != nil {
+			return nil, fmt.Errorf("get secret %s: %v", key, err)
+		} else if found {
+			vals[key] = val
+		}
+	}
+	return vals, nil
+}
+
+// getSecretValue calls the Secrets Manager GetSecretValue API
+// for the latest version of the given secret.
+func (p *awsProvider) getSecretValue(ctx context.Context, secretID string) (val string, found bool, err error) {
+	body, err := json.Marshal(map[string]string{"SecretId": secretID})
+	if err != nil {
+		return "", false, err
+	}
+
+	region := p.awsCfg.Region
+	endpoint := fmt.Sprintf("https://secretsmanager.%s.amazonaws.com/", region)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", false, err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	creds, err := p.awsCfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", false, fmt.Errorf("retrieve credentials: %v", err)
+	}
+	hash := sha256.Sum256(body)
+	err = v4.NewSigner().SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "secretsmanager", region, time.Now())
+	if err != nil {
+		return "", false, fmt.Errorf("sign request: %v", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", false, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(respBody, &errResp)
+		if errResp.Type == "ResourceNotFoundException" {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("got status %d: %s", resp.StatusCode, respBody)
+	}
+
+	var out struct {
+		SecretString *string `json:"SecretString"`
+		SecretBinary []byte  `json:"SecretBinary"`
+	}
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return "", false, fmt.Errorf("decode response: %v", err)
+	}
+	if out.SecretString != nil {
+		return *out.SecretString, true, nil
+	}
+	return string(out.SecretBinary), true, nil
+}
@@ -0,0 +1,70 @@
+//go:build !encore_no_gcp
+
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"google.golang.org/api/googleapi"
+	secretmanager "google.golang.org/api/secretmanager/v1"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+func init() {
+	registerProvider(providerDesc{
+		name:    "gcp",
+		matches: func(cfg *config.SecretsProvider) bool { return cfg.GCP != nil },
+		newProvider: func(cfg *config.SecretsProvider) (provider, error) {
+			if cfg.GCP.ProjectID == "" {
+				return nil, errors.New("gcp: no project id specified")
+			}
+			return &gcpProvider{cfg: cfg.GCP}, nil
+		},
+	})
+}
+
+// gcpProvider resolves secrets from GCP Secret Manager.
+type gcpProvider struct {
+	cfg *config.GCPSecretsProvider
+
+	once   sync.Once
+	svc    *secretmanager.Service
+	svcErr error
+}
+
+func (p *gcpProvider) name() string { return "gcp" }
+
+func (p *gcpProvider) fetch(ctx context.Context, keys []string) (map[string]string, error) {
+	p.once.Do(func() {
+		p.svc, p.svcErr = secretmanager.NewService(context.Background())
+	})
+	if p.svcErr != nil {
+		return nil, fmt.Errorf("create secret manager client: %v", p.svcErr)
+	}
+
+	vals := make(map[string]string, len(keys))
+	for _, key := range keys {
+		name := fmt.Sprintf("projects/%s/secrets/%s/versions/latest", p.cfg.ProjectID, p.cfg.Prefix+key)
+		resp, err := p.svc.Projects.Secrets.Versions.Access(name).Context(ctx).Do()
+		if err != nil {
+			var gerr *googleapi.Error
+			if errors.As(err, &gerr) && gerr.Code == http.StatusNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("access secret %s: %v", key, err)
+		}
+
+		data, err := base64.StdEncoding.DecodeString(resp.Payload.Data)
+		if err != nil {
+			return nil, fmt.Errorf("decode secret %s: %v", key, err)
+		}
+		vals[key] = string(data)
+	}
+	return vals, nil
+}
@@ -1,10 +1,12 @@
 package secrets
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -13,8 +15,14 @@ import (
 )
 
 type Manager struct {
-	cfg     *config.Runtime
+	cfg       *config.Runtime
+	providers []provider
+
+	mu      sync.Mutex
 	secrets map[string]string
+	fixed   map[string]bool           // keys provided by Encore, which never change
+	watched map[string]*watchedSecret // secrets refreshed in the background
+	refresh sync.Once
 
 	// track missing secrets for local development
 	missing    []string
@@ -22,27 +30,128 @@ type Manager struct {
 }
 
 func NewManager(cfg *config.Runtime, appSecretsEnv string) *Manager {
-	return &Manager{cfg: cfg, secrets: parse(appSecretsEnv)}
+	mgr := &Manager{
+		cfg:     cfg,
+		secrets: parse(appSecretsEnv),
+		fixed:   make(map[string]bool),
+		watched: make(map[string]*watchedSecret),
+	}
+	for key := range mgr.secrets {
+		mgr.fixed[key] = true
+	}
+	for _, p := range cfg.SecretsProviders {
+		prov, err := newProvider(p)
+		if err != nil {
+			log.Fatalln("encore runtime: fatal error: invalid secrets provider:", err)
+		}
+		mgr.providers = append(mgr.providers, prov)
+	}
+	return mgr
+}
+
+// Resolve resolves the values of the given secret keys from the configured
+// secrets providers, for keys not already provided by Encore.
+//
+// It reports the keys that could not be resolved by any provider,
+// sorted by key.
+func (mgr *Manager) Resolve(ctx context.Context, keys []string) (missing []string, err error) {
+	mgr.mu.Lock()
+	var pending []string
+	for _, key := range keys {
+		if _, ok := mgr.secrets[key]; !ok {
+			pending = append(pending, key)
+		}
+	}
+	mgr.mu.Unlock()
+
+	// Fetch the secrets without holding the lock, as the providers
+	// may take a while to respond.
+	vals, missing, err := mgr.fetch(ctx, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	for key, val := range vals {
+		// Keep values resolved concurrently, such as by a refresh.
+		if _, ok := mgr.secrets[key]; !ok {
+			mgr.secrets[key] = val
+		}
+	}
+	return missing, nil
+}
+
+// fetch fetches the given keys from the secrets providers, in order.
+// It reports the keys that could not be found by any provider, sorted by key.
+func (mgr *Manager) fetch(ctx context.Context, keys []string) (vals map[string]string, missing []string, err error) {
+	vals = make(map[string]string, len(keys))
+	pending := append([]string(nil), keys...)
+	for _, p := range mgr.providers {
+		if len(pending) == 0 {
+			break
+		}
+		found, err := p.fetch(ctx, pending)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s secrets provider: %v", p.name(), err)
+		}
+
+		remaining := pending[:0]
+		for _, key := range pending {
+			if val, ok := found[key]; ok {
+				vals[key] = val
+			} else {
+				remaining = append(remaining, key)
+			}
+		}
+		pending = remaining
+	}
+
+	sort.Strings(pending)
+	return vals, pending, nil
 }
 
 // Load loads a secret.
 func (mgr *Manager) Load(key string) string {
-	if val, ok := mgr.secrets[key]; ok {
+	mgr.mu.Lock()
+	val, ok := mgr.secrets[key]
+	mgr.mu.Unlock()
+	if ok {
 		return val
 	}
 
+	// The secret was not resolved at startup; try the providers.
+	if len(mgr.providers) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		missing, err := mgr.Resolve(ctx, []string{key})
+		cancel()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "encore: could not resolve secret", key+":", err)
+		} else if len(missing) == 0 {
+			mgr.mu.Lock()
+			val = mgr.secrets[key]
+			mgr.mu.Unlock()
+			return val
+		}
+	}
+
 	// For anything but local development, a missing secret is a fatal error.
 	if mgr.cfg.EnvCloud != "local" {
 		fmt.Fprintln(os.Stderr, "encore: could not find secret", key)
 		os.Exit(2)
 	}
 
+	mgr.mu.Lock()
 	mgr.missing = append(mgr.missing, key)
+	mgr.mu.Unlock()
 	mgr.logMissing.Do(func() {
 		// Wait one second before logging all the missing secrets.
 		go func() {
 			time.Sleep(1 * time.Second)
-			fmt.Fprintln(os.Stderr, "\n\033[31mwarning: secrets not defined:", strings.Join(mgr.missing, ", "), "\033[0m")
+			mgr.mu.Lock()
+			missing := strings.Join(mgr.missing, ", ")
+			mgr.mu.Unlock()
+			fmt.Fprintln(os.Stderr, "\n\033[31mwarning: secrets not defined:", missing, "\033[0m")
 			fmt.Fprintln(os.Stderr, "\033[2mnote: undefined secrets are left empty for local development only.")
 			fmt.Fprint(os.Stderr, "see https://encore.dev/docs/primitives/secrets for more information\033[0m\n\n")
 		}()
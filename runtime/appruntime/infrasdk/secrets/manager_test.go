@@ -0,0 +1,219 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+func TestManager_Resolve(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "FileSecret"), []byte("from-file"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "Shadowed"), []byte("from-file"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_SECRET_EnvSecret", "from-env")
+	t.Setenv("TEST_SECRET_Shadowed", "from-env")
+
+	cfg := &config.Runtime{
+		EnvCloud: "aws",
+		SecretsProviders: []*config.SecretsProvider{
+			{Env: &config.EnvSecretsProvider{Prefix: "TEST_SECRET_"}},
+			{Files: &config.FileSecretsProvider{Dir: dir}},
+		},
+	}
+	appSecrets := "EncoreSecret=" + base64.RawURLEncoding.EncodeToString([]byte("from-encore"))
+	mgr := NewManager(cfg, appSecrets)
+
+	missing, err := mgr.Resolve(context.Background(), []string{"EncoreSecret", "EnvSecret", "FileSecret", "Shadowed", "Missing"})
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(missing, []string{"Missing"}) {
+		t.Fatalf("got missing %v, want [Missing]", missing)
+	}
+
+	want := map[string]string{
+		"EncoreSecret": "from-encore",
+		"EnvSecret":    "from-env",
+		"FileSecret":   "from-file",
+		"Shadowed":     "from-env", // earlier providers take precedence
+	}
+	for key, val := range want {
+		if got := mgr.Load(key); got != val {
+			t.Errorf("Load(%q) = %q, want %q", key, got, val)
+		}
+	}
+}
+
+func TestVaultProvider(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if got := req.Header.Get("X-Vault-Token"); got != "token" {
+			http.Error(w, "permission denied", http.StatusForbidden)
+			return
+		} else if req.URL.Path != "/v1/kv/data/myapp/prod" {
+			http.NotFound(w, req)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"data": map[string]any{
+					"Token":  "secret-token",
+					"Config": map[string]any{"a": 1},
+				},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	p, err := newVaultProvider(&config.VaultSecretsProvider{
+		Address:   srv.URL,
+		MountPath: "kv",
+		Path:      "myapp/prod",
+		Token:     "token",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := p.fetch(context.Background(), []string{"Token", "Config", "Missing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"Token": "secret-token", "Config": `{"a":1}`}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	// A missing secret path means no keys are found.
+	p.cfg.Path = "other"
+	got, err = p.fetch(context.Background(), []string{"Token"})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(got) != 0 {
+		t.Fatalf("got %v, want no values", got)
+	}
+
+	// Authentication errors are reported.
+	p.cfg.Token = "invalid"
+	if _, err := p.fetch(context.Background(), []string{"Token"}); err == nil {
+		t.Fatal("expected error for invalid token")
+	}
+}
+
+func TestManager_Refresh(t *testing.T) {
+	dir := t.TempDir()
+	write := func(key, val string) {
+		if err := os.WriteFile(filepath.Join(dir, key), []byte(val), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("Rotated", "v1")
+	write("Fixed", "from-file")
+
+	cfg := &config.Runtime{
+		EnvCloud:         "aws",
+		SecretsProviders: []*config.SecretsProvider{{Files: &config.FileSecretsProvider{Dir: dir}}},
+	}
+	appSecrets := "Fixed=" + base64.RawURLEncoding.EncodeToString([]byte("from-encore"))
+	mgr := NewManager(cfg, appSecrets)
+
+	rotated := mgr.Watch("Rotated")
+	fixed := mgr.Watch("Fixed")
+	if got := rotated.Get(); got != "v1" {
+		t.Fatalf("got %q, want v1", got)
+	}
+
+	var changes []string
+	stop := rotated.OnChange(func(val string) { changes = append(changes, val) })
+
+	// Refreshing without changes does not notify.
+	if err := mgr.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if len(changes) != 0 {
+		t.Fatalf("got changes %v, want none", changes)
+	}
+
+	write("Rotated", "v2")
+	if err := mgr.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := rotated.Get(); got != "v2" {
+		t.Fatalf("got %q, want v2", got)
+	} else if got := mgr.Load("Rotated"); got != "v2" {
+		t.Fatalf("Load: got %q, want v2", got)
+	} else if !reflect.DeepEqual(changes, []string{"v2"}) {
+		t.Fatalf("got changes %v, want [v2]", changes)
+	}
+
+	// Secrets provided by Encore are never refreshed.
+	if got := fixed.Get(); got != "from-encore" {
+		t.Fatalf("got %q, want from-encore", got)
+	}
+
+	// A secret that can no longer be found keeps its value,
+	// and stopped listeners are no longer notified.
+	stop()
+	if err := os.Remove(filepath.Join(dir, "Rotated")); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if got := rotated.Get(); got != "v2" {
+		t.Fatalf("got %q, want v2", got)
+	}
+	write("Rotated", "v3")
+	if err := mgr.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if len(changes) != 1 {
+		t.Fatalf("got changes %v after stop, want [v2]", changes)
+	}
+}
+
+// blockingProvider is a provider whose fetches block until unblock is closed.
+type blockingProvider struct {
+	fetching chan struct{}
+	unblock  chan struct{}
+}
+
+func (p *blockingProvider) name() string { return "blocking" }
+
+func (p *blockingProvider) fetch(ctx context.Context, keys []string) (map[string]string, error) {
+	p.fetching <- struct{}{}
+	<-p.unblock
+	vals := make(map[string]string, len(keys))
+	for _, key := range keys {
+		vals[key] = "from-provider"
+	}
+	return vals, nil
+}
+
+func TestManager_ResolveUnlocked(t *testing.T) {
+	appSecrets := "Known=" + base64.RawURLEncoding.EncodeToString([]byte("from-encore"))
+	mgr := NewManager(&config.Runtime{EnvCloud: "aws"}, appSecrets)
+	p := &blockingProvider{fetching: make(chan struct{}), unblock: make(chan struct{})}
+	mgr.providers = []provider{p}
+
+	done := make(chan string)
+	go func() { done <- mgr.Load("Lazy") }()
+	<-p.fetching
+
+	// Loading a resolved secret doesn't wait for the provider.
+	if got := mgr.Load("Known"); got != "from-encore" {
+		t.Fatalf("got %q, want from-encore", got)
+	}
+
+	close(p.unblock)
+	if got := <-done; got != "from-provider" {
+		t.Fatalf("got %q, want from-provider", got)
+	}
+}
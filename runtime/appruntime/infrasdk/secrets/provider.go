@@ -0,0 +1,102 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+// provider resolves secret values from a secrets backend.
+type provider interface {
+	// name is the name of the provider, for use in error messages.
+	name() string
+
+	// fetch fetches the values of the given secret keys.
+	// Keys the provider has no value for are omitted from the result.
+	fetch(ctx context.Context, keys []string) (map[string]string, error)
+}
+
+type providerDesc struct {
+	name        string
+	matches     func(cfg *config.SecretsProvider) bool
+	newProvider func(cfg *config.SecretsProvider) (provider, error)
+}
+
+var providerRegistry []providerDesc
+
+func registerProvider(desc providerDesc) {
+	providerRegistry = append(providerRegistry, desc)
+}
+
+func newProvider(cfg *config.SecretsProvider) (provider, error) {
+	for _, desc := range providerRegistry {
+		if desc.matches(cfg) {
+			return desc.newProvider(cfg)
+		}
+	}
+	return nil, errors.New("unknown or unsupported secrets provider")
+}
+
+func init() {
+	registerProvider(providerDesc{
+		name:    "env",
+		matches: func(cfg *config.SecretsProvider) bool { return cfg.Env != nil },
+		newProvider: func(cfg *config.SecretsProvider) (provider, error) {
+			return &envProvider{prefix: cfg.Env.Prefix}, nil
+		},
+	})
+	registerProvider(providerDesc{
+		name:    "files",
+		matches: func(cfg *config.SecretsProvider) bool { return cfg.Files != nil },
+		newProvider: func(cfg *config.SecretsProvider) (provider, error) {
+			if cfg.Files.Dir == "" {
+				return nil, errors.New("files: no directory specified")
+			}
+			return &fileProvider{dir: cfg.Files.Dir}, nil
+		},
+	})
+}
+
+// envProvider resolves secrets from environment variables.
+type envProvider struct {
+	prefix string
+}
+
+func (p *envProvider) name() string { return "env" }
+
+func (p *envProvider) fetch(ctx context.Context, keys []string) (map[string]string, error) {
+	vals := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if val, ok := os.LookupEnv(p.prefix + key); ok {
+			vals[key] = val
+		}
+	}
+	return vals, nil
+}
+
+// fileProvider resolves secrets from a directory containing one file per secret,
+// such as a mounted Kubernetes secret volume.
+type fileProvider struct {
+	dir string
+}
+
+func (p *fileProvider) name() string { return "files" }
+
+func (p *fileProvider) fetch(ctx context.Context, keys []string) (map[string]string, error) {
+	vals := make(map[string]string, len(keys))
+	for _, key := range keys {
+		data, err := os.ReadFile(filepath.Join(p.dir, key))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("read secret %s: %v", key, err)
+		}
+		vals[key] = string(data)
+	}
+	return vals, nil
+}
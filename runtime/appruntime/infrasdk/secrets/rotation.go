@@ -0,0 +1,154 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"encore.dev/secret"
+)
+
+// defaultRefreshInterval is how often watched secrets are refreshed
+// if the runtime config does not specify an interval.
+const defaultRefreshInterval = 5 * time.Minute
+
+// Watch loads a secret that is kept up to date when the secret is rotated.
+//
+// Secrets resolved from a secrets provider are periodically re-fetched
+// in the background. Secrets provided by Encore never change.
+func (mgr *Manager) Watch(key string) secret.Source {
+	mgr.mu.Lock()
+	w, ok := mgr.watched[key]
+	mgr.mu.Unlock()
+	if ok {
+		return w
+	}
+
+	val := mgr.Load(key)
+
+	mgr.mu.Lock()
+	if w, ok = mgr.watched[key]; !ok {
+		w = &watchedSecret{val: val, listeners: make(map[uint64]func(string))}
+		mgr.watched[key] = w
+	}
+	fixed := mgr.fixed[key]
+	mgr.mu.Unlock()
+
+	if !fixed && len(mgr.providers) > 0 {
+		mgr.refresh.Do(func() {
+			interval := mgr.cfg.SecretsRefreshInterval
+			if interval <= 0 {
+				interval = defaultRefreshInterval
+			}
+			go mgr.refreshLoop(interval)
+		})
+	}
+	return w
+}
+
+func (mgr *Manager) refreshLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		if err := mgr.Refresh(ctx); err != nil {
+			fmt.Fprintln(os.Stderr, "encore: could not refresh secrets:", err)
+		}
+		cancel()
+	}
+}
+
+// Refresh re-fetches the watched secrets from the secrets providers
+// and notifies listeners of the secrets whose values have changed.
+//
+// Secrets that can no longer be found keep their current value.
+func (mgr *Manager) Refresh(ctx context.Context) error {
+	mgr.mu.Lock()
+	keys := make([]string, 0, len(mgr.watched))
+	for key := range mgr.watched {
+		if !mgr.fixed[key] {
+			keys = append(keys, key)
+		}
+	}
+	mgr.mu.Unlock()
+	if len(keys) == 0 {
+		return nil
+	}
+	sort.Strings(keys)
+
+	vals, _, err := mgr.fetch(ctx, keys)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		val, ok := vals[key]
+		if !ok {
+			continue
+		}
+		mgr.mu.Lock()
+		mgr.secrets[key] = val
+		w := mgr.watched[key]
+		mgr.mu.Unlock()
+		w.set(key, val)
+	}
+	return nil
+}
+
+// watchedSecret is a secret value that is updated on refresh.
+// It implements secret.Source.
+type watchedSecret struct {
+	mu        sync.RWMutex
+	val       string
+	nextID    uint64
+	listeners map[uint64]func(string)
+}
+
+func (w *watchedSecret) Get() string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.val
+}
+
+func (w *watchedSecret) OnChange(fn func(string)) (stop func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	id := w.nextID
+	w.nextID++
+	w.listeners[id] = fn
+
+	return func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.listeners, id)
+	}
+}
+
+// set updates the value and notifies the listeners if it changed.
+func (w *watchedSecret) set(key, val string) {
+	w.mu.Lock()
+	if w.val == val {
+		w.mu.Unlock()
+		return
+	}
+	w.val = val
+	listeners := make([]func(string), 0, len(w.listeners))
+	for _, fn := range w.listeners {
+		listeners = append(listeners, fn)
+	}
+	w.mu.Unlock()
+
+	for _, fn := range listeners {
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Fprintf(os.Stderr, "encore: secret %s change listener panicked: %v\n", key, err)
+				}
+			}()
+			fn(val)
+		}()
+	}
+}
@@ -3,15 +3,52 @@
 package secrets
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
 	"encore.dev/appruntime/shared/appconf"
 	"encore.dev/appruntime/shared/encoreenv"
+	"encore.dev/secret"
 )
 
-var singleton = NewManager(
-	appconf.Runtime,
-	encoreenv.Get("ENCORE_APP_SECRETS"),
-)
+var Singleton = newSingleton()
+
+func newSingleton() *Manager {
+	mgr := NewManager(
+		appconf.Runtime,
+		encoreenv.Get("ENCORE_APP_SECRETS"),
+	)
+
+	// Resolve all the secrets used by the application at startup,
+	// so that missing secrets are reported before serving any traffic.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	missing, err := mgr.Resolve(ctx, appconf.Static.SecretKeys)
+
+	// For local development missing secrets are reported when loaded.
+	if appconf.Runtime.EnvCloud != "local" {
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "encore: could not resolve secrets:", err)
+			os.Exit(2)
+		} else if len(missing) > 0 {
+			fmt.Fprintln(os.Stderr, "encore: could not find secrets:", strings.Join(missing, ", "))
+			os.Exit(2)
+		}
+	} else if err != nil {
+		fmt.Fprintln(os.Stderr, "encore: warning: could not resolve secrets:", err)
+	}
+
+	return mgr
+}
 
 func Load(key string) string {
-	return singleton.Load(key)
+	return Singleton.Load(key)
+}
+
+// LoadValue loads a secret that is kept up to date when the secret is rotated.
+func LoadValue[T string | []byte](key string) secret.Value[T] {
+	return secret.NewValue[T](Singleton.Watch(key))
 }
@@ -0,0 +1,124 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+func init() {
+	registerProvider(providerDesc{
+		name:    "vault",
+		matches: func(cfg *config.SecretsProvider) bool { return cfg.Vault != nil },
+		newProvider: func(cfg *config.SecretsProvider) (provider, error) {
+			return newVaultProvider(cfg.Vault)
+		},
+	})
+}
+
+// vaultProvider resolves secrets from a HashiCorp Vault KV version 2 secrets engine.
+type vaultProvider struct {
+	cfg    *config.VaultSecretsProvider
+	client *http.Client
+}
+
+func newVaultProvider(cfg *config.VaultSecretsProvider) (*vaultProvider, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("vault: no address specified")
+	} else if cfg.Path == "" {
+		return nil, errors.New("vault: no secret path specified")
+	}
+	return &vaultProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+func (p *vaultProvider) name() string { return "vault" }
+
+func (p *vaultProvider) token() (string, error) {
+	if p.cfg.Token != "" {
+		return p.cfg.Token, nil
+	} else if p.cfg.TokenFile != "" {
+		data, err := os.ReadFile(p.cfg.TokenFile)
+		if err != nil {
+			return "", fmt.Errorf("read token file: %v", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	} else if tok := os.Getenv("VAULT_TOKEN"); tok != "" {
+		return tok, nil
+	}
+	return "", errors.New("no vault token configured")
+}
+
+func (p *vaultProvider) fetch(ctx context.Context, keys []string) (map[string]string, error) {
+	token, err := p.token()
+	if err != nil {
+		return nil, err
+	}
+
+	mount := p.cfg.MountPath
+	if mount == "" {
+		mount = "secret"
+	}
+	url := fmt.Sprintf("%s/v1/%s/data/%s",
+		strings.TrimSuffix(p.cfg.Address, "/"), strings.Trim(mount, "/"), strings.Trim(p.cfg.Path, "/"))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if p.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.cfg.Namespace)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		// The secret does not exist, so none of the keys are found.
+		return nil, nil
+	} else if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("read %s: got status %d: %s", p.cfg.Path, resp.StatusCode, body)
+	}
+
+	var respData struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&respData); err != nil {
+		return nil, fmt.Errorf("decode response: %v", err)
+	}
+
+	vals := make(map[string]string, len(keys))
+	for _, key := range keys {
+		switch val := respData.Data.Data[key].(type) {
+		case nil:
+			// Not found
+		case string:
+			vals[key] = val
+		default:
+			// Use the JSON representation for non-string values.
+			data, err := json.Marshal(val)
+			if err != nil {
+				return nil, fmt.Errorf("encode secret %s: %v", key, err)
+			}
+			vals[key] = string(data)
+		}
+	}
+	return vals, nil
+}
@@ -25,3 +25,14 @@ func init() {
 		}
 	}
 }
+
+// WithPrefix returns the environment variables whose names start with prefix.
+func WithPrefix(prefix string) map[string]string {
+	m := make(map[string]string)
+	for key, val := range envs {
+		if strings.HasPrefix(key, prefix) {
+			m[key] = val
+		}
+	}
+	return m
+}
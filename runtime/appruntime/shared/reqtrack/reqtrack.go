@@ -58,6 +58,7 @@ func copyReqInfoFromParent(next, prev *model2.Request) {
 	if prevData, nextData := prev.RPCData, next.RPCData; prevData != nil && nextData != nil {
 		if nextData.UserID == "" {
 			nextData.UserID = prevData.UserID
+			nextData.AuthScheme = prevData.AuthScheme
 		}
 		if nextData.AuthData == nil {
 			nextData.AuthData = prevData.AuthData
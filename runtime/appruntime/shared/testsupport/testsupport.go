@@ -7,6 +7,7 @@ import (
 	"runtime/debug"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 	_ "unsafe" // for go:linkname
@@ -26,8 +27,26 @@ type Manager struct {
 	wd              string
 	testServiceOnce sync.Once
 	testService     string
+
+	dbIsolation atomic.Int32 // the DBIsolation mode
 }
 
+// DBIsolation describes how tests are isolated from each other's database changes.
+type DBIsolation int32
+
+const (
+	// SharedDB shares each database between all tests. This is the default.
+	SharedDB DBIsolation = iota
+
+	// RollbackDB runs the queries of each test in a transaction
+	// that is rolled back when the test ends.
+	RollbackDB
+
+	// CloneDB gives each test a fresh database,
+	// cloned from a template database with the migrated schema.
+	CloneDB
+)
+
 func NewManager(static *config.Static, rt *reqtrack.RequestTracker, rootLogger zerolog.Logger) *Manager {
 	wd, _ := os.Getwd()
 	return &Manager{static: static, rt: rt, rootLogger: rootLogger, wd: wd}
@@ -130,6 +149,17 @@ func (mgr *Manager) EndTest(t *testing.T) {
 	mgr.rt.FinishRequest()
 }
 
+// SetDBIsolation sets how tests are isolated from each other's database changes.
+// Each test's isolated database is set up the first time the test uses the database.
+func (mgr *Manager) SetDBIsolation(mode DBIsolation) {
+	mgr.dbIsolation.Store(int32(mode))
+}
+
+// DBIsolation reports how tests are isolated from each other's database changes.
+func (mgr *Manager) DBIsolation() DBIsolation {
+	return DBIsolation(mgr.dbIsolation.Load())
+}
+
 // CurrentTest returns the currently running test.
 // If no test is running, it panics.
 func (mgr *Manager) CurrentTest() *testing.T {
@@ -48,6 +48,13 @@ func (mgr *Manager) Data() interface{} {
 	return nil
 }
 
+func (mgr *Manager) Scheme() string {
+	if curr := mgr.rt.Current(); curr.Req != nil && curr.Req.RPCData != nil {
+		return curr.Req.RPCData.AuthScheme
+	}
+	return ""
+}
+
 // WithContext returns a new context that sets the auth information for outgoing API calls.
 // It does not affect the auth information for the current request.
 //
@@ -0,0 +1,345 @@
+// Package jwtauth implements auth handlers that authenticate requests
+// using JSON Web Tokens (JWTs), such as the ID and access tokens issued
+// by OpenID Connect providers.
+//
+// A Validator verifies the signature and standard claims of a token
+// and maps its claims to the user id and auth data of the request.
+// It's meant to be called from the application's auth handler:
+//
+//	type Data struct {
+//		Email string `json:"email"`
+//	}
+//
+//	var validator = jwtauth.New(jwtauth.Config[Data]{
+//		Issuer:   "https://example.auth0.com/",
+//		Audience: []string{"https://api.example.com"},
+//	})
+//
+//	//encore:authhandler
+//	func AuthHandler(ctx context.Context, token string) (auth.UID, *Data, error) {
+//		return validator.Authenticate(ctx, token)
+//	}
+//
+// Use the jwttest package to mint tokens signed with a local key in tests.
+//
+// For more information about how authentication works with Encore applications see https://encore.dev/docs/develop/auth.
+package jwtauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"encore.dev/beta/auth"
+	"encore.dev/beta/errs"
+)
+
+// Config configures a Validator.
+//
+// The keys to verify tokens with are given by Keys, if set,
+// or otherwise fetched from JWKSURL or the issuer's OpenID Connect discovery document.
+type Config[Data any] struct {
+	// Issuer is the expected value of the "iss" claim.
+	// Unless Keys or JWKSURL are set, the signing keys are discovered through
+	// the OpenID Connect discovery document at {Issuer}/.well-known/openid-configuration.
+	Issuer string
+
+	// Audience lists the accepted values of the "aud" claim.
+	// If set, tokens must include at least one of them in their audience.
+	Audience []string
+
+	// JWKSURL is the URL of the JSON Web Key Set containing the signing keys,
+	// for issuers that don't support OpenID Connect discovery.
+	JWKSURL string
+
+	// Keys are static keys to verify tokens with, keyed by key id ("kid").
+	// The values must be of type *rsa.PublicKey, *ecdsa.PublicKey,
+	// ed25519.PublicKey, or []byte for HMAC secrets.
+	// Tokens without a key id can only be verified if there's a single key.
+	Keys map[string]any
+
+	// Algorithms are the accepted signing algorithms.
+	// By default all RSA, RSA-PSS, ECDSA and EdDSA algorithms are accepted,
+	// and HMAC algorithms are accepted when Keys contains HMAC secrets.
+	Algorithms []string
+
+	// Leeway is the clock skew to allow for when checking
+	// the "exp", "nbf" and "iat" claims. It defaults to one minute.
+	Leeway time.Duration
+
+	// CacheTTL is how long fetched signing keys are cached before
+	// they're fetched again. It defaults to one hour.
+	// Tokens signed with unknown keys trigger a refresh regardless,
+	// to handle key rotation.
+	CacheTTL time.Duration
+
+	// HTTPClient is the client used to fetch the signing keys.
+	// It defaults to a client with a 10 second timeout.
+	HTTPClient *http.Client
+
+	// UIDClaim is the claim containing the user id. It defaults to "sub".
+	UIDClaim string
+
+	// MapClaims maps the claims of a verified token to the user id and auth data.
+	// By default the user id is taken from UIDClaim and the auth data
+	// is decoded from the claims using encoding/json.
+	MapClaims func(ctx context.Context, claims *Claims) (auth.UID, *Data, error)
+}
+
+const (
+	defaultLeeway   = time.Minute
+	defaultCacheTTL = time.Hour
+)
+
+// Validator validates JWTs and maps their claims to auth data of type Data.
+// It's safe for concurrent use.
+type Validator[Data any] struct {
+	cfg    Config[Data]
+	parser *jwt.Parser
+	jwks   *keySet // nil if cfg.Keys is set
+	now    func() time.Time
+}
+
+// New returns a new Validator with the given configuration.
+// It panics if the configuration is invalid.
+func New[Data any](cfg Config[Data]) *Validator[Data] {
+	if cfg.Issuer == "" && cfg.JWKSURL == "" && len(cfg.Keys) == 0 {
+		panic("jwtauth: one of Issuer, JWKSURL or Keys must be set")
+	}
+
+	hasSecrets := false
+	for kid, key := range cfg.Keys {
+		switch key.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		case []byte:
+			hasSecrets = true
+		default:
+			panic(fmt.Sprintf("jwtauth: unsupported key type %T for key %q", key, kid))
+		}
+	}
+
+	if cfg.Leeway == 0 {
+		cfg.Leeway = defaultLeeway
+	}
+	if cfg.CacheTTL == 0 {
+		cfg.CacheTTL = defaultCacheTTL
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if cfg.UIDClaim == "" {
+		cfg.UIDClaim = "sub"
+	}
+
+	algs := cfg.Algorithms
+	if len(algs) == 0 {
+		algs = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+		if hasSecrets {
+			algs = append(algs, "HS256", "HS384", "HS512")
+		}
+	}
+
+	v := &Validator[Data]{
+		cfg:    cfg,
+		parser: jwt.NewParser(jwt.WithValidMethods(algs), jwt.WithJSONNumber(), jwt.WithoutClaimsValidation()),
+		now:    time.Now,
+	}
+	if len(cfg.Keys) == 0 {
+		v.jwks = &keySet{
+			client:  cfg.HTTPClient,
+			issuer:  cfg.Issuer,
+			jwksURL: cfg.JWKSURL,
+			ttl:     cfg.CacheTTL,
+			now:     func() time.Time { return v.now() },
+		}
+	}
+	return v
+}
+
+// Authenticate verifies the token and maps its claims to the user id and auth data.
+// It has the signature of an auth handler, so it can be called directly from one.
+//
+// Invalid tokens are reported with the errs.Unauthenticated error code.
+func (v *Validator[Data]) Authenticate(ctx context.Context, token string) (auth.UID, *Data, error) {
+	claims, err := v.Verify(ctx, token)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if v.cfg.MapClaims != nil {
+		return v.cfg.MapClaims(ctx, claims)
+	}
+
+	var fields map[string]any
+	if err := claims.Decode(&fields); err != nil {
+		return "", nil, unauthenticated("invalid token claims", err)
+	}
+	uid, ok := fields[v.cfg.UIDClaim].(string)
+	if !ok || uid == "" {
+		return "", nil, unauthenticated(fmt.Sprintf("token has no %q claim", v.cfg.UIDClaim), nil)
+	}
+
+	var data Data
+	if err := claims.Decode(&data); err != nil {
+		return "", nil, unauthenticated("invalid token claims", err)
+	}
+	return auth.UID(uid), &data, nil
+}
+
+// Verify verifies the token's signature and standard claims,
+// and returns its claims.
+//
+// Invalid tokens are reported with the errs.Unauthenticated error code.
+func (v *Validator[Data]) Verify(ctx context.Context, token string) (*Claims, error) {
+	token = strings.TrimSpace(token)
+
+	// keyErr tracks errors looking up the signing key,
+	// since the parser doesn't preserve them.
+	var keyErr error
+	tok, err := v.parser.Parse(token, func(tok *jwt.Token) (any, error) {
+		kid, _ := tok.Header["kid"].(string)
+		key, err := v.key(ctx, kid)
+		keyErr = err
+		return key, err
+	})
+	if keyErr != nil {
+		return nil, keyErr
+	} else if err != nil {
+		return nil, unauthenticated("invalid token", err)
+	}
+
+	payload, err := jwt.DecodeSegment(strings.Split(tok.Raw, ".")[1])
+	if err != nil {
+		return nil, unauthenticated("invalid token", err)
+	}
+	claims, err := parseClaims(payload)
+	if err != nil {
+		return nil, unauthenticated("invalid token claims", err)
+	}
+	if err := v.validate(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// validate validates the standard claims.
+func (v *Validator[Data]) validate(c *Claims) error {
+	now := v.now()
+	leeway := v.cfg.Leeway
+
+	if v.cfg.Issuer != "" && c.Issuer != v.cfg.Issuer {
+		return unauthenticated("invalid token issuer", nil)
+	}
+
+	if len(v.cfg.Audience) > 0 {
+		found := false
+	audLoop:
+		for _, aud := range c.Audience {
+			for _, want := range v.cfg.Audience {
+				if aud == want {
+					found = true
+					break audLoop
+				}
+			}
+		}
+		if !found {
+			return unauthenticated("invalid token audience", nil)
+		}
+	}
+
+	switch {
+	case c.ExpiresAt.IsZero():
+		return unauthenticated("token has no expiry", nil)
+	case now.After(c.ExpiresAt.Add(leeway)):
+		return unauthenticated("token expired", nil)
+	case !c.NotBefore.IsZero() && now.Add(leeway).Before(c.NotBefore):
+		return unauthenticated("token not yet valid", nil)
+	case !c.IssuedAt.IsZero() && now.Add(leeway).Before(c.IssuedAt):
+		return unauthenticated("token issued in the future", nil)
+	}
+	return nil
+}
+
+// key returns the key to verify a token signed with the key kid with.
+func (v *Validator[Data]) key(ctx context.Context, kid string) (any, error) {
+	if v.jwks != nil {
+		return v.jwks.key(ctx, kid)
+	}
+	if key, ok := lookupKey(v.cfg.Keys, kid); ok {
+		return key, nil
+	}
+	return nil, unknownKeyErr()
+}
+
+// lookupKey looks up the key with the given id.
+// Tokens without a key id match the only key, if there's just one.
+func lookupKey(keys map[string]any, kid string) (any, bool) {
+	if key, ok := keys[kid]; ok {
+		return key, true
+	} else if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+func unknownKeyErr() error {
+	return unauthenticated("token signed with unknown key", nil)
+}
+
+func unauthenticated(msg string, cause error) error {
+	return errs.B().Code(errs.Unauthenticated).Msg(msg).Cause(cause).Err()
+}
+
+// Claims are the claims of a verified token.
+type Claims struct {
+	Issuer    string    // the "iss" claim
+	Subject   string    // the "sub" claim
+	Audience  []string  // the "aud" claim
+	ExpiresAt time.Time // the "exp" claim
+	NotBefore time.Time // the "nbf" claim, or the zero time if not set
+	IssuedAt  time.Time // the "iat" claim, or the zero time if not set
+	ID        string    // the "jti" claim
+
+	// Raw is the JSON-encoded claims set of the token.
+	Raw json.RawMessage
+}
+
+// Decode decodes the claims set into dst using encoding/json.
+func (c *Claims) Decode(dst any) error {
+	return json.Unmarshal(c.Raw, dst)
+}
+
+func parseClaims(payload []byte) (*Claims, error) {
+	var reg jwt.RegisteredClaims
+	if err := json.Unmarshal(payload, &reg); err != nil {
+		return nil, err
+	}
+
+	c := &Claims{
+		Issuer:   reg.Issuer,
+		Subject:  reg.Subject,
+		Audience: reg.Audience,
+		ID:       reg.ID,
+		Raw:      payload,
+	}
+	if reg.ExpiresAt != nil {
+		c.ExpiresAt = reg.ExpiresAt.Time
+	}
+	if reg.NotBefore != nil {
+		c.NotBefore = reg.NotBefore.Time
+	}
+	if reg.IssuedAt != nil {
+		c.IssuedAt = reg.IssuedAt.Time
+	}
+	return c, nil
+}
@@ -0,0 +1,298 @@
+package jwtauth
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"encore.dev/beta/auth"
+	"encore.dev/beta/auth/jwtauth/jwttest"
+	"encore.dev/beta/errs"
+)
+
+type testData struct {
+	Email string   `json:"email"`
+	Roles []string `json:"roles"`
+}
+
+func TestValidator_Discovery(t *testing.T) {
+	ctx := context.Background()
+	iss := jwttest.NewIssuer(t)
+	v := New(Config[testData]{Issuer: iss.URL, Audience: []string{"api"}})
+
+	token := iss.Mint(map[string]any{
+		"sub":   "user-1",
+		"aud":   "api",
+		"email": "jane@example.com",
+		"roles": []string{"admin"},
+	})
+	uid, data, err := v.Authenticate(ctx, token)
+	if err != nil {
+		t.Fatal(err)
+	} else if uid != "user-1" {
+		t.Errorf("got uid %q, want %q", uid, "user-1")
+	} else if data.Email != "jane@example.com" || len(data.Roles) != 1 || data.Roles[0] != "admin" {
+		t.Errorf("got data %+v", data)
+	}
+}
+
+func TestValidator_InvalidTokens(t *testing.T) {
+	ctx := context.Background()
+	iss := jwttest.NewIssuer(t)
+	other := jwttest.NewIssuer(t)
+	v := New(Config[testData]{Issuer: iss.URL, Audience: []string{"api"}})
+
+	now := time.Now()
+	tests := []struct {
+		name  string
+		token string
+		msg   string
+	}{
+		{
+			name:  "expired",
+			token: iss.Mint(map[string]any{"sub": "u", "aud": "api", "exp": now.Add(-time.Hour)}),
+			msg:   "token expired",
+		},
+		{
+			name:  "not_yet_valid",
+			token: iss.Mint(map[string]any{"sub": "u", "aud": "api", "nbf": now.Add(time.Hour)}),
+			msg:   "token not yet valid",
+		},
+		{
+			name:  "wrong_audience",
+			token: iss.Mint(map[string]any{"sub": "u", "aud": []string{"other", "another"}}),
+			msg:   "invalid token audience",
+		},
+		{
+			name:  "wrong_issuer",
+			token: iss.Mint(map[string]any{"sub": "u", "aud": "api", "iss": "https://evil.example.com"}),
+			msg:   "invalid token issuer",
+		},
+		{
+			name:  "no_subject",
+			token: iss.Mint(map[string]any{"aud": "api"}),
+			msg:   `token has no "sub" claim`,
+		},
+		{
+			name:  "other_issuer_key",
+			token: other.Mint(map[string]any{"sub": "u", "aud": "api", "iss": iss.URL}),
+			msg:   "invalid token",
+		},
+		{
+			name:  "unsigned",
+			token: unsignedToken(t, iss.URL),
+			msg:   "invalid token",
+		},
+		{
+			name:  "malformed",
+			token: "not-a-token",
+			msg:   "invalid token",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := v.Authenticate(ctx, test.token)
+			if code := errs.Code(err); code != errs.Unauthenticated {
+				t.Fatalf("got code %v for %v, want %v", code, err, errs.Unauthenticated)
+			} else if msg := err.(*errs.Error).Message; msg != test.msg {
+				t.Fatalf("got message %q, want %q", msg, test.msg)
+			}
+		})
+	}
+}
+
+func TestValidator_KeyRotation(t *testing.T) {
+	ctx := context.Background()
+	iss := jwttest.NewIssuer(t)
+	v := New(Config[testData]{Issuer: iss.URL})
+	now := time.Now()
+	v.now = func() time.Time { return now }
+
+	if _, _, err := v.Authenticate(ctx, iss.Mint(map[string]any{"sub": "u"})); err != nil {
+		t.Fatal(err)
+	}
+
+	// Tokens signed with a new key are rejected until the keys can be refreshed.
+	iss.Rotate()
+	token := iss.Mint(map[string]any{"sub": "u"})
+	if _, _, err := v.Authenticate(ctx, token); errs.Code(err) != errs.Unauthenticated {
+		t.Fatalf("got err %v, want unknown key", err)
+	}
+
+	now = now.Add(minRefreshInterval)
+	if _, _, err := v.Authenticate(ctx, token); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKeySet_RefreshUnlocked(t *testing.T) {
+	ctx := context.Background()
+	iss := jwttest.NewIssuer(t)
+
+	// Serve the issuer's key set, blocking fetches until unblocked.
+	var fetches int32
+	fetching := make(chan struct{}, 10)
+	unblock := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		fetching <- struct{}{}
+		<-unblock
+		resp, err := http.Get(iss.URL + "/jwks.json")
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer func() { _ = resp.Body.Close() }()
+		_, _ = io.Copy(w, resp.Body)
+	}))
+	defer srv.Close()
+
+	now := time.Now()
+	var nowMu sync.Mutex
+	ks := &keySet{
+		client:  srv.Client(),
+		issuer:  iss.URL,
+		jwksURL: srv.URL,
+		ttl:     time.Hour,
+		now: func() time.Time {
+			nowMu.Lock()
+			defer nowMu.Unlock()
+			return now
+		},
+	}
+	var oldKid string
+	for kid := range iss.Keys() {
+		oldKid = kid
+	}
+	ks.keys = iss.Keys()
+	ks.fetchedAt = now
+
+	// Stale keys are used while refreshing in the background.
+	nowMu.Lock()
+	now = now.Add(2 * time.Hour)
+	nowMu.Unlock()
+	if _, err := ks.key(ctx, oldKid); err != nil {
+		t.Fatal(err)
+	}
+	<-fetching
+
+	// Calls for unknown keys wait for the in-flight refresh, instead of starting their own.
+	iss.Rotate()
+	var newKid string
+	for kid := range iss.Keys() {
+		if kid != oldKid {
+			newKid = kid
+		}
+	}
+	var wg sync.WaitGroup
+	errCh := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := ks.key(ctx, newKid)
+			errCh <- err
+		}()
+	}
+
+	// The lock is not held during the refresh.
+	if _, err := ks.key(ctx, oldKid); err != nil {
+		t.Fatal(err)
+	}
+
+	close(unblock)
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := atomic.LoadInt32(&fetches); n != 1 {
+		t.Fatalf("got %d fetches, want 1", n)
+	}
+}
+
+func TestValidator_StaticKeys(t *testing.T) {
+	ctx := context.Background()
+	iss := jwttest.NewIssuer(t)
+	v := New(Config[testData]{Keys: iss.Keys()})
+	if _, _, err := v.Authenticate(ctx, iss.Mint(map[string]any{"sub": "u"})); err != nil {
+		t.Fatal(err)
+	}
+
+	// HMAC secrets are supported, and used for tokens without a key id.
+	secret := []byte("secret")
+	v = New(Config[testData]{Keys: map[string]any{"": secret}})
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "u",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := v.Authenticate(ctx, token); err != nil {
+		t.Fatal(err)
+	}
+
+	// HMAC tokens are rejected when verifying with public keys.
+	v = New(Config[testData]{Keys: iss.Keys()})
+	if _, _, err := v.Authenticate(ctx, token); errs.Code(err) != errs.Unauthenticated {
+		t.Fatalf("got err %v, want Unauthenticated", err)
+	}
+}
+
+func TestValidator_MapClaims(t *testing.T) {
+	ctx := context.Background()
+	iss := jwttest.NewIssuer(t)
+	v := New(Config[testData]{
+		Issuer:   iss.URL,
+		UIDClaim: "uid",
+		MapClaims: func(ctx context.Context, claims *Claims) (auth.UID, *testData, error) {
+			var c struct {
+				Email string `json:"mail"`
+			}
+			if err := claims.Decode(&c); err != nil {
+				return "", nil, err
+			}
+			return auth.UID("custom:" + claims.Subject), &testData{Email: c.Email}, nil
+		},
+	})
+
+	uid, data, err := v.Authenticate(ctx, iss.Mint(map[string]any{"sub": "u", "mail": "a@b.c"}))
+	if err != nil {
+		t.Fatal(err)
+	} else if uid != "custom:u" || data.Email != "a@b.c" {
+		t.Fatalf("got uid %q and data %+v", uid, data)
+	}
+}
+
+func TestValidator_UnavailableIssuer(t *testing.T) {
+	iss := jwttest.NewIssuer(t)
+	token := iss.Mint(map[string]any{"sub": "u"})
+
+	v := New(Config[testData]{Issuer: iss.URL + "/missing"})
+	if _, _, err := v.Authenticate(context.Background(), token); errs.Code(err) != errs.Unavailable {
+		t.Fatalf("got err %v, want Unavailable", err)
+	}
+}
+
+func unsignedToken(t *testing.T, issuer string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+		"iss": issuer,
+		"sub": "u",
+		"aud": "api",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
@@ -0,0 +1,155 @@
+// Package jwttest provides helpers for testing code that authenticates
+// requests with the jwtauth package, by minting tokens signed with local keys.
+package jwttest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Issuer is a local token issuer for use in tests.
+//
+// It serves an OpenID Connect discovery document and a JSON Web Key Set
+// on a local HTTP server, so a jwtauth.Validator configured with
+// Issuer: issuer.URL verifies the tokens it mints without network access.
+// Alternatively use Keys to configure the validator with static keys.
+type Issuer struct {
+	// URL is the issuer URL, which is also the "iss" claim of minted tokens.
+	URL string
+
+	t   testing.TB
+	srv *httptest.Server
+
+	mu   sync.Mutex
+	keys []*signingKey // all keys, the last one is used for signing
+}
+
+type signingKey struct {
+	id  string
+	key *ecdsa.PrivateKey
+}
+
+// NewIssuer creates a new Issuer with a freshly generated signing key.
+// The issuer's server is closed when the test completes.
+func NewIssuer(t testing.TB) *Issuer {
+	t.Helper()
+	i := &Issuer{t: t}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, req *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":   i.URL,
+			"jwks_uri": i.URL + "/jwks.json",
+		})
+	})
+	mux.HandleFunc("/jwks.json", func(w http.ResponseWriter, req *http.Request) {
+		writeJSON(w, map[string]any{"keys": i.jwks()})
+	})
+	i.srv = httptest.NewServer(mux)
+	i.URL = i.srv.URL
+	t.Cleanup(i.srv.Close)
+
+	i.Rotate()
+	return i
+}
+
+// Rotate generates a new signing key to sign tokens with from now on.
+// Previous keys are still published, so previously minted tokens remain valid.
+func (i *Issuer) Rotate() {
+	i.t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		i.t.Fatalf("jwttest: generate key: %v", err)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.keys = append(i.keys, &signingKey{
+		id:  "key-" + strconv.Itoa(len(i.keys)+1),
+		key: key,
+	})
+}
+
+// Keys returns the issuer's public keys, keyed by key id,
+// for use as static keys in a jwtauth.Config.
+func (i *Issuer) Keys() map[string]any {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	keys := make(map[string]any, len(i.keys))
+	for _, k := range i.keys {
+		keys[k.id] = &k.key.PublicKey
+	}
+	return keys
+}
+
+// Mint returns a token with the given claims, signed with the current signing key.
+//
+// The "iss", "iat" and "exp" claims default to the issuer URL,
+// the current time and one hour from now, respectively.
+// Claims with time.Time values are encoded as numeric dates.
+func (i *Issuer) Mint(claims map[string]any) string {
+	i.t.Helper()
+
+	now := time.Now()
+	mc := jwt.MapClaims{
+		"iss": i.URL,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		if t, ok := v.(time.Time); ok {
+			v = t.Unix()
+		}
+		mc[k] = v
+	}
+
+	i.mu.Lock()
+	sk := i.keys[len(i.keys)-1]
+	i.mu.Unlock()
+
+	tok := jwt.NewWithClaims(jwt.SigningMethodES256, mc)
+	tok.Header["kid"] = sk.id
+	signed, err := tok.SignedString(sk.key)
+	if err != nil {
+		i.t.Fatalf("jwttest: sign token: %v", err)
+	}
+	return signed
+}
+
+// jwks returns the issuer's keys in JSON Web Key format.
+func (i *Issuer) jwks() []map[string]string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	enc := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	var keys []map[string]string
+	for _, k := range i.keys {
+		pub := k.key.PublicKey
+		keys = append(keys, map[string]string{
+			"kty": "EC",
+			"kid": k.id,
+			"use": "sig",
+			"alg": "ES256",
+			"crv": "P-256",
+			"x":   enc(pub.X.FillBytes(make([]byte, 32))),
+			"y":   enc(pub.Y.FillBytes(make([]byte, 32))),
+		})
+	}
+	return keys
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,278 @@
+package jwtauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"encore.dev/beta/errs"
+)
+
+// minRefreshInterval is the minimum time between fetching the signing keys,
+// to avoid tokens signed with unknown keys causing excessive requests.
+const minRefreshInterval = time.Minute
+
+// refreshTimeout bounds fetching the signing keys. Refreshes aren't tied
+// to the request that started them, since other requests may be waiting for them.
+const refreshTimeout = 30 * time.Second
+
+// keySet is a cached JSON Web Key Set fetched over HTTP.
+type keySet struct {
+	client *http.Client
+	issuer string
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu          sync.Mutex
+	jwksURL     string         // discovered from the issuer if empty
+	keys        map[string]any // nil until fetched
+	fetchedAt   time.Time      // when keys were fetched
+	attemptedAt time.Time      // when fetching keys was last attempted
+	fetchErr    error          // the error of the last attempt, if any
+	refreshing  chan struct{}  // closed when the in-flight refresh completes; nil if none
+}
+
+// key returns the key with the given id, fetching the key set if necessary.
+//
+// The key set is refreshed when the cached keys are older than the TTL,
+// or when the key is not found in them since the keys may have been rotated.
+// Keys are fetched without holding ks.mu, and concurrent calls share a single fetch.
+// While refreshing, the previously fetched keys are used, and calls only wait
+// for the refresh when the key is not among them.
+// If refreshing fails, the previously fetched keys are used.
+func (ks *keySet) key(ctx context.Context, kid string) (any, error) {
+	ks.mu.Lock()
+	now := ks.now()
+	stale := now.Sub(ks.fetchedAt) >= ks.ttl
+	key, found := lookupKey(ks.keys, kid)
+	if found && !stale {
+		ks.mu.Unlock()
+		return key, nil
+	}
+
+	done := ks.refreshing
+	if done == nil && (ks.attemptedAt.IsZero() || now.Sub(ks.attemptedAt) >= minRefreshInterval) {
+		ks.attemptedAt = now
+		done = make(chan struct{})
+		ks.refreshing = done
+		go ks.refresh(done)
+	}
+	ks.mu.Unlock()
+
+	if found {
+		// Keep using the stale key while refreshing.
+		return key, nil
+	} else if done != nil {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return nil, errs.B().Code(errs.Unavailable).Msg("unable to fetch token signing keys").Cause(ctx.Err()).Err()
+		}
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	if key, ok := lookupKey(ks.keys, kid); ok {
+		return key, nil
+	} else if ks.fetchErr != nil {
+		return nil, errs.B().Code(errs.Unavailable).Msg("unable to fetch token signing keys").Cause(ks.fetchErr).Err()
+	}
+	return nil, unknownKeyErr()
+}
+
+// refresh fetches the key set and stores the result, closing done when finished.
+// It must be called without holding ks.mu.
+func (ks *keySet) refresh(done chan struct{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+	defer cancel()
+
+	ks.mu.Lock()
+	jwksURL := ks.jwksURL
+	ks.mu.Unlock()
+
+	keys, jwksURL, err := ks.fetch(ctx, jwksURL)
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.fetchErr = err
+	if jwksURL != "" {
+		ks.jwksURL = jwksURL
+	}
+	if err == nil {
+		ks.keys = keys
+		ks.fetchedAt = ks.now()
+	}
+	ks.refreshing = nil
+	close(done)
+}
+
+// fetch fetches the key set from jwksURL, discovering it from the issuer if empty.
+// It returns the keys and the URL they were fetched from.
+func (ks *keySet) fetch(ctx context.Context, jwksURL string) (map[string]any, string, error) {
+	if jwksURL == "" {
+		url, err := ks.discover(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		jwksURL = url
+	}
+
+	var set struct {
+		Keys []*jsonWebKey `json:"keys"`
+	}
+	if err := ks.getJSON(ctx, jwksURL, &set); err != nil {
+		return nil, jwksURL, err
+	}
+
+	keys := make(map[string]any, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		// Skip unsupported keys, so they don't prevent using the rest.
+		if key, err := k.publicKey(); err == nil {
+			keys[k.Kid] = key
+		}
+	}
+	if len(keys) == 0 {
+		return nil, jwksURL, fmt.Errorf("no usable signing keys in key set %s", jwksURL)
+	}
+	return keys, jwksURL, nil
+}
+
+// discover fetches the issuer's OpenID Connect discovery document
+// and returns the URL of its key set.
+func (ks *keySet) discover(ctx context.Context) (jwksURL string, err error) {
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	url := strings.TrimSuffix(ks.issuer, "/") + "/.well-known/openid-configuration"
+	if err := ks.getJSON(ctx, url, &doc); err != nil {
+		return "", err
+	}
+
+	if doc.Issuer != ks.issuer {
+		return "", fmt.Errorf("discovery document issuer %q does not match issuer %q", doc.Issuer, ks.issuer)
+	} else if doc.JWKSURI == "" {
+		return "", fmt.Errorf("discovery document for issuer %q has no jwks_uri", ks.issuer)
+	}
+	return doc.JWKSURI, nil
+}
+
+func (ks *keySet) getJSON(ctx context.Context, url string, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := ks.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(dst); err != nil {
+		return fmt.Errorf("GET %s: invalid response: %v", url, err)
+	}
+	return nil
+}
+
+// jsonWebKey is a public key in JSON Web Key format, as defined by RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC and OKP keys
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k *jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		} else if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("invalid EC key")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		} else if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	} else if len(b) == 0 {
+		return nil, errors.New("missing key parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
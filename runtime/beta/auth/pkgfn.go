@@ -25,3 +25,11 @@ func UserID() (UID, bool) {
 func Data() any {
 	return Singleton.Data()
 }
+
+// Scheme reports the name of the auth scheme whose auth handler authenticated the request,
+// as given by "//encore:authhandler scheme=name".
+// It returns "" if the request was made without authentication details,
+// or the auth handler has no scheme name.
+func Scheme() string {
+	return Singleton.Scheme()
+}
@@ -175,6 +175,22 @@ func (c ErrCode) MarshalJSON() ([]byte, error) {
 	return []byte("\"" + s + "\""), nil
 }
 
+//publicapigen:keep
+func (c *ErrCode) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	for code, name := range codeNames {
+		if name == s {
+			*c = ErrCode(code)
+			return nil
+		}
+	}
+	*c = Unknown
+	return nil
+}
+
 //publicapigen:keep
 var codeNames = [...]string{
 	OK:                 "ok",
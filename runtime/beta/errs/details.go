@@ -1,5 +1,7 @@
 package errs
 
+import "reflect"
+
 // ErrDetails is a marker interface for telling Encore
 // the type is used for reporting error details.
 //
@@ -9,3 +11,22 @@ package errs
 type ErrDetails interface {
 	ErrDetails() // marker method; it need not do anything
 }
+
+// detailsType reports the name identifying the type of det on the wire,
+// in the form "pkgname.TypeName". Generated clients use it to decode
+// the details into the corresponding type.
+//
+// It reports "" if det is nil or not a named type.
+func detailsType(det ErrDetails) string {
+	if det == nil {
+		return ""
+	}
+	t := reflect.TypeOf(det)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Name() == "" {
+		return ""
+	}
+	return t.String()
+}
@@ -227,6 +227,11 @@ func init() {
 		stream.WriteMore()
 		stream.WriteObjectField("details")
 		stream.WriteVal(e.Details)
+		if typ := detailsType(e.Details); typ != "" {
+			stream.WriteMore()
+			stream.WriteObjectField("details_type")
+			stream.WriteString(typ)
+		}
 		stream.WriteObjectEnd()
 	}, nil)
 
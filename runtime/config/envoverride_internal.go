@@ -0,0 +1,18 @@
+package config
+
+import (
+	"encore.dev/appruntime/shared/encoreenv"
+	"encore.dev/config/internal/override"
+)
+
+// applyEnvOverrides applies the config overrides for the given service set through
+// environment variables, returning the updated config.
+//
+// A value at the path "db.host" of the service "users" is overridden by the
+// environment variable ENCORE_CFG_USERS__DB__HOST. Path segments are matched
+// against the field names case-insensitively, and list elements are referenced
+// by their index. String values are used as-is; all other values are parsed as JSON.
+func applyEnvOverrides(serviceName string, cfg []byte) ([]byte, error) {
+	prefix := envName(serviceName) + override.Sep
+	return override.Apply(cfg, prefix, encoreenv.WithPrefix(prefix))
+}
@@ -21,18 +21,20 @@ func envName(serviceName string) string {
 // CreateValue creates a new Value on the given path with the given value
 func CreateValue[T any](value T, pathToValue ValuePath) Value[T] {
 	valueID := Singleton.nextID()
+	live := registerLiveValue(valueID, pathToValue, value)
 	return func() T {
 		Singleton.valueMeta(valueID, pathToValue)
-		return testOverrideOrValue(valueID, value)
+		return testOverrideOrValue(valueID, live.get())
 	}
 }
 
 // CreateValueList creates a new Value Slice on the given path with the given values
 func CreateValueList[T any](value []T, pathToValue ValuePath) Values[T] {
 	valueID := Singleton.nextID()
+	live := registerLiveValue(valueID, pathToValue, value)
 	return func() []T {
 		Singleton.valueMeta(valueID, pathToValue)
-		return testOverrideOrValue(valueID, value)
+		return testOverrideOrValue(valueID, live.get())
 	}
 }
 
@@ -47,7 +49,7 @@ func GetMetaForValue[T any](value func() T) (ValueID, ValuePath) {
 
 	// Scope the extraction to the current goroutine
 	Singleton.extraction.scopeMutex.Lock()
-	Singleton.extraction.forSpan = req.Req.SpanID
+	Singleton.extraction.forSpan = spanID(req)
 	Singleton.extraction.forGoRoutine = req.Goctr
 	Singleton.extraction.scopeMutex.Unlock()
 
@@ -0,0 +1,170 @@
+// Package live fetches live updates to config values from a watched file
+// or a config service.
+//
+// Both sources serve a JSON document keyed by service name, where each
+// service's object has the same structure as the service's config:
+//
+//	{"users": {"SignupsEnabled": false, "Limits": {"MaxRequests": 100}}}
+//
+// Only the values present in the document are updated.
+package live
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+// Source is a source of live config documents.
+type Source interface {
+	// Name describes the source, for logging.
+	Name() string
+
+	// Fetch fetches the current document from the source.
+	// It reports changed=false if the document is unchanged since the last fetch.
+	Fetch(ctx context.Context) (data []byte, changed bool, err error)
+}
+
+// NewSource returns the source described by cfg.
+func NewSource(cfg *config.LiveConfig) (Source, error) {
+	switch {
+	case cfg.Path != "" && cfg.URL != "":
+		return nil, fmt.Errorf("live config: only one of path and url may be set")
+	case cfg.Path != "":
+		return &FileSource{Path: cfg.Path}, nil
+	case cfg.URL != "":
+		return &HTTPSource{URL: cfg.URL, Client: http.DefaultClient}, nil
+	default:
+		return nil, fmt.Errorf("live config: one of path and url must be set")
+	}
+}
+
+// FileSource reads the document from a file.
+type FileSource struct {
+	Path string
+
+	modTime time.Time
+	size    int64
+	last    []byte
+}
+
+func (s *FileSource) Name() string { return "file:" + s.Path }
+
+func (s *FileSource) Fetch(ctx context.Context) (data []byte, changed bool, err error) {
+	fi, err := os.Stat(s.Path)
+	if err != nil {
+		return nil, false, err
+	}
+	// Skip reading the file if it doesn't appear to have been modified.
+	if s.last != nil && fi.ModTime().Equal(s.modTime) && fi.Size() == s.size {
+		return s.last, false, nil
+	}
+
+	data, err = os.ReadFile(s.Path)
+	if err != nil {
+		return nil, false, err
+	}
+	s.modTime, s.size = fi.ModTime(), fi.Size()
+	changed = s.last == nil || !bytes.Equal(data, s.last)
+	s.last = data
+	return data, changed, nil
+}
+
+// HTTPSource fetches the document from a config service using HTTP GET requests.
+// It uses the ETag response header, if set, to make conditional requests.
+type HTTPSource struct {
+	URL    string
+	Client *http.Client
+
+	etag string
+	last []byte
+}
+
+func (s *HTTPSource) Name() string { return s.URL }
+
+func (s *HTTPSource) Fetch(ctx context.Context) (data []byte, changed bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", s.URL, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if s.etag != "" && s.last != nil {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		return s.last, false, nil
+	case http.StatusOK:
+	default:
+		return nil, false, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	data, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	s.etag = resp.Header.Get("ETag")
+	changed = s.last == nil || !bytes.Equal(data, s.last)
+	s.last = data
+	return data, changed, nil
+}
+
+// Document is a parsed live config document.
+type Document map[string]any
+
+// Parse parses a live config document.
+func Parse(data []byte) (Document, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc Document
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid live config document: %v", err)
+	}
+	return doc, nil
+}
+
+// Lookup returns the JSON encoding of the value at path within the service's config,
+// and whether the document contains a value at that path.
+func (d Document) Lookup(service string, path []string) (json.RawMessage, bool) {
+	val, ok := d[service]
+	if !ok {
+		return nil, false
+	}
+	for _, segment := range path {
+		switch v := val.(type) {
+		case map[string]any:
+			if val, ok = v[segment]; !ok {
+				return nil, false
+			}
+		case []any:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, false
+			}
+			val = v[idx]
+		default:
+			return nil, false
+		}
+	}
+
+	data, err := json.Marshal(val)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
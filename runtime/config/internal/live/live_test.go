@@ -0,0 +1,143 @@
+package live
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+func TestNewSource(t *testing.T) {
+	if _, err := NewSource(&config.LiveConfig{Path: "a", URL: "b"}); err == nil {
+		t.Error("got nil err with both path and url set")
+	}
+	if _, err := NewSource(&config.LiveConfig{}); err == nil {
+		t.Error("got nil err with neither path nor url set")
+	}
+	if src, err := NewSource(&config.LiveConfig{Path: "/etc/cfg.json"}); err != nil || src.Name() != "file:/etc/cfg.json" {
+		t.Errorf("got %v, %v", src, err)
+	}
+}
+
+func TestFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "live.json")
+	write := func(data string, modTime time.Time) {
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fetch := func(src *FileSource) (string, bool) {
+		data, changed, err := src.Fetch(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data), changed
+	}
+
+	now := time.Now()
+	write(`{"a": 1}`, now)
+	src := &FileSource{Path: path}
+	if data, changed := fetch(src); data != `{"a": 1}` || !changed {
+		t.Errorf("first fetch: got %q, %v", data, changed)
+	}
+	if _, changed := fetch(src); changed {
+		t.Error("unmodified file reported as changed")
+	}
+
+	// Touching the file without changing its contents is not a change.
+	write(`{"a": 1}`, now.Add(time.Second))
+	if _, changed := fetch(src); changed {
+		t.Error("touched file reported as changed")
+	}
+
+	write(`{"a": 2}`, now.Add(2*time.Second))
+	if data, changed := fetch(src); data != `{"a": 2}` || !changed {
+		t.Errorf("after change: got %q, %v", data, changed)
+	}
+}
+
+func TestHTTPSource(t *testing.T) {
+	body, etag := `{"a": 1}`, `"v1"`
+	var conditional int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("If-None-Match") == etag {
+			conditional++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	src := &HTTPSource{URL: srv.URL, Client: srv.Client()}
+	fetch := func() (string, bool) {
+		data, changed, err := src.Fetch(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data), changed
+	}
+
+	if data, changed := fetch(); data != `{"a": 1}` || !changed {
+		t.Errorf("first fetch: got %q, %v", data, changed)
+	}
+	if data, changed := fetch(); data != `{"a": 1}` || changed {
+		t.Errorf("second fetch: got %q, %v", data, changed)
+	}
+	if conditional != 1 {
+		t.Errorf("got %d conditional requests, want 1", conditional)
+	}
+
+	body, etag = `{"a": 2}`, `"v2"`
+	if data, changed := fetch(); data != `{"a": 2}` || !changed {
+		t.Errorf("after change: got %q, %v", data, changed)
+	}
+}
+
+func TestDocument_Lookup(t *testing.T) {
+	doc, err := Parse([]byte(`{
+		"users": {
+			"SignupsEnabled": false,
+			"Limits": {"MaxRequests": 12345678901234567890},
+			"Servers": [{"Enabled": true}]
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		service string
+		path    []string
+		want    string
+		ok      bool
+	}{
+		{"users", []string{"SignupsEnabled"}, `false`, true},
+		{"users", []string{"Limits"}, `{"MaxRequests":12345678901234567890}`, true},
+		{"users", []string{"Limits", "MaxRequests"}, `12345678901234567890`, true},
+		{"users", []string{"Servers", "0", "Enabled"}, `true`, true},
+		{"users", []string{"Servers", "1", "Enabled"}, ``, false},
+		{"users", []string{"Missing"}, ``, false},
+		{"users", []string{"SignupsEnabled", "Nested"}, ``, false},
+		{"other", []string{"SignupsEnabled"}, ``, false},
+	}
+	for _, tt := range tests {
+		got, ok := doc.Lookup(tt.service, tt.path)
+		if string(got) != tt.want || ok != tt.ok {
+			t.Errorf("Lookup(%s, %v): got %s, %v, want %s, %v", tt.service, tt.path, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	if _, err := Parse([]byte(`[1, 2]`)); err == nil {
+		t.Error("got nil err for non-object document")
+	}
+}
@@ -0,0 +1,120 @@
+// Package override applies overrides to JSON config values.
+package override
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Sep separates the path segments in override names.
+const Sep = "__"
+
+// Apply applies the overrides, keyed by name, to the JSON value cfg.
+// The path to the value each override sets follows prefix in its name,
+// with the path segments separated by Sep. Path segments are matched against
+// object keys case-insensitively, and list elements are referenced by their index.
+// String values are replaced as-is; all other values are parsed as JSON.
+func Apply(cfg []byte, prefix string, overrides map[string]string) ([]byte, error) {
+	if len(overrides) == 0 {
+		return cfg, nil
+	}
+
+	root, err := decodeJSON(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %v", err)
+	}
+
+	// Apply the overrides in a deterministic order.
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := strings.Split(strings.TrimPrefix(name, prefix), Sep)
+		root, err = setPath(root, path, overrides[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid config override %s: %v", name, err)
+		}
+	}
+
+	return json.Marshal(root)
+}
+
+// setPath sets the value at path within val to raw, returning the updated value.
+func setPath(val any, path []string, raw string) (any, error) {
+	if len(path) == 0 {
+		// Strings are used as-is, so they don't need to be quoted.
+		if _, ok := val.(string); ok {
+			return raw, nil
+		}
+		newVal, err := decodeJSON([]byte(raw))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q: %v", raw, err)
+		}
+		return newVal, nil
+	}
+
+	segment := path[0]
+	switch v := val.(type) {
+	case map[string]any:
+		key, ok := findKey(v, segment)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %s", segment)
+		}
+		newVal, err := setPath(v[key], path[1:], raw)
+		if err != nil {
+			return nil, err
+		}
+		v[key] = newVal
+		return v, nil
+
+	case []any:
+		idx, err := strconv.Atoi(segment)
+		if err != nil || idx < 0 || idx >= len(v) {
+			return nil, fmt.Errorf("invalid list index %s", segment)
+		}
+		newVal, err := setPath(v[idx], path[1:], raw)
+		if err != nil {
+			return nil, err
+		}
+		v[idx] = newVal
+		return v, nil
+
+	default:
+		return nil, fmt.Errorf("cannot set field %s on a non-object value", segment)
+	}
+}
+
+// findKey finds the key in m matching name, preferring an exact match
+// over a case-insensitive one.
+func findKey(m map[string]any, name string) (string, bool) {
+	if _, ok := m[name]; ok {
+		return name, true
+	}
+	for key := range m {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// decodeJSON decodes the JSON value in data, preserving the precision of numbers.
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var val any
+	if err := dec.Decode(&val); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected data after value")
+	}
+	return val, nil
+}
@@ -0,0 +1,72 @@
+package override
+
+import (
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+	const prefix = "ENCORE_CFG_USERS__"
+	cfg := []byte(`{"Host":"localhost","port":8080,"DB":{"Name":"users","Replicas":["a","b"]},"Big":12345678901234567890}`)
+
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		want      string
+		wantErr   string
+	}{
+		{
+			name: "none",
+			want: string(cfg),
+		},
+		{
+			name: "values",
+			overrides: map[string]string{
+				prefix + "HOST":            "example.com",
+				prefix + "PORT":            "9090",
+				prefix + "DB__NAME":        "123",
+				prefix + "DB__REPLICAS__1": "c",
+				prefix + "DB__REPLICAS__0": "z",
+				prefix + "BIG":             "98765432109876543210",
+			},
+			want: `{"Big":98765432109876543210,"DB":{"Name":"123","Replicas":["z","c"]},"Host":"example.com","port":9090}`,
+		},
+		{
+			name:      "objects",
+			overrides: map[string]string{prefix + "DB": `{"Name": "other"}`},
+			want:      `{"Big":12345678901234567890,"DB":{"Name":"other"},"Host":"localhost","port":8080}`,
+		},
+		{
+			name:      "unknown field",
+			overrides: map[string]string{prefix + "DB__USER": "foo"},
+			wantErr:   "invalid config override ENCORE_CFG_USERS__DB__USER: unknown field USER",
+		},
+		{
+			name:      "invalid index",
+			overrides: map[string]string{prefix + "DB__REPLICAS__2": "foo"},
+			wantErr:   "invalid config override ENCORE_CFG_USERS__DB__REPLICAS__2: invalid list index 2",
+		},
+		{
+			name:      "invalid value",
+			overrides: map[string]string{prefix + "PORT": "eighty"},
+			wantErr:   `invalid config override ENCORE_CFG_USERS__PORT: invalid value "eighty": invalid character 'e' looking for beginning of value`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Apply(cfg, prefix, tt.overrides)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("got err %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
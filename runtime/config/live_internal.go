@@ -0,0 +1,230 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"encore.dev/config/internal/live"
+)
+
+// defaultLivePollInterval is how often the live config source is checked
+// for changes if the runtime config does not specify an interval.
+const defaultLivePollInterval = 30 * time.Second
+
+// liveValue is a config value which can be updated while the application is running.
+type liveValue interface {
+	// location returns the service and path of the value.
+	location() (service string, path ValuePath)
+
+	// update decodes data and atomically swaps it in as the new value,
+	// reporting the old and new values if the value changed.
+	update(m *Manager, data []byte) (oldVal, newVal any, changed bool, err error)
+
+	// notify calls the change listeners with the current value.
+	notify()
+}
+
+// dynamicValue is a config value of type T which can be updated live.
+type dynamicValue[T any] struct {
+	service string
+	path    ValuePath
+	val     atomic.Pointer[T]
+
+	mutex     sync.Mutex
+	nextID    uint64
+	listeners map[uint64]func(T)
+}
+
+// registerLiveValue registers a config value for live updates,
+// returning the value holder used to read the current value.
+func registerLiveValue[T any](id ValueID, path ValuePath, initial T) *dynamicValue[T] {
+	v := &dynamicValue[T]{
+		service:   Singleton.live.loading,
+		path:      path,
+		listeners: make(map[uint64]func(T)),
+	}
+	v.val.Store(&initial)
+
+	Singleton.live.mutex.Lock()
+	Singleton.live.values[id] = v
+	Singleton.live.mutex.Unlock()
+
+	// Start watching for live updates once there are values to update.
+	if cfg := Singleton.runtime.LiveConfig; cfg != nil && v.service != "" {
+		Singleton.live.start.Do(func() {
+			src, err := live.NewSource(cfg)
+			if err != nil {
+				Singleton.rootLog.Error().Err(err).Msg("config: unable to watch live config")
+				return
+			}
+			interval := cfg.PollInterval
+			if interval <= 0 {
+				interval = defaultLivePollInterval
+			}
+			go Singleton.watchLive(src, interval)
+		})
+	}
+	return v
+}
+
+func (v *dynamicValue[T]) get() T {
+	return *v.val.Load()
+}
+
+func (v *dynamicValue[T]) location() (string, ValuePath) {
+	return v.service, v.path
+}
+
+func (v *dynamicValue[T]) update(m *Manager, data []byte) (oldVal, newVal any, changed bool, err error) {
+	var val T
+	if err := m.json.Unmarshal(data, &val); err != nil {
+		return nil, nil, false, err
+	}
+	old := v.val.Load()
+	if reflect.DeepEqual(*old, val) {
+		return nil, nil, false, nil
+	}
+	v.val.Store(&val)
+	return *old, val, true, nil
+}
+
+func (v *dynamicValue[T]) notify() {
+	v.mutex.Lock()
+	listeners := make([]func(T), 0, len(v.listeners))
+	for _, fn := range v.listeners {
+		listeners = append(listeners, fn)
+	}
+	v.mutex.Unlock()
+
+	val := v.get()
+	for _, fn := range listeners {
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					Singleton.rootLog.Error().
+						Str("service", v.service).
+						Str("path", strings.Join(v.path, ".")).
+						Msgf("config: change listener panicked: %v", err)
+				}
+			}()
+			fn(val)
+		}()
+	}
+}
+
+func (v *dynamicValue[T]) onChange(fn func(T)) (stop func()) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	id := v.nextID
+	v.nextID++
+	v.listeners[id] = fn
+
+	return func() {
+		v.mutex.Lock()
+		defer v.mutex.Unlock()
+		delete(v.listeners, id)
+	}
+}
+
+// onChange registers fn to be called when the given config value is updated.
+func onChange[T any](value func() T, fn func(T)) (stop func()) {
+	id, _ := GetMetaForValue(value)
+
+	Singleton.live.mutex.Lock()
+	lv := Singleton.live.values[id]
+	Singleton.live.mutex.Unlock()
+
+	v, ok := lv.(*dynamicValue[T])
+	if !ok {
+		panic("config.OnChange called with an unknown config value")
+	}
+	return v.onChange(fn)
+}
+
+// watchLive polls the live config source for changes and applies them.
+func (m *Manager) watchLive(src live.Source, interval time.Duration) {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		data, changed, err := src.Fetch(ctx)
+		cancel()
+
+		if err != nil {
+			m.rootLog.Error().Err(err).Str("source", src.Name()).Msg("config: unable to fetch live config")
+		} else if changed {
+			if err := m.applyLive(src.Name(), data); err != nil {
+				m.rootLog.Error().Err(err).Str("source", src.Name()).Msg("config: unable to apply live config")
+			}
+		}
+		time.Sleep(interval)
+	}
+}
+
+// applyLive applies the live config document data from the given source,
+// updating the config values it contains and notifying their listeners.
+//
+// Each changed value is logged. Values which cannot be decoded keep their
+// current value and are reported in the returned error.
+func (m *Manager) applyLive(source string, data []byte) error {
+	doc, err := live.Parse(data)
+	if err != nil {
+		return err
+	}
+
+	m.live.mutex.Lock()
+	values := make([]liveValue, 0, len(m.live.values))
+	for _, v := range m.live.values {
+		values = append(values, v)
+	}
+	m.live.mutex.Unlock()
+
+	// Update the values in a deterministic order.
+	sort.Slice(values, func(i, j int) bool {
+		si, pi := values[i].location()
+		sj, pj := values[j].location()
+		if si != sj {
+			return si < sj
+		}
+		return strings.Join(pi, ".") < strings.Join(pj, ".")
+	})
+
+	var (
+		changed []liveValue
+		errs    []string
+	)
+	for _, v := range values {
+		service, path := v.location()
+		raw, ok := doc.Lookup(service, path)
+		if !ok {
+			continue
+		}
+
+		oldVal, newVal, didChange, err := v.update(m, raw)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s.%s: %v", service, strings.Join(path, "."), err))
+		} else if didChange {
+			m.rootLog.Info().
+				Str("service", service).
+				Str("path", strings.Join(path, ".")).
+				Str("source", source).
+				Interface("old", oldVal).
+				Interface("new", newVal).
+				Msg("config value updated")
+			changed = append(changed, v)
+		}
+	}
+
+	for _, v := range changed {
+		v.notify()
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config values: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
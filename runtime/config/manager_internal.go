@@ -8,7 +8,9 @@ import (
 	"testing"
 
 	jsoniter "github.com/json-iterator/go"
+	"github.com/rs/zerolog"
 
+	rtconfig "encore.dev/appruntime/exported/config"
 	"encore.dev/appruntime/exported/model"
 	"encore.dev/appruntime/shared/encoreenv"
 	"encore.dev/appruntime/shared/reqtrack"
@@ -19,8 +21,10 @@ type ValuePath []string
 
 type Manager struct {
 	// Runtime components we need for config
-	rt   *reqtrack.RequestTracker
-	json jsoniter.API
+	rt      *reqtrack.RequestTracker
+	runtime *rtconfig.Runtime
+	json    jsoniter.API
+	rootLog zerolog.Logger
 
 	// config tracking systems
 	nextValueID atomic.Uint64
@@ -35,17 +39,31 @@ type Manager struct {
 		ExtractedPath ValuePath    // What's the path we extracted?
 	}
 
+	// Live config updates
+	live struct {
+		loadMutex sync.Mutex // Held while a service's config is being loaded
+		loading   string     // The service being loaded, only accessed under loadMutex
+
+		mutex  sync.Mutex // Guards values and serializes updates
+		values map[ValueID]liveValue
+		start  sync.Once
+	}
+
 	// Test support
 	testMutex     sync.RWMutex
 	testOverrides map[*testing.T]map[ValueID]any
 }
 
-func NewManager(rt *reqtrack.RequestTracker, json jsoniter.API) *Manager {
-	return &Manager{
+func NewManager(rt *reqtrack.RequestTracker, runtime *rtconfig.Runtime, json jsoniter.API, rootLog zerolog.Logger) *Manager {
+	mgr := &Manager{
 		rt:            rt,
+		runtime:       runtime,
 		json:          json,
+		rootLog:       rootLog,
 		testOverrides: make(map[*testing.T]map[ValueID]any),
 	}
+	mgr.live.values = make(map[ValueID]liveValue)
+	return mgr
 }
 
 func (m *Manager) getComputedCUE(serviceName string) ([]byte, error) {
@@ -88,7 +106,7 @@ func (m *Manager) valueMeta(id ValueID, path ValuePath) {
 	req := m.rt.Current()
 	m.extraction.scopeMutex.RLock()
 	defer m.extraction.scopeMutex.RUnlock()
-	if req.Req.SpanID != m.extraction.forSpan || req.Goctr != m.extraction.forGoRoutine {
+	if spanID(req) != m.extraction.forSpan || req.Goctr != m.extraction.forGoRoutine {
 		return
 	}
 
@@ -97,3 +115,12 @@ func (m *Manager) valueMeta(id ValueID, path ValuePath) {
 	m.extraction.ExtractedPath = path
 	m.extraction.count++
 }
+
+// spanID returns the span ID of the current request,
+// or the zero span ID if there is no current request (such as during initialization).
+func spanID(req reqtrack.Current) model.SpanID {
+	if req.Req == nil {
+		return model.SpanID{}
+	}
+	return req.Req.SpanID
+}
@@ -3,23 +3,29 @@
 //
 // # By default configuration is pulled at build time from CUE files in each service directory
 //
+// Configuration can also be provided as YAML or JSON files (config.yaml, with environment
+// specific overrides in files like config.prod.yaml), and individual values can be overridden
+// at runtime using environment variables named ENCORE_CFG_<SERVICE>__<FIELD>.
+//
 // For more information about configuration see https://encore.dev/docs/develop/config.
 package config
 
 import (
 	"fmt"
 
+	"encore.dev/appruntime/shared/appconf"
 	"encore.dev/appruntime/shared/jsonapi"
+	"encore.dev/appruntime/shared/logging"
 	"encore.dev/appruntime/shared/reqtrack"
 )
 
 //publicapigen:drop
-var Singleton = NewManager(reqtrack.Singleton, jsonapi.Default)
+var Singleton = NewManager(reqtrack.Singleton, appconf.Runtime, jsonapi.Default, logging.RootLogger)
 
 // Load returns the fully loaded configuration for this service.
 //
-// The configuration is loaded from the CUE files in the service directory and
-// will be validated by Encore at compile time, which ensures this function will
+// The configuration is loaded from the CUE files (or the YAML and JSON config files)
+// in the service directory and will be validated by Encore at compile time, which ensures this function will
 // return a valid configuration at runtime.
 //
 // Encore will generate a `encore.gen.cue` file in the service directory which
@@ -34,6 +40,12 @@ func Load[T any](__serviceName string, __unmarshaler Unmarshaler[T]) T {
 		panic(err.Error())
 	}
 
+	// Apply any overrides from environment variables
+	cfgBytes, err = applyEnvOverrides(__serviceName, cfgBytes)
+	if err != nil {
+		panic(fmt.Sprintf("failed to apply config overrides for service %s: %v", __serviceName, err))
+	}
+
 	// Create an iterator for the JSON config
 	itr := Singleton.json.BorrowIterator(cfgBytes)
 	defer Singleton.json.ReturnIterator(itr)
@@ -41,6 +53,22 @@ func Load[T any](__serviceName string, __unmarshaler Unmarshaler[T]) T {
 		panic(fmt.Sprintf("failed to unmarshal config for service %s: %v", __serviceName, itr.Error))
 	}
 
-	// Now unmarshal the root object
+	// Now unmarshal the root object, tracking the service
+	// so its config values can be updated live
+	Singleton.live.loadMutex.Lock()
+	defer Singleton.live.loadMutex.Unlock()
+	Singleton.live.loading = __serviceName
+	defer func() { Singleton.live.loading = "" }()
 	return __unmarshaler(itr, nil)
 }
+
+// OnChange registers fn to be called with the new value whenever
+// the config value is updated while the application is running.
+//
+// Values declared as config.Value or config.Values can be updated
+// from a live config source without restarting the application.
+// The function is called from a background goroutine.
+// It returns a function that stops further notifications.
+func OnChange[T any](value func() T, fn func(T)) (stop func()) {
+	return onChange(value, fn)
+}
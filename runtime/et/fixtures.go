@@ -0,0 +1,30 @@
+package et
+
+import (
+	"context"
+	"fmt"
+
+	"encore.dev/internal/fixtures"
+	"encore.dev/storage/sqldb"
+)
+
+// LoadFixtures inserts the rows in the given YAML or JSON fixture files into the database db.
+// Each file maps table names to the rows to insert into them, in order:
+//
+//	users:
+//	  - id: 1
+//	    email: alice@example.com
+//	posts:
+//	  - id: 1
+//	    author_id: 1
+//	    tags: ["news"] # objects and arrays are inserted as JSON
+//
+// The rows are inserted in a single transaction, in the order they appear in the files.
+// When tests are isolated from each other's database changes (see SetDBIsolation),
+// the fixtures are only visible to the current test.
+func LoadFixtures(db *sqldb.Database, paths ...string) error {
+	if err := fixtures.Load(context.Background(), db, paths...); err != nil {
+		return fmt.Errorf("et.LoadFixtures: %v", err)
+	}
+	return nil
+}
@@ -1,17 +1,36 @@
 package et
 
 import (
+	"fmt"
+
 	"encore.dev/appruntime/exported/config"
 	"encore.dev/appruntime/shared/reqtrack"
+	"encore.dev/appruntime/shared/testsupport"
 )
 
 //publicapigen:drop
 type Manager struct {
 	static *config.Static
 	rt     *reqtrack.RequestTracker
+	ts     *testsupport.Manager
 }
 
 //publicapigen:drop
-func NewManager(static *config.Static, rt *reqtrack.RequestTracker) *Manager {
-	return &Manager{static, rt}
+func NewManager(static *config.Static, rt *reqtrack.RequestTracker, ts *testsupport.Manager) *Manager {
+	return &Manager{static, rt, ts}
+}
+
+func (mgr *Manager) SetDBIsolation(mode DBIsolation) {
+	var m testsupport.DBIsolation
+	switch mode {
+	case SharedDB:
+		m = testsupport.SharedDB
+	case RollbackDB:
+		m = testsupport.RollbackDB
+	case CloneDB:
+		m = testsupport.CloneDB
+	default:
+		panic(fmt.Sprintf("et.SetDBIsolation: unknown isolation mode %d", mode))
+	}
+	mgr.ts.SetDBIsolation(m)
 }
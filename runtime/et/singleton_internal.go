@@ -5,7 +5,8 @@ package et
 import (
 	"encore.dev/appruntime/shared/appconf"
 	"encore.dev/appruntime/shared/reqtrack"
+	"encore.dev/appruntime/shared/testsupport"
 )
 
 //publicapigen:drop
-var Singleton = NewManager(appconf.Static, reqtrack.Singleton)
+var Singleton = NewManager(appconf.Static, reqtrack.Singleton, testsupport.Singleton)
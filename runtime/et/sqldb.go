@@ -0,0 +1,38 @@
+package et
+
+// DBIsolation describes how tests are isolated from each other's database changes.
+type DBIsolation int
+
+const (
+	// SharedDB shares each database between all the tests in the package.
+	// This is the default.
+	SharedDB DBIsolation = iota
+
+	// RollbackDB runs the queries of each test in a transaction that is rolled back
+	// when the test ends, so tests never see each other's changes.
+	//
+	// As the transaction uses a single connection, rows must be closed before running
+	// another query, and queries made with Stdlib or sqldb.Driver are not isolated.
+	// Transactions begun by the test are nested in the test's transaction.
+	// Subtests run in a savepoint of their parent's transaction, so they see the
+	// parent's changes and can't deadlock on them. Subtests of the same parent
+	// take turns using its transaction, even if they call t.Parallel.
+	// SQLite only allows one transaction at a time to write, so use CloneDB
+	// for SQLite databases written to by parallel tests.
+	RollbackDB
+
+	// CloneDB gives each test a fresh database, cloned from a template database
+	// with the migrated schema, which is dropped when the test ends.
+	// It's supported for PostgreSQL and SQLite databases.
+	CloneDB
+)
+
+// SetDBIsolation sets how the tests in the current package are isolated from each other's
+// database changes. Isolating tests makes it safe for tests using databases to call t.Parallel.
+//
+// Each test gets its own isolated database the first time it uses a database,
+// and subtests' changes are isolated from their parent test. Call SetDBIsolation before
+// any tests run, typically from TestMain or an init function in a test file.
+func SetDBIsolation(mode DBIsolation) {
+	Singleton.SetDBIsolation(mode)
+}
@@ -0,0 +1,152 @@
+// Package fixtures loads YAML and JSON fixture files into databases.
+package fixtures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"encore.dev/storage/sqldb"
+)
+
+// Load inserts the rows in the given fixture files into the database db,
+// in a single transaction.
+func Load(ctx context.Context, db *sqldb.Database, paths ...string) error {
+	var tables []Table
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		t, err := Parse(data)
+		if err != nil {
+			return fmt.Errorf("parse %s: %v", path, err)
+		}
+		tables = append(tables, t...)
+	}
+
+	return db.InTx(ctx, nil, func(tx *sqldb.Tx) error {
+		for _, t := range tables {
+			for i, row := range t.Rows {
+				query, args := InsertQuery(db.Engine(), t.Name, row)
+				if _, err := tx.Exec(ctx, query, args...); err != nil {
+					return fmt.Errorf("insert row %d into %s: %v", i, t.Name, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// Table is the rows to insert into a table.
+type Table struct {
+	Name string
+	Rows [][]Column
+}
+
+// Column is the value of a column in a row to insert.
+type Column struct {
+	Name  string
+	Value any
+}
+
+// Parse parses a YAML or JSON fixture file, keeping the order of the tables and columns.
+func Parse(data []byte) ([]Table, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	} else if len(doc.Content) == 0 {
+		return nil, nil // empty file
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("line %d: expected a mapping of table names to rows", root.Line)
+	}
+	var tables []Table
+	for i := 0; i < len(root.Content); i += 2 {
+		key, val := root.Content[i], root.Content[i+1]
+		if val.Kind != yaml.SequenceNode {
+			return nil, fmt.Errorf("line %d: expected a list of rows for table %s", val.Line, key.Value)
+		}
+		t := Table{Name: key.Value}
+		for _, rowNode := range val.Content {
+			if rowNode.Kind != yaml.MappingNode {
+				return nil, fmt.Errorf("line %d: expected a mapping of column names to values", rowNode.Line)
+			}
+			var row []Column
+			for j := 0; j < len(rowNode.Content); j += 2 {
+				col, colVal := rowNode.Content[j], rowNode.Content[j+1]
+				value, err := decodeValue(colVal)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: column %s: %v", colVal.Line, col.Value, err)
+				}
+				row = append(row, Column{Name: col.Value, Value: value})
+			}
+			t.Rows = append(t.Rows, row)
+		}
+		tables = append(tables, t)
+	}
+	return tables, nil
+}
+
+// decodeValue decodes the value of a column.
+// Objects and arrays are encoded as JSON, to support JSON columns.
+func decodeValue(node *yaml.Node) (any, error) {
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return nil, err
+	}
+	switch v.(type) {
+	case map[string]any, []any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+	return v, nil
+}
+
+// InsertQuery returns the query and arguments for inserting row into the table.
+func InsertQuery(engine sqldb.Engine, table string, row []Column) (string, []any) {
+	if len(row) == 0 {
+		if engine == sqldb.MySQL {
+			return fmt.Sprintf("INSERT INTO %s () VALUES ()", quoteIdent(engine, table)), nil
+		}
+		return fmt.Sprintf("INSERT INTO %s DEFAULT VALUES", quoteIdent(engine, table)), nil
+	}
+
+	var cols, params []string
+	args := make([]any, 0, len(row))
+	for i, c := range row {
+		cols = append(cols, quoteIdent(engine, c.Name))
+		if engine == sqldb.Postgres {
+			params = append(params, "$"+strconv.Itoa(i+1))
+		} else {
+			params = append(params, "?")
+		}
+		args = append(args, c.Value)
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		quoteIdent(engine, table), strings.Join(cols, ", "), strings.Join(params, ", "))
+	return query, args
+}
+
+// quoteIdent quotes the possibly schema-qualified identifier name.
+func quoteIdent(engine sqldb.Engine, name string) string {
+	q := `"`
+	if engine == sqldb.MySQL {
+		q = "`"
+	}
+	parts := strings.Split(name, ".")
+	for i, p := range parts {
+		parts[i] = q + strings.ReplaceAll(p, q, q+q) + q
+	}
+	return strings.Join(parts, ".")
+}
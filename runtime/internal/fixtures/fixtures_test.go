@@ -0,0 +1,131 @@
+package fixtures
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/shared/reqtrack"
+	"encore.dev/appruntime/shared/testsupport"
+	"encore.dev/storage/sqldb"
+)
+
+func TestInsertQuery(t *testing.T) {
+	row := []Column{{"id", 1}, {"name", "alice"}}
+	tests := []struct {
+		engine sqldb.Engine
+		table  string
+		row    []Column
+		want   string
+	}{
+		{sqldb.Postgres, "users", row, `INSERT INTO "users" ("id", "name") VALUES ($1, $2)`},
+		{sqldb.Postgres, "auth.users", row, `INSERT INTO "auth"."users" ("id", "name") VALUES ($1, $2)`},
+		{sqldb.MySQL, "users", row, "INSERT INTO `users` (`id`, `name`) VALUES (?, ?)"},
+		{sqldb.SQLite, `we"ird`, row, `INSERT INTO "we""ird" ("id", "name") VALUES (?, ?)`},
+		{sqldb.Postgres, "users", nil, `INSERT INTO "users" DEFAULT VALUES`},
+		{sqldb.MySQL, "users", nil, "INSERT INTO `users` () VALUES ()"},
+	}
+	for _, test := range tests {
+		got, args := InsertQuery(test.engine, test.table, test.row)
+		if got != test.want {
+			t.Errorf("InsertQuery(%s, %q) = %q, want %q", test.engine, test.table, got, test.want)
+		}
+		if len(args) != len(test.row) {
+			t.Errorf("InsertQuery(%s, %q) got %d args, want %d", test.engine, test.table, len(args), len(test.row))
+		}
+	}
+}
+
+func TestParseFixtures(t *testing.T) {
+	yamlData := `
+users:
+  - id: 1
+    name: alice
+    meta: {admin: true}
+  - id: 2
+    name: null
+posts:
+  - {id: 1, author_id: 1, tags: [a, b]}
+`
+	jsonData := `{
+		"users": [
+			{"id": 1, "name": "alice", "meta": {"admin": true}},
+			{"id": 2, "name": null}
+		],
+		"posts": [{"id": 1, "author_id": 1, "tags": ["a", "b"]}]
+	}`
+	want := []Table{
+		{Name: "users", Rows: [][]Column{
+			{{"id", 1}, {"name", "alice"}, {"meta", `{"admin":true}`}},
+			{{"id", 2}, {"name", nil}},
+		}},
+		{Name: "posts", Rows: [][]Column{
+			{{"id", 1}, {"author_id", 1}, {"tags", `["a","b"]`}},
+		}},
+	}
+
+	for name, data := range map[string]string{"yaml": yamlData, "json": jsonData} {
+		got, err := Parse([]byte(data))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		} else if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %+v, want %+v", name, got, want)
+		}
+	}
+
+	for _, data := range []string{"- a\n- b", "users: {id: 1}", "users: [1, 2]"} {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("Parse(%q): got nil err", data)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	runtime := &config.Runtime{
+		SQLServers:   []*config.SQLServer{{Host: dir, Engine: "sqlite"}},
+		SQLDatabases: []*config.SQLDatabase{{EncoreName: "test", DatabaseName: "test"}},
+	}
+	rt := reqtrack.New(zerolog.Logger{}, nil, nil)
+	ts := testsupport.NewManager(&config.Static{}, rt, zerolog.Logger{})
+	mgr := sqldb.NewManager(runtime, rt, ts, nil, zerolog.Logger{})
+	defer mgr.Shutdown(ctx)
+
+	db := mgr.Named("test")
+	if _, err := db.Exec(ctx, "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT, meta TEXT)"); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "users.yaml")
+	data := "users:\n  - {id: 1, name: alice, meta: {admin: true}}\n  - {id: 2, name: bob}\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Load(ctx, db, path); err != nil {
+		t.Fatal(err)
+	}
+
+	var meta string
+	if err := db.QueryRow(ctx, "SELECT meta FROM users WHERE name = ?", "alice").Scan(&meta); err != nil {
+		t.Fatal(err)
+	} else if meta != `{"admin":true}` {
+		t.Errorf("got meta %q, want %q", meta, `{"admin":true}`)
+	}
+
+	// The rows are inserted in a single transaction.
+	if err := Load(ctx, db, path); err == nil {
+		t.Fatal("got nil err when inserting duplicate rows")
+	}
+	var n int
+	if err := db.QueryRow(ctx, "SELECT COUNT(*) FROM users").Scan(&n); err != nil {
+		t.Fatal(err)
+	} else if n != 2 {
+		t.Errorf("got %d rows, want 2", n)
+	}
+}
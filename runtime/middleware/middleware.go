@@ -7,6 +7,7 @@ package middleware
 
 import (
 	"context"
+	"net/http"
 
 	encore "encore.dev"
 )
@@ -92,6 +93,20 @@ type Response struct {
 	// For raw handlers middleware cannot modify this as it has already
 	// been written to the network.
 	HTTPStatus int
+
+	// Header contains the HTTP headers the response is written with.
+	// It's never nil in the Response returned by next.
+	//
+	// For regular endpoints it initially contains the headers from the
+	// response payload's fields tagged with `header:"..."`, and middleware can
+	// add, modify or remove headers. The headers are written both for successful
+	// responses and errors. When returning a new Response instead of modifying
+	// the one returned by next, copy the headers to keep them.
+	//
+	// For raw handlers it contains the headers written by the handler,
+	// and middleware cannot modify them as they have already been
+	// written to the network.
+	Header http.Header
 }
 
 // NewRequest constructs a new Request that returns the given context and request data.
@@ -65,6 +65,19 @@ func (mgr *Manager) Shutdown(force context.Context) {
 	}
 }
 
+// isHosted reports whether the given service is hosted by this instance.
+func (mgr *Manager) isHosted(svc string) bool {
+	if len(mgr.runtime.HostedServices) == 0 {
+		return true
+	}
+	for _, s := range mgr.runtime.HostedServices {
+		if s == svc {
+			return true
+		}
+	}
+	return false
+}
+
 // outstandingMessageTracker tracks the number of outstanding messages.
 // Once Shutdown() has been called, the next time the number of outstanding
 // messages reaches zero (or if it's already zero), the Done() channel is closed.
@@ -80,6 +80,13 @@ func NewSubscription[T any](topic *Topic[T], name string, subscriptionCfg Subscr
 	}
 
 	subscription, staticCfg := topic.getSubscriptionConfig(name)
+
+	// Subscriptions of services hosted by another instance
+	// of the application are processed by that instance.
+	if !mgr.isHosted(staticCfg.Service) {
+		return &Subscription[T]{mgr: mgr}
+	}
+
 	panicCatchWrapper := func(ctx context.Context, msg T) (err error) {
 		defer func() {
 			if err2 := recover(); err2 != nil {
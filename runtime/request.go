@@ -1,6 +1,7 @@
 package encore
 
 import (
+	"net/http"
 	"reflect"
 	"time"
 
@@ -42,6 +43,11 @@ type Request struct {
 	Path       string     // What was the path made to the API server
 	PathParams PathParams // If there are path parameters, what are they?
 
+	// Headers contains the HTTP headers of the incoming API call,
+	// for both regular and raw endpoints. It is empty for calls between
+	// services running in the same process. It must not be modified.
+	Headers http.Header
+
 	// PubSubMessage specific parameters.
 	// Message contains information about the PubSub message,
 	Message *MessageData
@@ -165,6 +171,11 @@ func (mgr *Manager) CurrentRequest() *Request {
 			result.PathParams[i].Value = param.Value
 		}
 
+		result.Headers = data.RequestHeaders
+		if result.Headers == nil {
+			result.Headers = make(http.Header)
+		}
+
 		result.API = &APIDesc{
 			RequestType:  desc.RequestType,
 			ResponseType: desc.ResponseType,
@@ -0,0 +1,63 @@
+// Package secret provides secret values that are kept up to date
+// when the underlying secret is rotated, without restarting the application.
+//
+// To use it, declare a field in the secrets struct as a Value:
+//
+//	var secrets struct {
+//		APIKey secret.Value[string]
+//	}
+//
+// When the application resolves secrets from a secrets provider
+// the value is periodically refreshed in the background.
+//
+// For more information about secrets see https://encore.dev/docs/primitives/secrets.
+package secret
+
+// Value is a secret value that is refreshed in the background
+// when the secret is rotated.
+//
+// Use Get to retrieve the current value and OnChange to be notified
+// when the value changes, for example to recreate clients that were
+// created using the old value.
+type Value[T string | []byte] struct {
+	src Source
+}
+
+// Get returns the current value of the secret.
+func (v Value[T]) Get() T {
+	if v.src == nil {
+		var zero T
+		return zero
+	}
+	return T(v.src.Get())
+}
+
+// OnChange registers fn to be called with the new value
+// whenever the secret is rotated.
+//
+// The function is called from a background goroutine.
+// It returns a function that stops further notifications.
+func (v Value[T]) OnChange(fn func(T)) (stop func()) {
+	if v.src == nil {
+		return func() {}
+	}
+	return v.src.OnChange(func(val string) {
+		fn(T(val))
+	})
+}
+
+// Source provides the current value of a secret
+// and notifications when it changes.
+//
+//publicapigen:drop
+type Source interface {
+	Get() string
+	OnChange(fn func(string)) (stop func())
+}
+
+// NewValue returns a Value backed by src.
+//
+//publicapigen:drop
+func NewValue[T string | []byte](src Source) Value[T] {
+	return Value[T]{src: src}
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -26,26 +27,71 @@ type Database struct {
 	mgr  *Manager
 
 	initOnce sync.Once
-	pool     *pgxpool.Pool
+	engine   Engine
+	pool     *pgxpool.Pool // the primary, for PostgreSQL databases
 	connStr  string
 
+	// std is the primary for databases accessed through database/sql,
+	// which are MySQL and SQLite databases, and connector is its connector.
+	std       *sql.DB
+	connector driver.Connector
+
+	replicas     []*replica
+	maxLag       time.Duration
+	replicaCtr   uint64 // accessed atomically
+	stopReplicas chan struct{}
+
 	stdlibOnce sync.Once
 	stdlib     *sql.DB
+
+	// test is set for the isolated databases of tests, and testTx
+	// is the transaction of a test whose changes are rolled back.
+	test   bool
+	testTx txConn
 }
 
 func (db *Database) init() {
 	db.initOnce.Do(func() {
-		if db.pool == nil {
-			db.pool = db.mgr.getPool(db.name)
+		if db.pool == nil && db.std == nil {
+			db.mgr.openPools(db)
+		}
+		if db.pool != nil {
+			db.connStr = stdlibdriver.RegisterConnConfig(db.pool.Config().ConnConfig)
+		}
+		if len(db.replicas) > 0 {
+			db.stopReplicas = make(chan struct{})
+			go db.monitorReplicas()
 		}
-		db.connStr = stdlibdriver.RegisterConnConfig(db.pool.Config().ConnConfig)
 	})
 }
 
 // Stdlib returns a *sql.DB object that is connected to the same db,
 // for use with libraries that expect a *sql.DB.
+//
+// When tests are isolated by rolling back their changes,
+// queries made with the returned *sql.DB are not isolated.
 func (db *Database) Stdlib() *sql.DB {
+	if iso, err := db.forTest(); err != nil {
+		panic(err.Error())
+	} else if iso.testTx == nil {
+		db = iso
+	}
 	db.init()
+	if db.std != nil {
+		// Open a separate *sql.DB whose queries are traced, as the queries
+		// made through db.std are traced by the methods of Database.
+		db.stdlibOnce.Do(func() {
+			db.stdlib = sql.OpenDB(wrappedConnector{
+				parent:    db.connector,
+				driverRef: &wrappedDriver{parent: db.connector.Driver(), mw: &interceptor{mgr: db.mgr}},
+			})
+			maxConns := db.std.Stats().MaxOpenConnections
+			db.stdlib.SetMaxOpenConns(maxConns)
+			db.stdlib.SetMaxIdleConns(maxConns)
+		})
+		return db.stdlib
+	}
+
 	registerDriver.Do(func() {
 		stdlibDriver = &wrappedDriver{
 			parent: stdlibdriver.GetDefaultDriver(),
@@ -78,9 +124,18 @@ func (db *Database) Stdlib() *sql.DB {
 }
 
 func (db *Database) shutdown(force context.Context) {
+	if db.stopReplicas != nil {
+		close(db.stopReplicas)
+	}
 	if db.pool != nil {
 		db.pool.Close()
 	}
+	if db.std != nil {
+		_ = db.std.Close()
+	}
+	for _, r := range db.replicas {
+		r.pool.Close()
+	}
 	if db.stdlib != nil {
 		_ = db.stdlib.Close()
 	}
@@ -150,6 +205,10 @@ func dbConf(srv *config.SQLServer, db *config.SQLDatabase) (*pgxpool.Config, err
 //
 // See (*database/sql.DB).ExecContext() for additional documentation.
 func (db *Database) Exec(ctx context.Context, query string, args ...interface{}) (ExecResult, error) {
+	db, err := db.forTest()
+	if err != nil {
+		return nil, err
+	}
 	db.init()
 	qid := atomic.AddUint64(&db.mgr.queryCtr, 1)
 
@@ -162,10 +221,11 @@ func (db *Database) Exec(ctx context.Context, query string, args ...interface{})
 			QueryID: qid,
 			TxID:    0,
 			Stack:   stack.Build(4),
+			Node:    db.primaryNode(),
 		})
 	}
 
-	res, err := db.pool.Exec(markTraced(ctx), query, args...)
+	res, err := db.primary().exec(markTraced(ctx), query, args)
 	err = convertErr(err)
 
 	if curr.Trace != nil {
@@ -177,11 +237,17 @@ func (db *Database) Exec(ctx context.Context, query string, args ...interface{})
 
 // Query executes a query that returns rows, typically a SELECT.
 // The args are for any placeholder parameters in the query.
+// If ctx was returned by WithReplica the query may be served by a read replica.
 //
 // See (*database/sql.DB).QueryContext() for additional documentation.
 func (db *Database) Query(ctx context.Context, query string, args ...interface{}) (*Rows, error) {
+	db, err := db.forTest()
+	if err != nil {
+		return nil, err
+	}
 	db.init()
 	qid := atomic.AddUint64(&db.mgr.queryCtr, 1)
+	conn, node := db.readConn(ctx)
 
 	curr := db.mgr.rt.Current()
 	if curr.Req != nil && curr.Trace != nil {
@@ -192,10 +258,11 @@ func (db *Database) Query(ctx context.Context, query string, args ...interface{}
 			QueryID: qid,
 			TxID:    0,
 			Stack:   stack.Build(4),
+			Node:    node,
 		})
 	}
 
-	rows, err := db.pool.Query(markTraced(ctx), query, args...)
+	rows, err := conn.query(markTraced(ctx), query, args)
 	err = convertErr(err)
 
 	if curr.Trace != nil {
@@ -209,11 +276,17 @@ func (db *Database) Query(ctx context.Context, query string, args ...interface{}
 }
 
 // QueryRow executes a query that is expected to return at most one row.
+// If ctx was returned by WithReplica the query may be served by a read replica.
 //
 // See (*database/sql.DB).QueryRowContext() for additional documentation.
 func (db *Database) QueryRow(ctx context.Context, query string, args ...interface{}) *Row {
+	db, err := db.forTest()
+	if err != nil {
+		return &Row{err: err}
+	}
 	db.init()
 	qid := atomic.AddUint64(&db.mgr.queryCtr, 1)
+	conn, node := db.readConn(ctx)
 
 	curr := db.mgr.rt.Current()
 	if curr.Req != nil && curr.Trace != nil {
@@ -224,10 +297,11 @@ func (db *Database) QueryRow(ctx context.Context, query string, args ...interfac
 			QueryID: qid,
 			TxID:    0,
 			Stack:   stack.Build(4),
+			Node:    node,
 		})
 	}
 
-	rows, err := db.pool.Query(markTraced(ctx), query, args...)
+	rows, err := conn.query(markTraced(ctx), query, args)
 	err = convertErr(err)
 	r := &Row{rows: rows, err: err}
 
@@ -238,16 +312,43 @@ func (db *Database) QueryRow(ctx context.Context, query string, args ...interfac
 	return r
 }
 
+// WithReplica returns a context that routes the queries made with Query and QueryRow
+// to a read replica of the database, for queries that can tolerate slightly stale data.
+//
+// Replicas that lag too far behind the primary are not used, and queries fall back
+// to the primary if no replica is available or the database has no replicas.
+// Exec and transactions always use the primary.
+func WithReplica(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaKey{}, true)
+}
+
 // Begin opens a new database transaction.
 //
 // See (*database/sql.DB).Begin() for additional documentation.
 func (db *Database) Begin(ctx context.Context) (*Tx, error) {
-	db.init()
-	tx, err := db.pool.Begin(markTraced(ctx))
-	err = convertErr(err)
+	return db.begin(ctx, TxOptions{}, 5)
+}
+
+// begin opens a new database transaction with the given options,
+// skipping stackSkip frames when building the stack for the trace.
+func (db *Database) begin(ctx context.Context, opts TxOptions, stackSkip int) (*Tx, error) {
+	db, err := db.forTest()
 	if err != nil {
 		return nil, err
 	}
+	db.init()
+
+	var tx txConn
+	if db.testTx != nil {
+		// Transactions of tests whose changes are rolled back are nested in the
+		// test's transaction, so the options can't be applied.
+		tx, err = db.testTx.savepoint(markTraced(ctx))
+	} else {
+		tx, err = db.beginConn(markTraced(ctx), opts)
+	}
+	if err != nil {
+		return nil, convertErr(err)
+	}
 	txid := atomic.AddUint64(&db.mgr.txidCtr, 1)
 
 	curr := db.mgr.rt.Current()
@@ -256,11 +357,27 @@ func (db *Database) Begin(ctx context.Context) (*Tx, error) {
 			SpanID: curr.Req.SpanID,
 			Goid:   curr.Goctr,
 			TxID:   txid,
-			Stack:  stack.Build(4),
+			Stack:  stack.Build(stackSkip),
 		})
 	}
 
-	return &Tx{mgr: db.mgr, txid: txid, std: tx}, nil
+	return &Tx{mgr: db.mgr, txid: txid, std: tx, node: db.primaryNode()}, nil
+}
+
+// beginConn begins a transaction on the primary with the given options.
+func (db *Database) beginConn(ctx context.Context, opts TxOptions) (txConn, error) {
+	if db.std != nil {
+		stdTx, err := db.std.BeginTx(ctx, stdTxOptions(db.engine, opts))
+		if err != nil {
+			return nil, err
+		}
+		return newStdTx(stdTx), nil
+	}
+	pgxTx, err := db.pool.BeginTx(ctx, pgxTxOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return newPgxTx(pgxTx), nil
 }
 
 // Driver returns the underlying database driver for this database connection pool.
@@ -273,12 +390,25 @@ func (db *Database) Begin(ctx context.Context) (*Tx, error) {
 // At some point in the future where Encore adds support for a different database driver
 // this will be made with backwards compatibility in mind, providing ample notice and
 // time to migrate in an opt-in fashion.
+//
+// Driver is only supported for PostgreSQL databases; use Stdlib for other engines.
+// When tests are isolated by rolling back their changes,
+// queries made with the returned driver are not isolated.
 func Driver[T SupportedDrivers](db *Database) T {
+	if iso, err := db.forTest(); err != nil {
+		panic(err.Error())
+	} else if iso.testTx == nil {
+		db = iso
+	}
+	db.init()
+	if db.engine != Postgres {
+		panic("sqldb: Driver is not supported for " + string(db.engine) + " databases, use Stdlib instead")
+	}
 	return any(db.pool).(T)
 }
 
 // SupportedDrivers is a type list of all supported database drivers.
-// Currently only [*pgxpool.Pool] is supported.
+// Currently only [*pgxpool.Pool] is supported, for PostgreSQL databases.
 type SupportedDrivers interface {
 	*pgxpool.Pool
 }
@@ -307,7 +437,7 @@ func DriverConn[T SupportedDriverConns](conn *sql.Conn, f func(driverConn T) err
 }
 
 // SupportedDriverConns is a type list of all supported database drivers
-// connections. Currently only [*pgx.Conn] is supported.
+// connections. Currently only [*pgx.Conn] is supported, for PostgreSQL databases.
 type SupportedDriverConns interface {
 	*pgx.Conn
 }
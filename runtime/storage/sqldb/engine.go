@@ -0,0 +1,27 @@
+package sqldb
+
+// Engine is the database engine a database runs on.
+//
+// The engine is set with an "-- encore:engine" directive in the first
+// migration of the database, and defaults to PostgreSQL.
+// Queries are passed to the engine as-is, so they must use
+// its SQL dialect and placeholder syntax ($1 for PostgreSQL, ? for MySQL and SQLite).
+type Engine string
+
+const (
+	// Postgres is PostgreSQL, the default engine.
+	Postgres Engine = "postgres"
+
+	// MySQL is MySQL.
+	MySQL Engine = "mysql"
+
+	// SQLite is SQLite, which runs embedded in the application
+	// and stores each database in a file.
+	SQLite Engine = "sqlite"
+)
+
+// Engine reports the database engine of the database.
+func (db *Database) Engine() Engine {
+	db.init()
+	return db.engine
+}
@@ -0,0 +1,337 @@
+package sqldb
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"net"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"encore.dev/appruntime/exported/config"
+)
+
+// querier runs queries against a connection pool or a transaction,
+// abstracting over the drivers used for the different database engines.
+type querier interface {
+	exec(ctx context.Context, query string, args []any) (ExecResult, error)
+	query(ctx context.Context, query string, args []any) (rows, error)
+}
+
+// rows is the result of a query.
+// Scan errors must be reported by Err, like pgx does.
+type rows interface {
+	Close()
+	Err() error
+	Next() bool
+	Scan(dest ...any) error
+}
+
+// txConn is an open transaction.
+type txConn interface {
+	querier
+	commit(ctx context.Context) error
+	rollback(ctx context.Context) error
+
+	// savepoint starts a nested transaction using a savepoint.
+	savepoint(ctx context.Context) (txConn, error)
+}
+
+// The SQLite driver uses cgo, so it's only linked into apps that use it
+// (see engine_sqlite.go). Apps without SQLite databases are built with
+// the encore_no_sqlite build tag, leaving these nil.
+var (
+	sqliteDriver driver.Driver
+
+	// convertSQLiteError converts err to an *Error if it's an SQLite error.
+	convertSQLiteError func(err error) (*Error, bool)
+)
+
+// engineOf reports the database engine of the server srv.
+func engineOf(srv *config.SQLServer) (Engine, error) {
+	switch e := Engine(srv.Engine); e {
+	case "", Postgres:
+		return Postgres, nil
+	case MySQL, SQLite:
+		return e, nil
+	default:
+		return "", fmt.Errorf("unknown database engine %q", srv.Engine)
+	}
+}
+
+// pgxQuerier is implemented by both *pgxpool.Pool and pgx.Tx.
+type pgxQuerier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// pgxConn is a querier for PostgreSQL databases.
+type pgxConn struct {
+	c pgxQuerier
+}
+
+func (c pgxConn) exec(ctx context.Context, query string, args []any) (ExecResult, error) {
+	return c.c.Exec(ctx, query, args...)
+}
+
+func (c pgxConn) query(ctx context.Context, query string, args []any) (rows, error) {
+	r, err := c.c.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// pgxTx is a transaction on a PostgreSQL database.
+type pgxTx struct {
+	pgxConn
+	tx pgx.Tx
+}
+
+func newPgxTx(tx pgx.Tx) *pgxTx {
+	return &pgxTx{pgxConn: pgxConn{c: tx}, tx: tx}
+}
+
+func (tx *pgxTx) commit(ctx context.Context) error   { return tx.tx.Commit(ctx) }
+func (tx *pgxTx) rollback(ctx context.Context) error { return tx.tx.Rollback(ctx) }
+
+func (tx *pgxTx) savepoint(ctx context.Context) (txConn, error) {
+	sp, err := tx.tx.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newPgxTx(sp), nil
+}
+
+// pgxTxOptions returns the pgx options for a transaction with the given options.
+func pgxTxOptions(opts TxOptions) pgx.TxOptions {
+	o := pgx.TxOptions{IsoLevel: pgx.TxIsoLevel(opts.Isolation)}
+	if opts.ReadOnly {
+		o.AccessMode = pgx.ReadOnly
+	}
+	return o
+}
+
+// stdQuerier is implemented by both *sql.DB and *sql.Tx.
+type stdQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// stdConn is a querier for the engines accessed through database/sql,
+// which are MySQL and SQLite.
+type stdConn struct {
+	c stdQuerier
+}
+
+func (c stdConn) exec(ctx context.Context, query string, args []any) (ExecResult, error) {
+	res, err := c.c.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return stdResult{res: res}, nil
+}
+
+func (c stdConn) query(ctx context.Context, query string, args []any) (rows, error) {
+	r, err := c.c.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &stdRows{rows: r}, nil
+}
+
+// stdResult adapts a sql.Result to ExecResult.
+type stdResult struct {
+	res sql.Result
+}
+
+func (r stdResult) RowsAffected() int64 {
+	n, _ := r.res.RowsAffected()
+	return n
+}
+
+// stdRows adapts *sql.Rows to rows, recording scan errors
+// so they're reported by Err.
+type stdRows struct {
+	rows *sql.Rows
+	err  error
+}
+
+func (r *stdRows) Close()     { _ = r.rows.Close() }
+func (r *stdRows) Next() bool { return r.rows.Next() }
+
+func (r *stdRows) Scan(dest ...any) error {
+	err := r.rows.Scan(dest...)
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+	return err
+}
+
+func (r *stdRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// stdTx is a transaction on a database accessed through database/sql.
+// Nested transactions use savepoints, which are supported by both MySQL and SQLite.
+type stdTx struct {
+	stdConn
+	tx    *sql.Tx
+	depth int    // the nesting depth; zero for the outermost transaction
+	name  string // the name of the savepoint, if depth > 0
+}
+
+func newStdTx(tx *sql.Tx) *stdTx {
+	return &stdTx{stdConn: stdConn{c: tx}, tx: tx}
+}
+
+func (tx *stdTx) commit(ctx context.Context) error {
+	if tx.depth == 0 {
+		return tx.tx.Commit()
+	}
+	_, err := tx.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+tx.name)
+	return err
+}
+
+func (tx *stdTx) rollback(ctx context.Context) error {
+	if tx.depth == 0 {
+		return tx.tx.Rollback()
+	}
+	_, err := tx.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+tx.name)
+	return err
+}
+
+func (tx *stdTx) savepoint(ctx context.Context) (txConn, error) {
+	sp := &stdTx{stdConn: tx.stdConn, tx: tx.tx, depth: tx.depth + 1}
+	sp.name = fmt.Sprintf("encore_sp_%d", sp.depth)
+	if _, err := tx.tx.ExecContext(ctx, "SAVEPOINT "+sp.name); err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+// stdTxOptions returns the database/sql options for a transaction with the given options.
+// SQLite transactions are always serializable, so the isolation level is ignored.
+func stdTxOptions(engine Engine, opts TxOptions) *sql.TxOptions {
+	o := &sql.TxOptions{ReadOnly: opts.ReadOnly}
+	if engine == MySQL {
+		switch opts.Isolation {
+		case ReadCommitted:
+			o.Isolation = sql.LevelReadCommitted
+		case RepeatableRead:
+			o.Isolation = sql.LevelRepeatableRead
+		case Serializable:
+			o.Isolation = sql.LevelSerializable
+		}
+	}
+	return o
+}
+
+// stdConnector returns the connector for a database accessed through database/sql.
+// If password is non-nil it's called to get the password for each new connection.
+func stdConnector(engine Engine, srv *config.SQLServer, db *config.SQLDatabase, password func() string) (driver.Connector, error) {
+	switch engine {
+	case MySQL:
+		cfg, err := mysqlConf(srv, db)
+		if err != nil {
+			return nil, err
+		}
+		return &mysqlConnector{cfg: cfg, password: password}, nil
+	case SQLite:
+		if sqliteDriver == nil {
+			return nil, fmt.Errorf("the app was built without SQLite support")
+		}
+		return dsnConnector{dsn: sqliteDSN(srv, db), driver: sqliteDriver}, nil
+	default:
+		return nil, fmt.Errorf("engine %s is not accessed through database/sql", engine)
+	}
+}
+
+// mysqlConf computes a suitable MySQL config given a database config.
+func mysqlConf(srv *config.SQLServer, db *config.SQLDatabase) (*mysql.Config, error) {
+	cfg := mysql.NewConfig()
+	cfg.User = db.User
+	cfg.Passwd = db.Password
+	cfg.DBName = db.DatabaseName
+	cfg.ParseTime = true
+
+	// Handle different ways of expressing the host
+	if strings.HasPrefix(srv.Host, "/") {
+		cfg.Net, cfg.Addr = "unix", srv.Host // unix socket
+	} else if _, _, err := net.SplitHostPort(srv.Host); err == nil {
+		cfg.Net, cfg.Addr = "tcp", srv.Host // host:port
+	} else {
+		cfg.Net, cfg.Addr = "tcp", net.JoinHostPort(srv.Host, "3306") // hostname
+	}
+
+	if srv.ServerCACert != "" || srv.ClientCert != "" {
+		cfg.TLS = &tls.Config{}
+	}
+
+	// If we have a server CA, set it in the TLS config.
+	if srv.ServerCACert != "" {
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM([]byte(srv.ServerCACert)) {
+			return nil, fmt.Errorf("invalid server ca cert")
+		}
+		cfg.TLS.RootCAs = caCertPool
+	}
+
+	// If we have a client cert, set it in the TLS config.
+	if srv.ClientCert != "" {
+		cert, err := tls.X509KeyPair([]byte(srv.ClientCert), []byte(srv.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("parse client cert: %v", err)
+		}
+		cfg.TLS.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// mysqlConnector connects to a MySQL database,
+// using the latest password for each new connection.
+type mysqlConnector struct {
+	cfg      *mysql.Config
+	password func() string // nil if the password is fixed
+}
+
+func (c *mysqlConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	cfg := c.cfg
+	if c.password != nil {
+		cfg = cfg.Clone()
+		cfg.Passwd = c.password()
+	}
+	conn, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Connect(ctx)
+}
+
+func (c *mysqlConnector) Driver() driver.Driver {
+	return mysql.MySQLDriver{}
+}
+
+// sqliteDSN computes the data source name of an SQLite database given a database config.
+// The database is stored in a file named after the database in the server's directory.
+func sqliteDSN(srv *config.SQLServer, db *config.SQLDatabase) string {
+	path := filepath.Join(srv.Host, db.DatabaseName+".db")
+	params := url.Values{
+		"_busy_timeout": {"5000"},
+		"_foreign_keys": {"on"},
+		"_journal_mode": {"WAL"},
+	}
+	return path + "?" + params.Encode()
+}
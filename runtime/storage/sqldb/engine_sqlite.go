@@ -0,0 +1,30 @@
+//go:build !encore_no_sqlite
+
+package sqldb
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/mattn/go-sqlite3"
+
+	"encore.dev/storage/sqldb/sqlerr"
+)
+
+func init() {
+	sqliteDriver = &sqlite3.SQLiteDriver{}
+	convertSQLiteError = func(err error) (*Error, bool) {
+		var src sqlite3.Error
+		if !errors.As(err, &src) {
+			return nil, false
+		}
+		return &Error{
+			Code:         sqlerr.MapSQLiteCode(int(src.ExtendedCode)),
+			Severity:     sqlerr.SeverityError,
+			DatabaseCode: strconv.Itoa(int(src.ExtendedCode)),
+			Message:      src.Error(),
+			driverErr:    src,
+			engine:       SQLite,
+		}, true
+	}
+}
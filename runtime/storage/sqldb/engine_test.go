@@ -0,0 +1,121 @@
+package sqldb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/rs/zerolog"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/shared/reqtrack"
+	"encore.dev/storage/sqldb/sqlerr"
+)
+
+func TestSQLite(t *testing.T) {
+	runtime := &config.Runtime{
+		SQLServers:   []*config.SQLServer{{Host: t.TempDir(), Engine: "sqlite"}},
+		SQLDatabases: []*config.SQLDatabase{{EncoreName: "test", DatabaseName: "test"}},
+	}
+	mgr := NewManager(runtime, reqtrack.New(zerolog.Logger{}, nil, nil), nil, nil, zerolog.Logger{})
+	defer mgr.Shutdown(context.Background())
+
+	ctx := context.Background()
+	db := mgr.Named("test")
+	if got := db.Engine(); got != SQLite {
+		t.Fatalf("got engine %q, want %q", got, SQLite)
+	}
+
+	if _, err := db.Exec(ctx, "CREATE TABLE item (id INTEGER PRIMARY KEY, name TEXT NOT NULL UNIQUE)"); err != nil {
+		t.Fatal(err)
+	}
+	res, err := db.Exec(ctx, "INSERT INTO item (id, name) VALUES (?, ?), (?, ?)", 1, "one", 2, "two")
+	if err != nil {
+		t.Fatal(err)
+	} else if n := res.RowsAffected(); n != 2 {
+		t.Fatalf("got %d rows affected, want 2", n)
+	}
+
+	// Database errors are mapped to error codes.
+	_, err = db.Exec(ctx, "INSERT INTO item (id, name) VALUES (?, ?)", 3, "one")
+	if code := ErrCode(err); code != sqlerr.UniqueViolation {
+		t.Fatalf("got code %q for %v, want %q", code, err, sqlerr.UniqueViolation)
+	}
+	_, err = db.Exec(ctx, "INSERT INTO item (id) VALUES (?)", 3)
+	if code := ErrCode(err); code != sqlerr.NotNullViolation {
+		t.Fatalf("got code %q for %v, want %q", code, err, sqlerr.NotNullViolation)
+	}
+
+	var name string
+	if err := db.QueryRow(ctx, "SELECT name FROM item WHERE id = ?", 2).Scan(&name); err != nil {
+		t.Fatal(err)
+	} else if name != "two" {
+		t.Fatalf("got name %q, want two", name)
+	}
+	if err := db.QueryRow(ctx, "SELECT name FROM item WHERE id = ?", 3).Scan(&name); !errors.Is(err, ErrNoRows) {
+		t.Fatalf("got err %v, want ErrNoRows", err)
+	}
+
+	// Nested transactions roll back to their savepoint.
+	err = db.InTx(ctx, nil, func(tx *Tx) error {
+		if _, err := tx.Exec(ctx, "INSERT INTO item (id, name) VALUES (?, ?)", 3, "three"); err != nil {
+			return err
+		}
+		nestedErr := tx.InTx(ctx, func(tx *Tx) error {
+			if _, err := tx.Exec(ctx, "INSERT INTO item (id, name) VALUES (?, ?)", 4, "four"); err != nil {
+				return err
+			}
+			return errors.New("rollback")
+		})
+		if nestedErr == nil {
+			t.Error("nested transaction: got nil error")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Stdlib().QueryContext(ctx, "SELECT id FROM item ORDER BY id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	} else if len(ids) != 3 || ids[2] != 3 {
+		t.Fatalf("got ids %v, want [1 2 3]", ids)
+	}
+}
+
+func TestConvertMySQLError(t *testing.T) {
+	tests := []struct {
+		number uint16
+		want   sqlerr.Code
+	}{
+		{number: 1062, want: sqlerr.UniqueViolation},
+		{number: 1452, want: sqlerr.ForeignKeyViolation},
+		{number: 1048, want: sqlerr.NotNullViolation},
+		{number: 1213, want: sqlerr.DeadlockDetected},
+		{number: 1064, want: sqlerr.Other},
+	}
+	for _, tt := range tests {
+		src := &mysql.MySQLError{Number: tt.number, Message: "some error"}
+		err := convertErr(src)
+		if got := ErrCode(err); got != tt.want {
+			t.Errorf("ErrCode(%v) = %v, want %v", err, got, tt.want)
+		}
+		if !errors.Is(err, src) {
+			t.Errorf("convertErr(%v) does not wrap the driver error", src)
+		}
+	}
+}
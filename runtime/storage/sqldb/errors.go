@@ -3,7 +3,9 @@ package sqldb
 import (
 	"database/sql"
 	"errors"
+	"strconv"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5"
 
@@ -28,6 +30,7 @@ func ErrCode(err error) sqlerr.Code {
 // Note that the fields for schema name, table name, column name, data type name,
 // and constraint name are supplied only for a limited number of error types;
 // see https://www.postgresql.org/docs/current/errcodes-appendix.html.
+// They are never supplied for MySQL and SQLite databases.
 //
 // You should not assume that the presence of any of these fields guarantees
 // the presence of another field.
@@ -40,7 +43,8 @@ type Error struct {
 	Severity sqlerr.Severity
 
 	// DatabaseCode is the database server-specific error code.
-	// It is specific to the underlying database server.
+	// It is specific to the underlying database server: the SQLSTATE code
+	// for PostgreSQL, the error number for MySQL and the extended result code for SQLite.
 	DatabaseCode string
 
 	// Message: the primary human-readable error message. This should be accurate
@@ -73,10 +77,21 @@ type Error struct {
 	// It's used to support errors.As and errors.Is to preserve
 	// backwards compatibility.
 	driverErr error
+
+	// engine is the engine that reported the error,
+	// or "" for PostgreSQL.
+	engine Engine
 }
 
 func (pe *Error) Error() string {
-	return string(pe.Severity) + ": " + pe.Message + " (Code " + string(pe.Code) + ": SQLSTATE " + pe.DatabaseCode + ")"
+	codeName := "SQLSTATE"
+	switch pe.engine {
+	case MySQL:
+		codeName = "MySQL error"
+	case SQLite:
+		codeName = "SQLite error"
+	}
+	return string(pe.Severity) + ": " + pe.Message + " (Code " + string(pe.Code) + ": " + codeName + " " + pe.DatabaseCode + ")"
 }
 
 func (pe *Error) Unwrap() error {
@@ -84,9 +99,19 @@ func (pe *Error) Unwrap() error {
 }
 
 func convertErr(err error) error {
-	var pgerr *pgconn.PgError
-	if errors.As(err, &pgerr) {
+	var (
+		pgerr    *pgconn.PgError
+		mysqlErr *mysql.MySQLError
+	)
+	switch {
+	case errors.As(err, &pgerr):
 		err = convertPgError(pgerr)
+	case errors.As(err, &mysqlErr):
+		err = convertMySQLError(mysqlErr)
+	case convertSQLiteError != nil:
+		if sqliteErr, ok := convertSQLiteError(err); ok {
+			err = sqliteErr
+		}
 	}
 
 	switch err {
@@ -114,3 +139,14 @@ func convertPgError(src *pgconn.PgError) error {
 		driverErr:      src,
 	}
 }
+
+func convertMySQLError(src *mysql.MySQLError) error {
+	return &Error{
+		Code:         sqlerr.MapMySQLCode(src.Number),
+		Severity:     sqlerr.SeverityError,
+		DatabaseCode: strconv.Itoa(int(src.Number)),
+		Message:      src.Message,
+		driverErr:    src,
+		engine:       MySQL,
+	}
+}
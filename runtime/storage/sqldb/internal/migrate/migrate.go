@@ -0,0 +1,258 @@
+// Package migrate applies database migrations to PostgreSQL databases.
+//
+// It follows the same rules as the Encore daemon, which uses golang-migrate:
+// migrations are applied in order of their version number, the current version
+// is tracked in the schema_migrations table, and concurrent runs against the
+// same database are serialized using the same advisory lock.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// NilVersion is the version of a database without any applied migrations.
+const NilVersion int64 = -1
+
+// versionTable is the name of the table tracking the current version.
+const versionTable = "schema_migrations"
+
+// advisoryLockSalt is the salt golang-migrate uses when computing advisory lock ids.
+const advisoryLockSalt uint32 = 1486364155
+
+// Migration is a database migration file.
+type Migration struct {
+	Version  int64
+	Filename string
+	Data     []byte
+}
+
+// LoadDir loads the up migrations in dir, ordered by version.
+// If dir does not exist it reports no migrations.
+func LoadDir(dir string) ([]*Migration, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var migrations []*Migration
+	seen := make(map[int64]string)
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".up.sql") {
+			continue
+		}
+		version, err := parseVersion(name)
+		if err != nil {
+			return nil, err
+		} else if other, ok := seen[version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, other, name)
+		}
+		seen[version] = name
+
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, &Migration{Version: version, Filename: name, Data: data})
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// parseVersion parses the version number prefix of a migration filename,
+// as in "1_create_table.up.sql".
+func parseVersion(filename string) (int64, error) {
+	num, _, _ := strings.Cut(filename, "_")
+	version, err := strconv.ParseInt(num, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("invalid migration filename %s: must start with a positive number followed by '_'", filename)
+	}
+	return version, nil
+}
+
+// Status describes the migration status of a database.
+type Status struct {
+	// Version is the version of the latest applied migration,
+	// or NilVersion if no migrations have been applied.
+	Version int64
+
+	// Dirty reports whether applying the migration at Version failed.
+	// It is retried by Up.
+	Dirty bool
+
+	// Pending are the migrations that have not been applied yet.
+	Pending []*Migration
+}
+
+// GetStatus returns the migration status of the database conn is connected to.
+func GetStatus(ctx context.Context, conn *pgx.Conn, migrations []*Migration) (*Status, error) {
+	table, err := tableName(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	version, dirty, err := currentVersion(ctx, conn, table)
+	if err != nil {
+		return nil, err
+	}
+	return &Status{
+		Version: version,
+		Dirty:   dirty,
+		Pending: pending(migrations, retryVersion(version, dirty)),
+	}, nil
+}
+
+// Up applies the pending migrations to the database conn is connected to,
+// calling onApply before applying each migration.
+func Up(ctx context.Context, conn *pgx.Conn, migrations []*Migration, onApply func(*Migration)) error {
+	table, err := tableName(ctx, conn)
+	if err != nil {
+		return err
+	}
+	var dbName string
+	if err := conn.QueryRow(ctx, "SELECT CURRENT_DATABASE()").Scan(&dbName); err != nil {
+		return fmt.Errorf("get database name: %v", err)
+	}
+
+	lockID := advisoryLockID(dbName, table[0], table[1])
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", lockID); err != nil {
+		return fmt.Errorf("acquire migration lock: %v", err)
+	}
+	defer func() {
+		// Use a fresh context so the lock is released even if ctx is canceled.
+		_, _ = conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", lockID)
+	}()
+
+	if err := ensureVersionTable(ctx, conn, table); err != nil {
+		return err
+	}
+	version, dirty, err := currentVersion(ctx, conn, table)
+	if err != nil {
+		return err
+	}
+
+	// If the last migration failed, reset the dirty flag and try again.
+	// This is safe since each migration runs inside a single transaction.
+	if dirty {
+		version = retryVersion(version, dirty)
+		if err := setVersion(ctx, conn, table, version, false); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range pending(migrations, version) {
+		if onApply != nil {
+			onApply(m)
+		}
+		if err := setVersion(ctx, conn, table, m.Version, true); err != nil {
+			return err
+		}
+		// Use the simple protocol so the migration can contain multiple statements,
+		// which Postgres executes in a single transaction.
+		if _, err := conn.PgConn().Exec(ctx, string(m.Data)).ReadAll(); err != nil {
+			return fmt.Errorf("migration %s failed: %v", m.Filename, err)
+		}
+		if err := setVersion(ctx, conn, table, m.Version, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retryVersion returns the version to migrate from, given the current version.
+// A dirty version is retried, matching how the daemon handles failed migrations.
+func retryVersion(version int64, dirty bool) int64 {
+	if !dirty {
+		return version
+	}
+	version--
+	// golang-migrate uses -1 to mean "no version", not 0.
+	if version == 0 {
+		version = NilVersion
+	}
+	return version
+}
+
+// pending returns the migrations after version.
+func pending(migrations []*Migration, version int64) []*Migration {
+	var out []*Migration
+	for _, m := range migrations {
+		if m.Version > version {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// advisoryLockID computes the advisory lock id golang-migrate uses for the
+// version table in the given schema and database.
+func advisoryLockID(dbName, schema, table string) int64 {
+	sum := crc32.ChecksumIEEE([]byte(schema + "\x00" + table + "\x00" + dbName))
+	return int64(sum * advisoryLockSalt)
+}
+
+// tableName returns the qualified name of the version table,
+// which lives in the current schema.
+func tableName(ctx context.Context, conn *pgx.Conn) (pgx.Identifier, error) {
+	var schema string
+	if err := conn.QueryRow(ctx, "SELECT CURRENT_SCHEMA()").Scan(&schema); err != nil {
+		return nil, fmt.Errorf("get current schema: %v", err)
+	}
+	return pgx.Identifier{schema, versionTable}, nil
+}
+
+func ensureVersionTable(ctx context.Context, conn *pgx.Conn, table pgx.Identifier) error {
+	_, err := conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS `+table.Sanitize()+` (version bigint not null primary key, dirty boolean not null)`)
+	if err != nil {
+		return fmt.Errorf("create version table: %v", err)
+	}
+	return nil
+}
+
+func currentVersion(ctx context.Context, conn *pgx.Conn, table pgx.Identifier) (version int64, dirty bool, err error) {
+	err = conn.QueryRow(ctx, `SELECT version, dirty FROM `+table.Sanitize()+` LIMIT 1`).Scan(&version, &dirty)
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return NilVersion, false, nil
+	case errors.As(err, &pgErr) && pgErr.Code == "42P01": // undefined_table
+		return NilVersion, false, nil
+	case err != nil:
+		return 0, false, fmt.Errorf("get current version: %v", err)
+	default:
+		return version, dirty, nil
+	}
+}
+
+func setVersion(ctx context.Context, conn *pgx.Conn, table pgx.Identifier, version int64, dirty bool) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("set version: %v", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `TRUNCATE `+table.Sanitize()); err != nil {
+		return fmt.Errorf("set version: %v", err)
+	}
+	if version >= 0 || dirty {
+		if _, err := tx.Exec(ctx, `INSERT INTO `+table.Sanitize()+` (version, dirty) VALUES ($1, $2)`, version, dirty); err != nil {
+			return fmt.Errorf("set version: %v", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("set version: %v", err)
+	}
+	return nil
+}
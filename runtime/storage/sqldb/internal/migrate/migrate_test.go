@@ -0,0 +1,95 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	for name, data := range map[string]string{
+		"10_add_index.up.sql":   "CREATE INDEX foo_idx ON foo (id);",
+		"2_add_column.up.sql":   "ALTER TABLE foo ADD COLUMN bar TEXT;",
+		"1_create_table.up.sql": "CREATE TABLE foo (id INT);",
+		"README.md":             "not a migration",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	migrations, err := LoadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, m := range migrations {
+		names = append(names, m.Filename)
+	}
+	if want := []string{"1_create_table.up.sql", "2_add_column.up.sql", "10_add_index.up.sql"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got migrations %v, want %v", names, want)
+	}
+	if got := migrations[2].Version; got != 10 {
+		t.Errorf("got version %d, want 10", got)
+	}
+	if got := string(migrations[0].Data); got != "CREATE TABLE foo (id INT);" {
+		t.Errorf("got data %q", got)
+	}
+
+	// A missing directory has no migrations.
+	if migrations, err := LoadDir(filepath.Join(dir, "missing")); err != nil || len(migrations) != 0 {
+		t.Errorf("got %v, %v for missing directory, want no migrations", migrations, err)
+	}
+
+	// Duplicate versions are rejected.
+	if err := os.WriteFile(filepath.Join(dir, "2_other.up.sql"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadDir(dir); err == nil || !strings.HasPrefix(err.Error(), "duplicate migration version 2") {
+		t.Errorf("got err %v, want duplicate migration version", err)
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	if v, err := parseVersion("0042_foo.up.sql"); err != nil || v != 42 {
+		t.Errorf("got %d, %v, want 42", v, err)
+	}
+	for _, name := range []string{"foo.up.sql", "0_foo.up.sql", "-1_foo.up.sql"} {
+		if _, err := parseVersion(name); err == nil {
+			t.Errorf("parseVersion(%q): got nil err, want invalid filename", name)
+		}
+	}
+}
+
+func TestPending(t *testing.T) {
+	migrations := []*Migration{{Version: 1}, {Version: 2}, {Version: 3}}
+	tests := []struct {
+		version int64
+		dirty   bool
+		want    []*Migration
+	}{
+		{NilVersion, false, migrations},
+		{2, false, migrations[2:]},
+		{3, false, nil},
+		// Dirty versions are retried.
+		{2, true, migrations[1:]},
+		{1, true, migrations},
+	}
+	for _, tt := range tests {
+		got := pending(migrations, retryVersion(tt.version, tt.dirty))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("pending(version=%d, dirty=%v): got %d migrations, want %d", tt.version, tt.dirty, len(got), len(tt.want))
+		}
+	}
+}
+
+func TestAdvisoryLockID(t *testing.T) {
+	// Computed by golang-migrate's database.GenerateAdvisoryLockId("todo", "public", "schema_migrations"),
+	// which the daemon uses when migrating the same database.
+	if got := advisoryLockID("todo", "public", "schema_migrations"); got != 817179364 {
+		t.Errorf("got lock id %d, want 817179364", got)
+	}
+}
@@ -0,0 +1,323 @@
+package sqldb
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/exported/model"
+	"encore.dev/appruntime/shared/testsupport"
+)
+
+// testDBKey identifies the isolated database of a test.
+type testDBKey struct {
+	t    *testing.T
+	name string
+}
+
+// testDB is the isolated database of a test, set up the first time the test uses it.
+type testDB struct {
+	once sync.Once
+	db   *Database
+	err  error
+
+	// subtests is held by the subtest whose database is nested in db's transaction,
+	// for RollbackDB isolation. Subtests take turns using the transaction.
+	subtests sync.Mutex
+}
+
+// cloneMaxConns is the maximum number of connections to each database cloned for a test,
+// to avoid exhausting the connections of the server when many tests run in parallel.
+const cloneMaxConns = 4
+
+// forTest returns the database to use for the current test, according to how
+// tests are isolated from each other's database changes. Outside of tests,
+// or if tests share databases, it returns db itself.
+func (db *Database) forTest() (*Database, error) {
+	if db.test || db.mgr.ts == nil {
+		return db, nil
+	}
+	mode := db.mgr.ts.DBIsolation()
+	if mode == testsupport.SharedDB {
+		return db, nil
+	}
+	curr := db.mgr.rt.Current()
+	if curr.Req == nil || curr.Req.Test == nil {
+		return db, nil
+	}
+	t := curr.Req.Test.Current
+
+	var parent *testDB
+	if mode == testsupport.RollbackDB {
+		parent = db.parentTestDB(curr.Req.Test.Parent)
+	}
+
+	key := testDBKey{t: t, name: db.name}
+	db.mgr.mu.Lock()
+	td, ok := db.mgr.testDBs[key]
+	if !ok {
+		td = &testDB{}
+		db.mgr.testDBs[key] = td
+	}
+	db.mgr.mu.Unlock()
+
+	td.once.Do(func() {
+		switch mode {
+		case testsupport.RollbackDB:
+			td.db, td.err = db.rollbackDB(t, parent)
+		case testsupport.CloneDB:
+			td.db, td.err = db.cloneDB(t)
+		default:
+			td.err = fmt.Errorf("unknown isolation mode %d", mode)
+		}
+		if td.err != nil {
+			td.err = fmt.Errorf("sqldb: isolate database %s for test: %v", db.name, td.err)
+		}
+		t.Cleanup(func() {
+			db.mgr.mu.Lock()
+			delete(db.mgr.testDBs, key)
+			db.mgr.mu.Unlock()
+		})
+	})
+	return td.db, td.err
+}
+
+// parentTestDB returns the isolated database of the closest ancestor test
+// of a test with parent request req that has one. It reports nil if there is none.
+func (db *Database) parentTestDB(req *model.Request) *testDB {
+	db.mgr.mu.Lock()
+	defer db.mgr.mu.Unlock()
+	for ; req != nil && req.Test != nil; req = req.Test.Parent {
+		if td, ok := db.mgr.testDBs[testDBKey{t: req.Test.Current, name: db.name}]; ok {
+			return td
+		}
+	}
+	return nil
+}
+
+// rollbackDB returns a database for the test t whose queries run in
+// a transaction that is rolled back when the test ends.
+//
+// If the test is a subtest of a test with its own transaction, the subtest
+// runs in a savepoint of that transaction instead. Otherwise the subtest
+// would deadlock when writing rows conflicting with those written by its parent,
+// as the parent's transaction can't end before the subtest does.
+func (db *Database) rollbackDB(t *testing.T, parent *testDB) (*Database, error) {
+	db.init()
+	var tx *lockedTx
+	if parent != nil && parent.db != nil && parent.db.testTx != nil {
+		parent.subtests.Lock()
+		sp, err := parent.db.testTx.savepoint(context.Background())
+		if err != nil {
+			parent.subtests.Unlock()
+			return nil, err
+		}
+		tx = sp.(*lockedTx)
+		t.Cleanup(func() {
+			_ = tx.rollback(context.Background())
+			parent.subtests.Unlock()
+		})
+	} else {
+		conn, err := db.beginConn(context.Background(), TxOptions{})
+		if err != nil {
+			return nil, err
+		}
+		tx = &lockedTx{mu: new(sync.Mutex), tx: conn}
+		t.Cleanup(func() {
+			_ = tx.rollback(context.Background())
+		})
+	}
+
+	iso := &Database{
+		name:      db.name,
+		mgr:       db.mgr,
+		engine:    db.engine,
+		pool:      db.pool,
+		connStr:   db.connStr,
+		std:       db.std,
+		connector: db.connector,
+		test:      true,
+		testTx:    tx,
+	}
+	iso.initOnce.Do(func() {}) // it shares db's pools
+	return iso, nil
+}
+
+// cloneDB returns a fresh database for the test t, cloned from the template
+// database with the migrated schema. It's dropped when the test ends.
+func (db *Database) cloneDB(t *testing.T) (*Database, error) {
+	srv, cfg := db.mgr.dbConfig(db.name)
+	if cfg.TemplateName == "" {
+		return nil, errors.New("there is no template database to clone; use RollbackDB isolation instead")
+	}
+	engine, err := engineOf(srv)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := *cfg
+	clone.DatabaseName = cfg.DatabaseName + "__test_" + randSuffix()
+	clone.TemplateName = ""
+	clone.Replicas = nil
+	if clone.MaxConnections <= 0 || clone.MaxConnections > cloneMaxConns {
+		clone.MaxConnections = cloneMaxConns
+	}
+
+	switch engine {
+	case Postgres:
+		err = db.mgr.clonePostgres(cfg, clone.DatabaseName)
+	case SQLite:
+		err = cloneSQLite(srv, cfg.TemplateName, clone.DatabaseName)
+	default:
+		err = fmt.Errorf("cloning %s databases is not supported; use RollbackDB isolation instead", engine)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	iso := &Database{name: db.name, mgr: db.mgr, test: true}
+	db.mgr.openPoolsFor(iso, srv, &clone)
+	t.Cleanup(func() {
+		iso.shutdown(context.Background())
+		var err error
+		if engine == Postgres {
+			err = db.mgr.dropPostgresClone(cfg, clone.DatabaseName)
+		} else {
+			err = dropSQLiteClone(srv, clone.DatabaseName)
+		}
+		if err != nil {
+			db.mgr.rootLog.Error().Err(err).Str("db", clone.DatabaseName).Msg("sqldb: failed to drop test database")
+		}
+	})
+	return iso, nil
+}
+
+// clonePostgres creates the database name from the template of the PostgreSQL database cfg.
+func (mgr *Manager) clonePostgres(cfg *config.SQLDatabase, name string) error {
+	stmt := fmt.Sprintf("CREATE DATABASE %s TEMPLATE %s",
+		pgx.Identifier{name}.Sanitize(), pgx.Identifier{cfg.TemplateName}.Sanitize())
+	return mgr.execAdmin(cfg, stmt)
+}
+
+// dropPostgresClone drops the database name cloned from the PostgreSQL database cfg.
+func (mgr *Manager) dropPostgresClone(cfg *config.SQLDatabase, name string) error {
+	return mgr.execAdmin(cfg, "DROP DATABASE IF EXISTS "+pgx.Identifier{name}.Sanitize())
+}
+
+// execAdmin executes the statement stmt using a connection to the PostgreSQL database cfg.
+// PostgreSQL reports an error when creating or dropping databases while another
+// session is using the template or the database, so it's retried a few times.
+func (mgr *Manager) execAdmin(cfg *config.SQLDatabase, stmt string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, err := mgr.connect(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	for attempt := 0; ; attempt++ {
+		_, err = conn.Exec(ctx, stmt)
+		var pgErr *pgconn.PgError
+		if err == nil || attempt >= 10 || !errors.As(err, &pgErr) || pgErr.Code != "55006" { // object_in_use
+			return err
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// cloneSQLite creates the SQLite database name by copying the template database.
+// The template is never written to once created, so it's safe to copy.
+func cloneSQLite(srv *config.SQLServer, template, name string) error {
+	src, err := os.Open(filepath.Join(srv.Host, template+".db"))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = src.Close() }()
+
+	dst, err := os.OpenFile(filepath.Join(srv.Host, name+".db"), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	return dst.Close()
+}
+
+// dropSQLiteClone removes the files of the SQLite database name.
+func dropSQLiteClone(srv *config.SQLServer, name string) error {
+	path := filepath.Join(srv.Host, name+".db")
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		if err := os.Remove(path + suffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// lockedTx serializes the use of a transaction shared by the goroutines of a test.
+// As the transaction uses a single connection, rows must still be closed before
+// running another query.
+type lockedTx struct {
+	mu *sync.Mutex
+	tx txConn
+}
+
+func (l *lockedTx) exec(ctx context.Context, query string, args []any) (ExecResult, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.tx.exec(ctx, query, args)
+}
+
+func (l *lockedTx) query(ctx context.Context, query string, args []any) (rows, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.tx.query(ctx, query, args)
+}
+
+func (l *lockedTx) commit(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.tx.commit(ctx)
+}
+
+func (l *lockedTx) rollback(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.tx.rollback(ctx)
+}
+
+func (l *lockedTx) savepoint(ctx context.Context) (txConn, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	sp, err := l.tx.savepoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &lockedTx{mu: l.mu, tx: sp}, nil
+}
+
+// randSuffix returns a random suffix for the names of databases cloned for tests.
+func randSuffix() string {
+	var b [6]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic("sqldb: unable to generate random data: " + err.Error())
+	}
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,144 @@
+package sqldb
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/shared/reqtrack"
+	"encore.dev/appruntime/shared/testsupport"
+	"encore.dev/storage/sqldb/sqlerr"
+)
+
+func TestTestIsolation(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	runtime := &config.Runtime{
+		SQLServers: []*config.SQLServer{{Host: dir, Engine: "sqlite"}},
+		SQLDatabases: []*config.SQLDatabase{
+			{EncoreName: "test", DatabaseName: "test", TemplateName: "test__template"},
+		},
+	}
+	rt := reqtrack.New(zerolog.Logger{}, nil, nil)
+	ts := testsupport.NewManager(&config.Static{}, rt, zerolog.Logger{})
+	mgr := NewManager(runtime, rt, ts, nil, zerolog.Logger{})
+	defer mgr.Shutdown(ctx)
+
+	// Set up the migrated schema and the template, like the daemon does.
+	db := mgr.Named("test")
+	if _, err := db.Exec(ctx, "CREATE TABLE item (name TEXT PRIMARY KEY)"); err != nil {
+		t.Fatal(err)
+	} else if _, err := db.Exec(ctx, "VACUUM INTO ?", filepath.Join(dir, "test__template.db")); err != nil {
+		t.Fatal(err)
+	}
+
+	// runTest inserts the same row in every test, which only succeeds if the tests are isolated.
+	runTest := func(t *testing.T) {
+		ts.StartTest(t)
+		defer ts.EndTest(t)
+
+		if _, err := db.Exec(ctx, "INSERT INTO item (name) VALUES (?)", "one"); err != nil {
+			t.Fatal(err)
+		}
+		var n int
+		if err := db.QueryRow(ctx, "SELECT COUNT(*) FROM item").Scan(&n); err != nil {
+			t.Fatal(err)
+		} else if n != 1 {
+			t.Fatalf("got %d rows, want 1", n)
+		}
+
+		// Transactions begun by the test are isolated too.
+		err := db.InTx(ctx, nil, func(tx *Tx) error {
+			_, err := tx.Exec(ctx, "INSERT INTO item (name) VALUES (?)", "one")
+			return err
+		})
+		if code := ErrCode(err); code != sqlerr.UniqueViolation {
+			t.Fatalf("got code %q for %v, want %q", code, err, sqlerr.UniqueViolation)
+		}
+	}
+
+	countRows := func() int {
+		t.Helper()
+		var n int
+		if err := db.QueryRow(ctx, "SELECT COUNT(*) FROM item").Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	t.Run("rollback", func(t *testing.T) {
+		ts.SetDBIsolation(testsupport.RollbackDB)
+		defer ts.SetDBIsolation(testsupport.SharedDB)
+		t.Run("first", runTest)
+		t.Run("second", runTest)
+	})
+	if n := countRows(); n != 0 {
+		t.Fatalf("got %d rows after rolled back tests, want 0", n)
+	}
+
+	// Subtests run in their parent's transaction, so they can write rows
+	// conflicting with the parent's without waiting for its transaction to end.
+	t.Run("rollback_subtest", func(t *testing.T) {
+		ts.SetDBIsolation(testsupport.RollbackDB)
+		defer ts.SetDBIsolation(testsupport.SharedDB)
+		ts.StartTest(t)
+		defer ts.EndTest(t)
+
+		if _, err := db.Exec(ctx, "INSERT INTO item (name) VALUES (?)", "one"); err != nil {
+			t.Fatal(err)
+		}
+		parent := rt.Current().Req
+		t.Run("sub", func(t *testing.T) {
+			// Goroutines inherit the current request in Encore apps, but not here.
+			rt.BeginRequest(parent)
+			ts.StartTest(t)
+			defer ts.EndTest(t)
+
+			if _, err := db.Exec(ctx, "UPDATE item SET name = ? WHERE name = ?", "two", "one"); err != nil {
+				t.Fatal(err)
+			}
+			var name string
+			if err := db.QueryRow(ctx, "SELECT name FROM item").Scan(&name); err != nil {
+				t.Fatal(err)
+			} else if name != "two" {
+				t.Fatalf("got name %q, want %q", name, "two")
+			}
+		})
+
+		// The subtest's changes are rolled back when it ends.
+		var name string
+		if err := db.QueryRow(ctx, "SELECT name FROM item").Scan(&name); err != nil {
+			t.Fatal(err)
+		} else if name != "one" {
+			t.Fatalf("got name %q after subtest, want %q", name, "one")
+		}
+	})
+	if n := countRows(); n != 0 {
+		t.Fatalf("got %d rows after rolled back tests, want 0", n)
+	}
+
+	t.Run("clone", func(t *testing.T) {
+		ts.SetDBIsolation(testsupport.CloneDB)
+		defer ts.SetDBIsolation(testsupport.SharedDB)
+		t.Run("first", runTest)
+		t.Run("second", runTest)
+	})
+	if n := countRows(); n != 0 {
+		t.Fatalf("got %d rows after cloned tests, want 0", n)
+	}
+
+	// The clones are dropped when the tests end.
+	files, err := filepath.Glob(filepath.Join(dir, "test__test_*"))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(files) > 0 {
+		t.Fatalf("got leftover test databases %v", files)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "test__template.db")); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -2,11 +2,16 @@ package sqldb
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"sync"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
 
 	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/infrasdk/secrets"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/testsupport"
 )
@@ -16,21 +21,27 @@ type Manager struct {
 	runtime *config.Runtime
 	rt      *reqtrack.RequestTracker
 	ts      *testsupport.Manager
+	secrets *secrets.Manager
+	rootLog zerolog.Logger
 
-	mu  sync.RWMutex
-	dbs map[string]*Database
+	mu      sync.RWMutex
+	dbs     map[string]*Database
+	testDBs map[testDBKey]*testDB // the isolated databases of tests
 
 	// Accessed atomically
 	txidCtr  uint64
 	queryCtr uint64
 }
 
-func NewManager(runtime *config.Runtime, rt *reqtrack.RequestTracker, ts *testsupport.Manager) *Manager {
+func NewManager(runtime *config.Runtime, rt *reqtrack.RequestTracker, ts *testsupport.Manager, secrets *secrets.Manager, rootLog zerolog.Logger) *Manager {
 	return &Manager{
 		runtime: runtime,
 		rt:      rt,
 		ts:      ts,
+		secrets: secrets,
+		rootLog: rootLog,
 		dbs:     make(map[string]*Database),
+		testDBs: make(map[testDBKey]*testDB),
 	}
 }
 
@@ -64,33 +75,82 @@ func (mgr *Manager) GetDB(dbName string) *Database {
 	db = &Database{
 		name: dbName,
 		mgr:  mgr,
-		pool: mgr.getPool(dbName),
 	}
+	mgr.openPools(db)
 	mgr.dbs[dbName] = db
 	return db
 }
 
-// getPool returns a database connection pool for the given database name.
-// Each time it's called it returns a new pool.
-func (mgr *Manager) getPool(dbName string) *pgxpool.Pool {
-	var db *config.SQLDatabase
-	for _, d := range mgr.runtime.SQLDatabases {
-		if d.EncoreName == dbName {
-			db = d
-			break
+// openPools opens the database connection pools for the database d:
+// the pool for the primary, and one for each read replica along with the maximum
+// replication lag for using them. Each time it's called it opens new pools.
+//
+// Read replicas are only supported for PostgreSQL databases,
+// and are ignored for other engines.
+func (mgr *Manager) openPools(d *Database) {
+	srv, db := mgr.dbConfig(d.name)
+	mgr.openPoolsFor(d, srv, db)
+}
+
+// dbConfig returns the config for the database with the given name, and its server.
+func (mgr *Manager) dbConfig(name string) (*config.SQLServer, *config.SQLDatabase) {
+	for _, cfg := range mgr.runtime.SQLDatabases {
+		if cfg.EncoreName == name {
+			return mgr.runtime.SQLServers[cfg.ServerID], cfg
+		}
+	}
+	panic("sqldb: unknown database: " + name)
+}
+
+// openPoolsFor is like openPools, but for the database db on the server srv.
+func (mgr *Manager) openPoolsFor(d *Database, srv *config.SQLServer, db *config.SQLDatabase) {
+	engine, err := engineOf(srv)
+	if err != nil {
+		panic("sqldb: " + err.Error())
+	}
+	d.engine = engine
+	if engine != Postgres {
+		d.std, d.connector = mgr.newStdPool(engine, srv, db)
+		return
+	}
+
+	d.pool = mgr.newPool(srv, db)
+	for _, r := range db.Replicas {
+		srv := mgr.runtime.SQLServers[r.ServerID]
+		replicaDB := *db
+		if r.DatabaseName != "" {
+			replicaDB.DatabaseName = r.DatabaseName
 		}
+		d.replicas = append(d.replicas, &replica{
+			node: "replica:" + srv.Host,
+			pool: mgr.newPool(srv, &replicaDB),
+		})
 	}
-	if db == nil {
-		panic("sqldb: unknown database: " + dbName)
+
+	d.maxLag = db.MaxReplicaLag
+	if d.maxLag <= 0 {
+		d.maxLag = defaultMaxReplicaLag
 	}
+}
 
-	srv := mgr.runtime.SQLServers[db.ServerID]
+// newPool returns a new connection pool for the database db on the server srv.
+func (mgr *Manager) newPool(srv *config.SQLServer, db *config.SQLDatabase) *pgxpool.Pool {
 	cfg, err := dbConf(srv, db)
 	if err != nil {
 		panic("sqldb: " + err.Error())
 	}
 
 	cfg.ConnConfig.Tracer = &pgxTracer{mgr: mgr}
+
+	// If the password is stored in a secret, use its latest value
+	// for each new connection so rotated credentials are picked up.
+	if db.PasswordSecret != "" {
+		password := mgr.secrets.Watch(db.PasswordSecret)
+		cfg.BeforeConnect = func(ctx context.Context, cc *pgx.ConnConfig) error {
+			cc.Password = password.Get()
+			return nil
+		}
+	}
 	pool, err := pgxpool.NewWithConfig(context.Background(), cfg)
 	if err != nil {
 		panic("sqldb: setup db: " + err.Error())
@@ -99,6 +159,30 @@ func (mgr *Manager) getPool(dbName string) *pgxpool.Pool {
 	return pool
 }
 
+// newStdPool returns a new connection pool for a MySQL or SQLite database db
+// on the server srv, along with the connector it uses.
+func (mgr *Manager) newStdPool(engine Engine, srv *config.SQLServer, db *config.SQLDatabase) (*sql.DB, driver.Connector) {
+	// If the password is stored in a secret, use its latest value
+	// for each new connection so rotated credentials are picked up.
+	var password func() string
+	if db.PasswordSecret != "" {
+		password = mgr.secrets.Watch(db.PasswordSecret).Get
+	}
+	connector, err := stdConnector(engine, srv, db, password)
+	if err != nil {
+		panic("sqldb: setup db: " + err.Error())
+	}
+
+	pool := sql.OpenDB(connector)
+	maxConns := 30
+	if n := db.MaxConnections; n > 0 {
+		maxConns = n
+	}
+	pool.SetMaxOpenConns(maxConns)
+	pool.SetMaxIdleConns(maxConns)
+	return pool, connector
+}
+
 func (mgr *Manager) Shutdown(force context.Context) {
 	var wg sync.WaitGroup
 	mgr.mu.RLock()
@@ -0,0 +1,150 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/storage/sqldb/internal/migrate"
+)
+
+// MigrateOptions configures how (*Manager).Migrate applies migrations.
+type MigrateOptions struct {
+	// Dir is the directory containing the migrations,
+	// with one subdirectory per database named after the database.
+	Dir string
+
+	// Databases are the names of the databases to migrate.
+	// If empty, all databases in the runtime config are migrated.
+	Databases []string
+
+	// DryRun reports the migrations that would be applied without applying them.
+	DryRun bool
+
+	// Status reports the migration status of each database without applying any migrations.
+	Status bool
+}
+
+// Migrate applies the pending migrations to the databases in the runtime config,
+// writing progress to w. The migrations are applied using the same ordering and
+// locking as the Encore daemon, so it's safe to run concurrently.
+func (mgr *Manager) Migrate(ctx context.Context, w io.Writer, opts MigrateOptions) error {
+	dbs, err := mgr.migrateTargets(opts.Databases)
+	if err != nil {
+		return err
+	}
+	if len(dbs) == 0 {
+		fmt.Fprintln(w, "no databases to migrate")
+		return nil
+	}
+
+	for _, db := range dbs {
+		if err := mgr.migrateDB(ctx, w, db, opts); err != nil {
+			return fmt.Errorf("%s: %v", db.EncoreName, err)
+		}
+	}
+	return nil
+}
+
+func (mgr *Manager) migrateDB(ctx context.Context, w io.Writer, db *config.SQLDatabase, opts MigrateOptions) error {
+	if engine, err := engineOf(mgr.runtime.SQLServers[db.ServerID]); err != nil {
+		return err
+	} else if engine != Postgres {
+		return fmt.Errorf("migrating %s databases is not supported; only PostgreSQL databases can be migrated", engine)
+	}
+
+	migrations, err := migrate.LoadDir(filepath.Join(opts.Dir, db.EncoreName))
+	if err != nil {
+		return fmt.Errorf("load migrations: %v", err)
+	}
+
+	conn, err := mgr.connect(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	if opts.Status || opts.DryRun {
+		status, err := migrate.GetStatus(ctx, conn, migrations)
+		if err != nil {
+			return err
+		}
+
+		version := "no migrations applied"
+		if status.Version != migrate.NilVersion {
+			version = fmt.Sprintf("version %d", status.Version)
+			if status.Dirty {
+				version += " (dirty, will be retried)"
+			}
+		}
+		fmt.Fprintf(w, "%s: %s, %d pending migration(s)\n", db.EncoreName, version, len(status.Pending))
+		for _, m := range status.Pending {
+			if opts.DryRun {
+				fmt.Fprintf(w, "  would apply %s\n", m.Filename)
+			} else {
+				fmt.Fprintf(w, "  pending %s\n", m.Filename)
+			}
+		}
+		return nil
+	}
+
+	applied := 0
+	err = migrate.Up(ctx, conn, migrations, func(m *migrate.Migration) {
+		fmt.Fprintf(w, "%s: applying %s\n", db.EncoreName, m.Filename)
+		applied++
+	})
+	if err != nil {
+		return err
+	}
+	if applied == 0 {
+		fmt.Fprintf(w, "%s: already up to date\n", db.EncoreName)
+	} else {
+		fmt.Fprintf(w, "%s: applied %d migration(s)\n", db.EncoreName, applied)
+	}
+	return nil
+}
+
+// migrateTargets returns the databases to migrate, ordered by name.
+func (mgr *Manager) migrateTargets(names []string) ([]*config.SQLDatabase, error) {
+	byName := make(map[string]*config.SQLDatabase, len(mgr.runtime.SQLDatabases))
+	for _, db := range mgr.runtime.SQLDatabases {
+		byName[db.EncoreName] = db
+	}
+
+	var dbs []*config.SQLDatabase
+	if len(names) == 0 {
+		dbs = append(dbs, mgr.runtime.SQLDatabases...)
+	} else {
+		for _, name := range names {
+			db, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown database: %s", name)
+			}
+			dbs = append(dbs, db)
+		}
+	}
+	sort.Slice(dbs, func(i, j int) bool { return dbs[i].EncoreName < dbs[j].EncoreName })
+	return dbs, nil
+}
+
+// connect opens a single connection to the given database.
+func (mgr *Manager) connect(ctx context.Context, db *config.SQLDatabase) (*pgx.Conn, error) {
+	srv := mgr.runtime.SQLServers[db.ServerID]
+	cfg, err := dbConf(srv, db)
+	if err != nil {
+		return nil, err
+	}
+	if db.PasswordSecret != "" {
+		cfg.ConnConfig.Password = mgr.secrets.Load(db.PasswordSecret)
+	}
+	conn, err := pgx.ConnectConfig(ctx, cfg.ConnConfig)
+	if err != nil {
+		return nil, fmt.Errorf("connect: %v", err)
+	}
+	return conn, nil
+}
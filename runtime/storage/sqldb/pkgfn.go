@@ -5,7 +5,9 @@ package sqldb
 import (
 	"context"
 
+	"encore.dev/appruntime/infrasdk/secrets"
 	"encore.dev/appruntime/shared/appconf"
+	"encore.dev/appruntime/shared/logging"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/testsupport"
 )
@@ -40,6 +42,15 @@ func Begin(ctx context.Context) (*Tx, error) {
 	return getCurrentDB().Begin(ctx)
 }
 
+// InTx runs fn in a transaction, which is committed if fn returns nil
+// and rolled back otherwise. Transactions failing due to serialization
+// failures or deadlocks are retried.
+//
+// See (*Database).InTx for additional documentation.
+func InTx(ctx context.Context, opts *TxOptions, fn func(tx *Tx) error) error {
+	return getCurrentDB().InTx(ctx, opts, fn)
+}
+
 // Commit commits the given transaction.
 //
 // See (*database/sql.Tx).Commit() for additional documentation.
@@ -93,7 +104,7 @@ func Named(name constStr) *Database {
 }
 
 //publicapigen:drop
-var Singleton = NewManager(appconf.Runtime, reqtrack.Singleton, testsupport.Singleton)
+var Singleton = NewManager(appconf.Runtime, reqtrack.Singleton, testsupport.Singleton, secrets.Singleton, logging.RootLogger)
 
 func getCurrentDB() *Database {
 	return Singleton.GetCurrentDB()
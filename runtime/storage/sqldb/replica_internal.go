@@ -0,0 +1,138 @@
+package sqldb
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// defaultMaxReplicaLag is the maximum replication lag for a replica
+	// to be used, if the database config doesn't specify one.
+	defaultMaxReplicaLag = 10 * time.Second
+
+	// replicaCheckInterval is how often the replication lag of replicas is checked.
+	replicaCheckInterval = 5 * time.Second
+
+	// primaryNode is the node name of the primary in traces.
+	primaryNode = "primary"
+)
+
+// replicaLagQuery computes the replication lag of a replica in seconds.
+// A replica that has replayed everything it has received is considered
+// up to date, since the last replay timestamp doesn't advance while the
+// primary is idle.
+const replicaLagQuery = `
+SELECT CASE
+	WHEN NOT pg_is_in_recovery() THEN 0
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END::float8`
+
+// replica is a read replica of a database.
+type replica struct {
+	node string // the node name in traces
+	pool *pgxpool.Pool
+
+	// usable is whether the replica is reachable and within
+	// the maximum replication lag, as of the last check.
+	usable atomic.Bool
+
+	// checked is whether the replica has been checked before.
+	// It's only accessed by the monitoring goroutine.
+	checked bool
+}
+
+type replicaKey struct{}
+
+// useReplica reports whether ctx was returned by WithReplica.
+func useReplica(ctx context.Context) bool {
+	return ctx.Value(replicaKey{}) != nil
+}
+
+// readConn returns the querier to use for read queries made with ctx,
+// and the node name it connects to for tracing.
+func (db *Database) readConn(ctx context.Context) (querier, string) {
+	if useReplica(ctx) && db.testTx == nil {
+		if r := db.pickReplica(); r != nil {
+			return pgxConn{c: r.pool}, r.node
+		}
+	}
+	return db.primary(), db.primaryNode()
+}
+
+// primary returns the querier for the primary.
+func (db *Database) primary() querier {
+	if db.testTx != nil {
+		return db.testTx
+	} else if db.std != nil {
+		return stdConn{c: db.std}
+	}
+	return pgxConn{c: db.pool}
+}
+
+// primaryNode returns the node name of the primary for tracing.
+// Databases without replicas report no node, as there is only one.
+func (db *Database) primaryNode() string {
+	if len(db.replicas) == 0 {
+		return ""
+	}
+	return primaryNode
+}
+
+// pickReplica returns a usable replica, distributing queries
+// between them round-robin. It reports nil if there are none.
+func (db *Database) pickReplica() *replica {
+	n := len(db.replicas)
+	if n == 0 {
+		return nil
+	}
+	start := int(atomic.AddUint64(&db.replicaCtr, 1) % uint64(n))
+	for i := 0; i < n; i++ {
+		if r := db.replicas[(start+i)%n]; r.usable.Load() {
+			return r
+		}
+	}
+	return nil
+}
+
+// monitorReplicas periodically checks the replication lag
+// of the replicas until the database is shut down.
+func (db *Database) monitorReplicas() {
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+	for {
+		db.checkReplicas()
+		select {
+		case <-db.stopReplicas:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkReplicas checks the replication lag of the replicas,
+// marking the ones that are unreachable or lag too far behind as unusable.
+func (db *Database) checkReplicas() {
+	for _, r := range db.replicas {
+		ctx, cancel := context.WithTimeout(context.Background(), replicaCheckInterval)
+		var lagSecs float64
+		err := r.pool.QueryRow(markTraced(ctx), replicaLagQuery).Scan(&lagSecs)
+		cancel()
+
+		lag := time.Duration(lagSecs * float64(time.Second))
+		usable := err == nil && lag <= db.maxLag
+		if was := r.usable.Swap(usable); was != usable || !r.checked {
+			if usable {
+				db.mgr.rootLog.Info().Str("db", db.name).Str("node", r.node).Msg("sqldb: replica is available")
+			} else if err != nil {
+				db.mgr.rootLog.Warn().Err(err).Str("db", db.name).Str("node", r.node).Msg("sqldb: replica is unavailable, using primary")
+			} else {
+				db.mgr.rootLog.Warn().Str("db", db.name).Str("node", r.node).Dur("lag", lag).Msg("sqldb: replica is lagging, using primary")
+			}
+		}
+		r.checked = true
+	}
+}
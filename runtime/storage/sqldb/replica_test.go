@@ -0,0 +1,51 @@
+package sqldb
+
+import (
+	"context"
+	"testing"
+)
+
+func TestReadConn(t *testing.T) {
+	r1, r2 := &replica{node: "replica:one"}, &replica{node: "replica:two"}
+	db := &Database{replicas: []*replica{r1, r2}}
+	replicaCtx := WithReplica(context.Background())
+
+	node := func(ctx context.Context) string {
+		_, node := db.readConn(ctx)
+		return node
+	}
+
+	// Unusable replicas fall back to the primary.
+	if got := node(replicaCtx); got != "primary" {
+		t.Errorf("no usable replicas: got node %q, want primary", got)
+	}
+
+	// Queries are distributed between the usable replicas.
+	r1.usable.Store(true)
+	r2.usable.Store(true)
+	seen := make(map[string]int)
+	for i := 0; i < 4; i++ {
+		seen[node(replicaCtx)]++
+	}
+	if seen["replica:one"] != 2 || seen["replica:two"] != 2 {
+		t.Errorf("got nodes %v, want two queries per replica", seen)
+	}
+
+	r1.usable.Store(false)
+	for i := 0; i < 2; i++ {
+		if got := node(replicaCtx); got != "replica:two" {
+			t.Errorf("one usable replica: got node %q, want replica:two", got)
+		}
+	}
+
+	// Queries only use replicas when requested.
+	if got := node(context.Background()); got != "primary" {
+		t.Errorf("without WithReplica: got node %q, want primary", got)
+	}
+
+	// Databases without replicas report no node.
+	db = &Database{}
+	if got := node(replicaCtx); got != "" {
+		t.Errorf("without replicas: got node %q, want none", got)
+	}
+}
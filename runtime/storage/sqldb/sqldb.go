@@ -8,8 +8,6 @@ import (
 	"database/sql"
 	"sync/atomic"
 
-	"github.com/jackc/pgx/v5"
-
 	"encore.dev/appruntime/exported/stack"
 	"encore.dev/appruntime/exported/trace"
 	"encore.dev/beta/errs"
@@ -32,7 +30,13 @@ type ExecResult interface {
 type Tx struct {
 	mgr  *Manager
 	txid uint64
-	std  pgx.Tx
+	std  txConn
+	node string // the node name in traces
+
+	// savepoint is whether the transaction is nested in another transaction
+	// using a savepoint. Nested transactions share the trace transaction of
+	// the enclosing transaction.
+	savepoint bool
 }
 
 // Commit commits the given transaction.
@@ -46,10 +50,10 @@ func (tx *Tx) Commit() error { return tx.commit() }
 func (tx *Tx) Rollback() error { return tx.rollback() }
 
 func (tx *Tx) commit() error {
-	err := tx.std.Commit(markTraced(context.Background()))
+	err := tx.std.commit(markTraced(context.Background()))
 	err = convertErr(err)
 
-	if curr := tx.mgr.rt.Current(); curr.Req != nil && curr.Trace != nil {
+	if curr := tx.mgr.rt.Current(); curr.Req != nil && curr.Trace != nil && !tx.savepoint {
 		curr.Trace.DBTxEnd(trace.DBTxEndParams{
 			SpanID: curr.Req.SpanID,
 			Goid:   curr.Goctr,
@@ -64,10 +68,10 @@ func (tx *Tx) commit() error {
 }
 
 func (tx *Tx) rollback() error {
-	err := tx.std.Rollback(markTraced(context.Background()))
+	err := tx.std.rollback(markTraced(context.Background()))
 	err = convertErr(err)
 
-	if curr := tx.mgr.rt.Current(); curr.Req != nil && curr.Trace != nil {
+	if curr := tx.mgr.rt.Current(); curr.Req != nil && curr.Trace != nil && !tx.savepoint {
 		curr.Trace.DBTxEnd(trace.DBTxEndParams{
 			SpanID: curr.Req.SpanID,
 			Goid:   curr.Goctr,
@@ -97,10 +101,11 @@ func (tx *Tx) exec(ctx context.Context, query string, args ...interface{}) (Exec
 			QueryID: qid,
 			TxID:    tx.txid,
 			Stack:   stack.Build(4),
+			Node:    tx.node,
 		})
 	}
 
-	res, err := tx.std.Exec(markTraced(ctx), query, args...)
+	res, err := tx.std.exec(markTraced(ctx), query, args)
 	err = convertErr(err)
 
 	if curr.Trace != nil {
@@ -122,10 +127,11 @@ func (tx *Tx) Query(ctx context.Context, query string, args ...interface{}) (*Ro
 			QueryID: qid,
 			TxID:    tx.txid,
 			Stack:   stack.Build(4),
+			Node:    tx.node,
 		})
 	}
 
-	rows, err := tx.std.Query(markTraced(ctx), query, args...)
+	rows, err := tx.std.query(markTraced(ctx), query, args)
 	err = convertErr(err)
 
 	if curr.Trace != nil {
@@ -150,12 +156,13 @@ func (tx *Tx) QueryRow(ctx context.Context, query string, args ...interface{}) *
 			QueryID: qid,
 			TxID:    tx.txid,
 			Stack:   stack.Build(4),
+			Node:    tx.node,
 		})
 	}
 
 	// pgx currently does not support .Err() on Row.
 	// Work around this by using Query.
-	rows, err := tx.std.Query(markTraced(ctx), query, args...)
+	rows, err := tx.std.query(markTraced(ctx), query, args)
 	err = convertErr(err)
 	r := &Row{rows: rows, err: err}
 
@@ -171,7 +178,7 @@ func (tx *Tx) QueryRow(ctx context.Context, query string, args ...interface{}) *
 //
 // See *database/sql.Rows for additional documentation.
 type Rows struct {
-	std pgx.Rows
+	std rows
 }
 
 // Close closes the Rows, preventing further enumeration.
@@ -206,7 +213,7 @@ func (r *Rows) Next() bool { return r.std.Next() }
 //
 // See *database/sql.Row for additional documentation.
 type Row struct {
-	rows pgx.Rows
+	rows rows
 	err  error
 }
 
@@ -41,6 +41,10 @@ const (
 	// due to some previous command failure.
 	TransactionFailed Code = "transaction_failed"
 
+	// SerializationFailure is reported when a transaction can't be serialized
+	// due to concurrent transactions. The transaction can be retried.
+	SerializationFailure Code = "serialization_failure"
+
 	// DeadlockDetected is reported when a deadlock is detected.
 	// Deadlock detection is done on a best-effort basis and not all deadlocks
 	// can be detected.
@@ -69,6 +73,8 @@ func MapCode(code string) Code {
 		return ExcludeViolation
 	case "25P02":
 		return TransactionFailed
+	case "40001":
+		return SerializationFailure
 	case "40P01":
 		return DeadlockDetected
 	case "53300":
@@ -78,6 +84,51 @@ func MapCode(code string) Code {
 	}
 }
 
+// MapMySQLCode maps a MySQL error number to a Code.
+//
+//publicapigen:drop
+func MapMySQLCode(number uint16) Code {
+	switch number {
+	case 1048: // ER_BAD_NULL_ERROR
+		return NotNullViolation
+	case 1216, 1217, 1451, 1452: // ER_NO_REFERENCED_ROW, ER_ROW_IS_REFERENCED (and their _2 variants)
+		return ForeignKeyViolation
+	case 1062, 1586: // ER_DUP_ENTRY, ER_DUP_ENTRY_WITH_KEY_NAME
+		return UniqueViolation
+	case 3819: // ER_CHECK_CONSTRAINT_VIOLATED
+		return CheckViolation
+	case 1213: // ER_LOCK_DEADLOCK
+		return DeadlockDetected
+	case 1040: // ER_CON_COUNT_ERROR
+		return TooManyConnections
+	default:
+		return Other
+	}
+}
+
+// MapSQLiteCode maps an SQLite extended result code to a Code.
+//
+// SQLite reports conflicting concurrent transactions as busy errors,
+// which are mapped to SerializationFailure as they can be retried.
+//
+//publicapigen:drop
+func MapSQLiteCode(code int) Code {
+	switch code {
+	case 1299: // SQLITE_CONSTRAINT_NOTNULL
+		return NotNullViolation
+	case 787: // SQLITE_CONSTRAINT_FOREIGNKEY
+		return ForeignKeyViolation
+	case 1555, 2067: // SQLITE_CONSTRAINT_PRIMARYKEY, SQLITE_CONSTRAINT_UNIQUE
+		return UniqueViolation
+	case 275: // SQLITE_CONSTRAINT_CHECK
+		return CheckViolation
+	}
+	if code&0xff == 5 { // SQLITE_BUSY and its extended codes
+		return SerializationFailure
+	}
+	return Other
+}
+
 // Severity defines the severity of a database error.
 type Severity string
 
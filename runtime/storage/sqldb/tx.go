@@ -0,0 +1,140 @@
+package sqldb
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"encore.dev/storage/sqldb/sqlerr"
+)
+
+// IsolationLevel is the isolation level of a transaction.
+//
+// See https://www.postgresql.org/docs/current/transaction-iso.html
+// for a description of the isolation levels.
+type IsolationLevel string
+
+const (
+	// DefaultIsolation uses the default isolation level of the database,
+	// which is ReadCommitted unless configured otherwise.
+	DefaultIsolation IsolationLevel = ""
+
+	ReadCommitted  IsolationLevel = "read committed"
+	RepeatableRead IsolationLevel = "repeatable read"
+	Serializable   IsolationLevel = "serializable"
+)
+
+// TxOptions are the options for transactions run with InTx.
+type TxOptions struct {
+	// Isolation is the isolation level of the transaction.
+	Isolation IsolationLevel
+
+	// ReadOnly makes the transaction read-only.
+	ReadOnly bool
+
+	// MaxRetries is the maximum number of times the transaction is retried
+	// after a serialization failure or deadlock. If zero it defaults to 3,
+	// and if negative the transaction is never retried.
+	MaxRetries int
+}
+
+const (
+	defaultMaxRetries = 3
+	minRetryBackoff   = 10 * time.Millisecond
+	maxRetryBackoff   = 1 * time.Second
+)
+
+// InTx runs fn in a transaction, which is committed if fn returns nil
+// and rolled back otherwise, including if fn panics. The opts may be nil
+// to use the default options.
+//
+// If the transaction fails due to a serialization failure or a deadlock
+// (see sqlerr.SerializationFailure and sqlerr.DeadlockDetected) it is retried
+// with exponential backoff, so fn may be called multiple times and must be
+// safe to retry. Each attempt is a separate transaction.
+//
+// The fn must not commit or roll back tx. To nest transactions, use (*Tx).InTx.
+func (db *Database) InTx(ctx context.Context, opts *TxOptions, fn func(tx *Tx) error) error {
+	var o TxOptions
+	if opts != nil {
+		o = *opts
+	}
+	maxRetries := o.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := db.runTx(ctx, o, fn)
+		if err == nil || attempt >= maxRetries || !isRetryable(err) {
+			return err
+		}
+
+		// Back off exponentially with jitter before retrying.
+		backoff := minRetryBackoff << attempt
+		if backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+		backoff = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// runTx runs a single attempt of a transaction.
+func (db *Database) runTx(ctx context.Context, opts TxOptions, fn func(tx *Tx) error) error {
+	tx, err := db.begin(ctx, opts, 6)
+	if err != nil {
+		return err
+	}
+	return tx.run(fn)
+}
+
+// InTx runs fn in a nested transaction, using a savepoint that is released
+// if fn returns nil and rolled back to otherwise, including if fn panics.
+// Rolling back to the savepoint undoes the changes made by fn while keeping
+// the enclosing transaction usable.
+//
+// Nested transactions are never retried, as serialization failures and
+// deadlocks abort the enclosing transaction. They are instead retried
+// by the enclosing (*Database).InTx.
+//
+// The fn must not commit or roll back tx.
+func (tx *Tx) InTx(ctx context.Context, fn func(tx *Tx) error) error {
+	sp, err := tx.std.savepoint(markTraced(ctx))
+	if err != nil {
+		return convertErr(err)
+	}
+	nested := &Tx{mgr: tx.mgr, txid: tx.txid, std: sp, node: tx.node, savepoint: true}
+	return nested.run(fn)
+}
+
+// run calls fn and commits the transaction if it returns nil,
+// or rolls it back otherwise.
+func (tx *Tx) run(fn func(tx *Tx) error) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = tx.rollback()
+			panic(r)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		_ = tx.rollback()
+		return err
+	}
+	return tx.commit()
+}
+
+// isRetryable reports whether a transaction failing with err can be retried.
+func isRetryable(err error) bool {
+	var pgerr *Error
+	if !errors.As(err, &pgerr) {
+		return false
+	}
+	return pgerr.Code == sqlerr.SerializationFailure || pgerr.Code == sqlerr.DeadlockDetected
+}
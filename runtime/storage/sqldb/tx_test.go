@@ -0,0 +1,32 @@
+package sqldb
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"encore.dev/storage/sqldb/sqlerr"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: errors.New("some error"), want: false},
+		{err: &Error{Code: sqlerr.UniqueViolation}, want: false},
+		{err: &Error{Code: sqlerr.SerializationFailure}, want: true},
+		{err: &Error{Code: sqlerr.DeadlockDetected}, want: true},
+		{err: fmt.Errorf("wrapped: %w", &Error{Code: sqlerr.DeadlockDetected}), want: true},
+		{err: convertErr(&pgconn.PgError{Code: "40001"}), want: true},
+		{err: convertErr(&pgconn.PgError{Code: "40P01"}), want: true},
+		{err: convertErr(&pgconn.PgError{Code: "23505"}), want: false},
+	}
+	for _, tt := range tests {
+		if got := isRetryable(tt.err); got != tt.want {
+			t.Errorf("isRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -42,17 +42,35 @@ func configureAPIFramework(pc *parsectx.Context, services []*Service, res *parse
 		}
 	}
 
-	// Add the app's auth handler
+	// Add the app's auth handlers. When there are multiple,
+	// each must implement a uniquely named auth scheme.
+	if len(authHandlers) > 1 {
+		unnamedErr := authhandler.ErrMultipleAuthHandlers
+		unnamed := false
+		schemes := make(map[string]*authhandler.AuthHandler)
+		for _, ah := range authHandlers {
+			if ah.Scheme == "" {
+				unnamedErr = unnamedErr.AtGoNode(ah.Decl.AST.Type, errors.AsError("auth handler without a scheme name defined here"))
+				unnamed = true
+			} else if prev, ok := schemes[ah.Scheme]; ok {
+				pc.Errs.Add(
+					authhandler.ErrDuplicateAuthScheme(ah.Scheme).
+						AtGoNode(prev.Decl.AST.Type, errors.AsError("first auth handler defined here")).
+						AtGoNode(ah.Decl.AST.Type, errors.AsError("second auth handler defined here")),
+				)
+			} else {
+				schemes[ah.Scheme] = ah
+			}
+		}
+		if unnamed {
+			pc.Errs.Add(unnamedErr)
+		}
+	}
 	for _, ah := range authHandlers {
 		if fw.AuthHandler.Empty() {
 			fw.AuthHandler = option.Some(ah)
-		} else {
-			pc.Errs.Add(
-				authhandler.ErrMultipleAuthHandlers.
-					AtGoNode(fw.AuthHandler.MustGet().Decl.AST.Type, errors.AsError("first auth handler defined here")).
-					AtGoNode(ah.Decl.AST.Type, errors.AsError("second auth handler defined here")),
-			)
 		}
+		fw.AuthHandlers = append(fw.AuthHandlers, ah)
 	}
 
 	modifySvcDesc := func(pkg *pkginfo.Package, errTemplate *errors.Template, fn func(svc *Service, desc *apiframework.ServiceDesc)) {
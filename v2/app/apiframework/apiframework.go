@@ -15,7 +15,12 @@ type AppDesc struct {
 	GlobalMiddleware []*middleware.Middleware
 
 	// AuthHandler defines the application's auth handler, if any.
+	// If the application has multiple auth handlers it's the first one.
 	AuthHandler option.Option[*authhandler.AuthHandler]
+
+	// AuthHandlers are all the application's auth handlers,
+	// in the order they are tried.
+	AuthHandlers []*authhandler.AuthHandler
 }
 
 // ServiceDesc describes an Encore Framework-based service.
@@ -74,6 +74,27 @@ func (d *Desc) MatchingGlobalMiddleware(ep *api.Endpoint) []*middleware.Middlewa
 	return matches
 }
 
+// EndpointAuthSchemes returns the auth schemes whose auth handlers are tried
+// for the endpoint, in order. It's nil if the endpoint is private
+// or the application has at most one auth handler.
+func (d *Desc) EndpointAuthSchemes(ep *api.Endpoint) []string {
+	if ep.Access == api.Private {
+		return nil
+	} else if len(ep.AuthSchemes) > 0 {
+		return ep.AuthSchemes
+	}
+
+	var schemes []string
+	d.Framework.ForAll(func(fw *apiframework.AppDesc) {
+		if len(fw.AuthHandlers) > 1 {
+			for _, ah := range fw.AuthHandlers {
+				schemes = append(schemes, ah.Scheme)
+			}
+		}
+	})
+	return schemes
+}
+
 // ValidateAndDescribe validates the application and computes the
 // application description.
 func ValidateAndDescribe(pc *parsectx.Context, result *parser.Result) *Desc {
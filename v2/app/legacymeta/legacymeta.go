@@ -16,6 +16,7 @@ import (
 	"encr.dev/v2/internals/schema"
 	"encr.dev/v2/parser/apis/api"
 	"encr.dev/v2/parser/apis/authhandler"
+	"encr.dev/v2/parser/apis/errdetails"
 	"encr.dev/v2/parser/apis/middleware"
 	"encr.dev/v2/parser/apis/servicestruct"
 	"encr.dev/v2/parser/infra/caches"
@@ -85,10 +86,21 @@ func (b *builder) Build() *meta.Data {
 					Path:           b.apiPath(ep.Decl.AST.Pos(), ep.Path),
 					HttpMethods:    ep.HTTPMethods,
 					Tags:           ep.Tags.ToProto(),
+					AuthSchemes:    ep.AuthSchemes,
 				}
 				if ep.Raw {
 					rpc.Proto = meta.RPC_RAW
 				}
+				rpc.Version = uint32(ep.Version)
+				if dep, ok := ep.Deprecation.Get(); ok {
+					rpc.Deprecation = &meta.RPC_Deprecation{}
+					if dep.Since != "" {
+						rpc.Deprecation.Since = &dep.Since
+					}
+					if dep.Sunset != "" {
+						rpc.Deprecation.Sunset = &dep.Sunset
+					}
+				}
 
 				switch ep.Access {
 				case api.Public:
@@ -128,6 +140,7 @@ func (b *builder) Build() *meta.Data {
 								Description: mig.Description,
 							})
 						}
+						out.SqlEngine = sqlEngine(res.Engine)
 					}
 				}
 			}
@@ -224,11 +237,15 @@ func (b *builder) Build() *meta.Data {
 				PkgName: r.Package().Name,
 				Loc:     b.schemaLoc(r.Decl.File, r.Decl.AST),
 				Params:  b.schemaTypeUnwrapPointer(r.Param),
+				Scheme:  r.Scheme,
 			}
 			if data, ok := r.AuthData.Get(); ok {
 				ah.AuthData = b.typeDeclRefUnwrapPointer(data)
 			}
-			md.AuthHandler = ah
+			if md.AuthHandler == nil {
+				md.AuthHandler = ah
+			}
+			md.AuthHandlers = append(md.AuthHandlers, ah)
 
 			if svc, ok := b.app.ServiceForPath(r.Decl.File.FSPath); ok {
 				b.nodes.addAuthHandler(r, svc.Name)
@@ -393,6 +410,12 @@ func (b *builder) Build() *meta.Data {
 				b.nodes.addServiceStruct(r, svc.Name)
 			}
 
+		case *errdetails.ErrDetails:
+			md.ErrDetails = append(md.ErrDetails, &meta.ErrDetails{
+				Name: r.Name,
+				Type: b.schemaType(r.Type),
+			})
+
 		case *pubsub.Subscription, *caches.Keyspace:
 			dependent = append(dependent, r)
 		}
@@ -544,3 +567,15 @@ func (b *builder) relPath(pkg paths.Pkg) string {
 	}
 	return rel.String()
 }
+
+// sqlEngine returns the metadata representation of a database engine.
+func sqlEngine(engine sqldb.Engine) meta.Service_SQLEngine {
+	switch engine {
+	case sqldb.MySQL:
+		return meta.Service_MYSQL
+	case sqldb.SQLite:
+		return meta.Service_SQLITE
+	default:
+		return meta.Service_POSTGRES
+	}
+}
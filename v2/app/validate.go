@@ -6,6 +6,7 @@ import (
 	"encr.dev/v2/internals/parsectx"
 	"encr.dev/v2/parser"
 	"encr.dev/v2/parser/apis/authhandler"
+	"encr.dev/v2/parser/apis/errdetails"
 	"encr.dev/v2/parser/apis/middleware"
 	"encr.dev/v2/parser/infra/pubsub"
 	"encr.dev/v2/parser/infra/secrets"
@@ -22,12 +23,14 @@ func (d *Desc) validate(pc *parsectx.Context, result *parser.Result) {
 		d.validateMiddleware(pc, fw)
 		d.validateServiceStructs(pc, result)
 	}
+	d.validateErrDetails(pc, result)
 
 	// Validate infrastructure
 	d.validateCaches(pc, result)
 	d.validateConfigs(pc, result)
 	d.validateCrons(pc, result)
 	d.validatePubSub(pc, result)
+	d.validateSQLDatabases(pc, result)
 
 	// Validate all resources are defined within a service
 	for _, r := range result.Resources() {
@@ -44,6 +47,9 @@ func (d *Desc) validate(pc *parsectx.Context, result *parser.Result) {
 		case *secrets.Secrets:
 			// Secrets are allowed anywhere
 			continue
+		case *errdetails.ErrDetails:
+			// Error details are commonly declared in shared packages
+			continue
 
 		default:
 			_, ok := d.ServiceForPath(r.Package().FSPath)
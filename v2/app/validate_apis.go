@@ -2,6 +2,9 @@ package app
 
 import (
 	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
 
 	"encr.dev/pkg/errors"
 	"encr.dev/v2/app/apiframework"
@@ -20,6 +23,16 @@ func (d *Desc) validateAPIs(pc *parsectx.Context, fw *apiframework.AppDesc, resu
 
 	apiPaths := resourcepaths.NewSet()
 
+	// Different versions of the same API share a path.
+	// Track the versions registered for each method and path shape,
+	// so that only the first version is added to apiPaths.
+	type versionKey struct{ method, shape string }
+	type versionedPath struct {
+		path     *resourcepaths.Path
+		versions map[int]bool
+	}
+	versionedPaths := make(map[versionKey]*versionedPath)
+
 	for _, svc := range d.Services {
 		fwSvc, ok := svc.Framework.Get()
 		if !ok {
@@ -36,10 +49,39 @@ func (d *Desc) validateAPIs(pc *parsectx.Context, fw *apiframework.AppDesc, resu
 				)
 			}
 
+			// Check that the auth schemes the endpoint accepts are implemented.
+			for _, scheme := range ep.AuthSchemes {
+				if slices.IndexFunc(fw.AuthHandlers, func(ah *authhandler.AuthHandler) bool { return ah.Scheme == scheme }) == -1 {
+					pc.Errs.Add(
+						errors.AtOptionalNode(authhandler.ErrUnknownAuthScheme(scheme), ep.AuthField),
+					)
+				}
+			}
+
 			// Check for duplicate paths by adding them to the set
 			// Note, errors will be reported automatically to pc.Errs
 			for _, method := range ep.HTTPMethods {
-				apiPaths.Add(pc.Errs, method, ep.Path)
+				if ep.Version == 0 {
+					apiPaths.Add(pc.Errs, method, ep.Path)
+					continue
+				}
+
+				key := versionKey{method, pathShape(ep.Path)}
+				if vp, ok := versionedPaths[key]; ok && !vp.versions[ep.Version] {
+					if vp.path.String() != ep.Path.String() {
+						pc.Errs.Add(api.ErrVersionPathMismatch.
+							AtGoNode(ep.Path, errors.AsError("defined here")).
+							AtGoNode(vp.path, errors.AsHelp("other version defined here")))
+					}
+					vp.versions[ep.Version] = true
+				} else if apiPaths.Add(pc.Errs, method, ep.Path) {
+					versionedPaths[key] = &versionedPath{path: ep.Path, versions: map[int]bool{ep.Version: true}}
+				} else {
+					continue
+				}
+
+				// Versioned APIs are additionally reachable with the version as a path prefix.
+				apiPaths.Add(pc.Errs, method, ep.Path.WithVersion(ep.Version))
 			}
 
 			if receiver, ok := ep.Recv.Get(); ok {
@@ -108,3 +150,21 @@ func (d *Desc) validateAPIs(pc *parsectx.Context, fw *apiframework.AppDesc, resu
 		}
 	}
 }
+
+// pathShape returns the path with parameter names omitted,
+// so that paths that differ only in parameter names compare equal.
+func pathShape(p *resourcepaths.Path) string {
+	var b strings.Builder
+	for _, seg := range p.Segments {
+		b.WriteByte('/')
+		switch seg.Type {
+		case resourcepaths.Param:
+			b.WriteByte(':')
+		case resourcepaths.Wildcard:
+			b.WriteByte('*')
+		default:
+			b.WriteString(seg.Value)
+		}
+	}
+	return b.String()
+}
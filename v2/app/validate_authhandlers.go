@@ -1,14 +1,30 @@
 package app
 
 import (
+	"encr.dev/pkg/errors"
 	"encr.dev/v2/app/apiframework"
 	"encr.dev/v2/internals/parsectx"
+	"encr.dev/v2/internals/schema/schemautil"
+	"encr.dev/v2/parser/apis/authhandler"
 )
 
 func (d *Desc) validateAuthHandlers(pc *parsectx.Context, fw *apiframework.AppDesc) {
-	_, found := fw.AuthHandler.Get()
+	first, found := fw.AuthHandler.Get()
 	if !found {
 		return
 	}
 
+	// All auth handlers must return the same auth data type,
+	// since auth.Data() has a single type regardless of the auth scheme.
+	firstData, firstHasData := first.AuthData.Get()
+	for _, ah := range fw.AuthHandlers[1:] {
+		data, hasData := ah.AuthData.Get()
+		if hasData != firstHasData || (hasData && schemautil.Hash(data.ToType()) != schemautil.Hash(firstData.ToType())) {
+			pc.Errs.Add(
+				authhandler.ErrAuthHandlerDataMismatch.
+					AtGoNode(first.Decl.AST.Type, errors.AsHelp("first auth handler defined here")).
+					AtGoNode(ah.Decl.AST.Type, errors.AsError("auth data type differs here")),
+			)
+		}
+	}
 }
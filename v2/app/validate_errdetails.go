@@ -0,0 +1,25 @@
+package app
+
+import (
+	"encr.dev/pkg/errors"
+	"encr.dev/v2/internals/parsectx"
+	"encr.dev/v2/parser"
+	"encr.dev/v2/parser/apis/errdetails"
+)
+
+func (d *Desc) validateErrDetails(pc *parsectx.Context, result *parser.Result) {
+	// Error details are identified on the wire by their package and type name,
+	// which must therefore be unique within the application.
+	seen := make(map[string]*errdetails.ErrDetails)
+	for _, det := range parser.Resources[*errdetails.ErrDetails](result) {
+		if prev, ok := seen[det.Name]; ok {
+			pc.Errs.Add(
+				errdetails.ErrDuplicateName(det.Name).
+					AtGoNode(det.Decl.AST.Name, errors.AsError("declared here")).
+					AtGoNode(prev.Decl.AST.Name, errors.AsHelp("previously declared here")),
+			)
+			continue
+		}
+		seen[det.Name] = det
+	}
+}
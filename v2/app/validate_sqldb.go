@@ -0,0 +1,17 @@
+package app
+
+import (
+	"encr.dev/v2/internals/parsectx"
+	"encr.dev/v2/parser"
+	"encr.dev/v2/parser/infra/sqldb"
+)
+
+// validateSQLDatabases checks the constant queries made against
+// each database against the schema defined by its migrations.
+func (d *Desc) validateSQLDatabases(pc *parsectx.Context, result *parser.Result) {
+	for _, res := range result.Resources() {
+		if db, ok := res.(*sqldb.Database); ok {
+			sqldb.CheckQueries(pc.Errs, db, result.Usages(db))
+		}
+	}
+}
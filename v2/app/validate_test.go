@@ -22,6 +22,7 @@ import (
 	"encr.dev/v2/internals/schema"
 	"encr.dev/v2/internals/testutil"
 	"encr.dev/v2/parser"
+	"encr.dev/v2/parser/apis/errdetails"
 	"encr.dev/v2/parser/apis/middleware"
 	"encr.dev/v2/parser/infra/config"
 	"encr.dev/v2/parser/infra/crons"
@@ -215,6 +216,13 @@ func parse(ts *testscript.TestScript, neg bool, args []string) {
 				printf("rpc %s.%s access=%v raw=%v path=%v recv=%v",
 					svc.Name, rpc.Name, rpc.Access, rpc.Raw, rpc.Path, recvName,
 				)
+				dep, deprecated := rpc.Deprecation.Get()
+				printf("rpcVersion %s.%s version=%d deprecated=%v since=%s sunset=%s",
+					svc.Name, rpc.Name, rpc.Version, deprecated, dep.Since, dep.Sunset,
+				)
+				printf("rpcAuth %s.%s schemes=%s",
+					svc.Name, rpc.Name, strings.Join(desc.EndpointAuthSchemes(rpc), ","),
+				)
 			}
 		})
 	}
@@ -277,6 +285,8 @@ func parse(ts *testscript.TestScript, neg bool, args []string) {
 				res.Cfg.MaxRetryBackoff)
 		case *metrics.Metric:
 			printf("metric %s %s %s %s", res.Name, strings.ToUpper(res.ValueType.String()), strings.ToUpper(res.Type.String()), res.Labels)
+		case *errdetails.ErrDetails:
+			printf("errDetails %s", res.Name)
 		}
 	}
 }
@@ -50,9 +50,7 @@ func Process(p Params) {
 		APIHandlers:    make(map[*api.Endpoint]*codegen.VarDecl),
 		Middleware:     make(map[*middleware.Middleware]*codegen.VarDecl),
 		ServiceStructs: make(map[*app.Service]*codegen.VarDecl),
-
-		// Set below
-		AuthHandler: option.None[*codegen.VarDecl](),
+		AuthHandlers:   make(map[*authhandler.AuthHandler]*codegen.VarDecl),
 	}
 
 	if fw, ok := p.Desc.Framework.Get(); ok {
@@ -82,13 +80,13 @@ func Process(p Params) {
 			userfacinggen.Gen(p.Gen, svc, svcStruct)
 		}
 
-		gp.AuthHandler = option.Map(fw.AuthHandler, func(ah *authhandler.AuthHandler) *codegen.VarDecl {
+		for _, ah := range fw.AuthHandlers {
 			var svcStruct option.Option[*codegen.VarDecl]
 			if svc, ok := p.Desc.ServiceForPath(ah.Decl.File.FSPath); ok {
 				svcStruct = option.AsOptional(svcStructBySvc[svc.Name])
 			}
-			return authhandlergen.Gen(p.Gen, p.Desc, ah, svcStruct)
-		})
+			gp.AuthHandlers[ah] = authhandlergen.Gen(p.Gen, p.Desc, ah, svcStruct)
+		}
 
 		mws := middlewaregen.Gen(p.Gen, fw.GlobalMiddleware, option.None[*codegen.VarDecl]())
 		maps.Copy(gp.Middleware, mws)
@@ -27,9 +27,7 @@ func Gen(gen *codegen.Generator, appDesc *app.Desc, ah *authhandler.AuthHandler,
 		}
 	}
 
-	desc.Value(Op("&").Add(apiQ("AuthHandlerDesc")).Types(
-		gu.Type(ah.Param),
-	).Values(Dict{
+	fields := Dict{
 		Id("Service"): Lit(svcName),
 		Id("SvcNum"):  Lit(svcNum),
 		Id("DefLoc"):  Lit(gen.TraceNodes.AuthHandler()),
@@ -38,7 +36,13 @@ func Gen(gen *codegen.Generator, appDesc *app.Desc, ah *authhandler.AuthHandler,
 		Id("HasAuthData"): Lit(ah.AuthData.Present()),
 		Id("DecodeAuth"):  renderDecodeAuth(gen, f, ah, enc),
 		Id("AuthHandler"): renderAuthHandler(gen, ah, svcStruct),
-	}))
+	}
+	if ah.Scheme != "" {
+		fields[Id("Scheme")] = Lit(ah.Scheme)
+	}
+	desc.Value(Op("&").Add(apiQ("AuthHandlerDesc")).Types(
+		gu.Type(ah.Param),
+	).Values(fields))
 
 	f.Add(Func().Id("init").Params().Block(
 		Qual("encore.dev/appruntime/apisdk/api", "RegisterAuthHandler").Call(
@@ -72,11 +72,7 @@ func genAPIDesc(
 	}
 
 	pos := ep.Decl.AST.Pos()
-	desc := f.VarDecl("APIDesc", ep.Name)
-	desc.Value(Op("&").Add(apiQ("Desc")).Types(
-		reqDesc.Type(),
-		respDesc.Type(),
-	).Values(Dict{
+	fields := Dict{
 		Id("Service"):        Lit(svc.Name),
 		Id("SvcNum"):         Lit(fw.Num),
 		Id("Endpoint"):       Lit(ep.Name),
@@ -100,12 +96,42 @@ func genAPIDesc(
 
 		Id("ServiceMiddleware"):   serviceMiddleware(ep, fw, svcMiddleware),
 		Id("GlobalMiddlewareIDs"): globalMiddleware(appDesc, ep),
-	}))
+	}
+	if respDesc.HasHeaders() {
+		fields[Id("RespHeaders")] = respDesc.RespHeaders()
+	}
+	if ep.Version > 0 {
+		fields[Id("Version")] = Lit(ep.Version)
+	}
+	if dep, ok := ep.Deprecation.Get(); ok {
+		fields[Id("Deprecation")] = deprecation(dep)
+	}
+	if schemes := appDesc.EndpointAuthSchemes(ep); len(schemes) > 0 {
+		fields[Id("AuthSchemes")] = gu.GoToJen(pos, schemes)
+	}
+
+	desc := f.VarDecl("APIDesc", ep.Name)
+	desc.Value(Op("&").Add(apiQ("Desc")).Types(
+		reqDesc.Type(),
+		respDesc.Type(),
+	).Values(fields))
 
 	handler.desc = desc
 	return handler
 }
 
+// deprecation renders the deprecation description of an endpoint.
+func deprecation(dep api.Deprecation) *Statement {
+	return Op("&").Add(apiQ("Deprecation")).ValuesFunc(func(g *Group) {
+		if dep.Since != "" {
+			g.Id("Since").Op(":").Lit(dep.Since)
+		}
+		if dep.Sunset != "" {
+			g.Id("Sunset").Op(":").Lit(dep.Sunset)
+		}
+	})
+}
+
 func serviceMiddleware(ep *api.Endpoint, fw *apiframework.ServiceDesc, svcMiddleware map[*middleware.Middleware]*codegen.VarDecl) *Statement {
 	return Index().Op("*").Add(apiQ("Middleware")).ValuesFunc(func(g *Group) {
 		for _, mw := range fw.Middleware {
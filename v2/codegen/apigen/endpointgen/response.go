@@ -55,12 +55,9 @@ func (d *responseDesc) EncodeResponse() *Statement {
 		} else {
 			g.Id("respData").Op(":=").Index().Byte().Values(LitRune('\n'))
 		}
-		if len(resp.HeaderParameters) > 0 {
-			g.Var().Id("headers").Map(String()).Index().String()
-		}
 
-		responseEncoder := CustomFunc(Options{Separator: "\n"}, func(g *Group) {
-			if len(resp.BodyParameters) > 0 {
+		if len(resp.BodyParameters) > 0 {
+			responseEncoder := CustomFunc(Options{Separator: "\n"}, func(g *Group) {
 				g.Comment("Encode JSON body")
 				g.List(Id("respData"), Err()).Op("=").Qual("encore.dev/appruntime/shared/serde", "SerializeJSONFunc").Call(
 					Id("json"),
@@ -76,40 +73,58 @@ func (d *responseDesc) EncodeResponse() *Statement {
 					Return(Err()),
 				)
 				g.Id("respData").Op("=").Append(Id("respData"), LitRune('\n'))
+			})
+
+			// If response is a ptr we need to check it's not nil
+			if schemautil.IsPointer(d.ep.Response) {
+				g.If(Id("resp").Op("!=").Nil()).Block(responseEncoder)
+			} else {
+				g.Add(responseEncoder)
 			}
+		}
 
-			if len(resp.HeaderParameters) > 0 {
-				g.Line().Comment("Encode headers")
-				g.Id("headers").Op("=").Map(String()).Index().String().Values(DictFunc(func(dict Dict) {
-					for _, f := range resp.HeaderParameters {
-						if builtin, ok := f.Type.(schema.BuiltinType); ok {
-							encExpr := genutil.MarshalBuiltin(builtin.Kind, Id("resp").Dot(f.SrcName))
-							dict[Lit(f.WireName)] = Index().String().Values(encExpr)
-						} else {
-							d.gu.Errs.Addf(f.Type.ASTExpr().Pos(), "unsupported type in header: %s", d.gu.TypeToString(f.Type))
-						}
-					}
-				}))
+		g.Line().Comment("Write response")
+		g.Id("w").Dot("Write").Call(Id("respData"))
+		g.Return(Nil())
+	})
+}
+
+// HasHeaders reports whether the response has fields encoded as HTTP headers.
+func (d *responseDesc) HasHeaders() bool {
+	if d.ep.Raw || d.ep.Response == nil {
+		return false
+	}
+	resp := apienc.DescribeResponse(d.gu.Errs, d.ep.Response)
+	return len(resp.HeaderParameters) > 0
+}
+
+// RespHeaders returns the function literal to encode the response's HTTP headers.
+// The headers are written separately from the body so that middleware can modify them.
+func (d *responseDesc) RespHeaders() *Statement {
+	return Func().Params(
+		Id("resp").Add(d.Type()),
+	).Qual("net/http", "Header").BlockFunc(func(g *Group) {
+		resp := apienc.DescribeResponse(d.gu.Errs, d.ep.Response)
+		g.Id("headers").Op(":=").Make(Qual("net/http", "Header"))
+
+		headerEncoder := CustomFunc(Options{Separator: "\n"}, func(g *Group) {
+			for _, f := range resp.HeaderParameters {
+				if builtin, ok := f.Type.(schema.BuiltinType); ok {
+					encExpr := genutil.MarshalBuiltin(builtin.Kind, Id("resp").Dot(f.SrcName))
+					g.Id("headers").Dot("Set").Call(Lit(f.WireName), encExpr)
+				} else {
+					d.gu.Errs.Addf(f.Type.ASTExpr().Pos(), "unsupported type in header: %s", d.gu.TypeToString(f.Type))
+				}
 			}
 		})
 
 		// If response is a ptr we need to check it's not nil
 		if schemautil.IsPointer(d.ep.Response) {
-			g.If(Id("resp").Op("!=").Nil()).Block(responseEncoder)
+			g.If(Id("resp").Op("!=").Nil()).Block(headerEncoder)
 		} else {
-			g.Add(responseEncoder)
+			g.Add(headerEncoder)
 		}
-
-		g.Line().Comment("Write response")
-		if len(resp.HeaderParameters) > 0 {
-			g.For(List(Id("k"), Id("vs")).Op(":=").Range().Id("headers")).Block(
-				For(List(Id("_"), Id("v")).Op(":=").Range().Id("vs")).Block(
-					Id("w").Dot("Header").Call().Dot("Add").Call(Id("k"), Id("v")),
-				),
-			)
-		}
-		g.Id("w").Dot("Write").Call(Id("respData"))
-		g.Return(Nil())
+		g.Return(Id("headers"))
 	})
 }
 
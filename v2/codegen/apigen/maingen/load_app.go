@@ -14,9 +14,11 @@ import (
 	"encr.dev/v2/app/apiframework"
 	"encr.dev/v2/codegen"
 	"encr.dev/v2/internals/pkginfo"
+	"encr.dev/v2/parser"
 	"encr.dev/v2/parser/apis/api"
 	"encr.dev/v2/parser/apis/api/apienc"
 	"encr.dev/v2/parser/infra/pubsub"
+	"encr.dev/v2/parser/infra/secrets"
 )
 
 type testParams struct {
@@ -56,6 +58,14 @@ func genLoadApp(p GenParams, test option.Option[testParams]) {
 			Id("BundledServices"):   bundledServices(p.Desc),
 		}
 
+		if keys := secretKeys(p.Desc); len(keys) > 0 {
+			staticCfg[Id("SecretKeys")] = Index().String().ValuesFunc(func(g *Group) {
+				for _, key := range keys {
+					g.Lit(key)
+				}
+			})
+		}
+
 		if len(envsToEmbed) > 0 {
 			staticCfg[Id("TestAsExternalBinary")] = True()
 			for _, env := range envsToEmbed {
@@ -132,6 +142,22 @@ func bundledServices(appDesc *app.Desc) *Statement {
 	})
 }
 
+// secretKeys returns the sorted keys of all secrets used by the application.
+func secretKeys(appDesc *app.Desc) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, r := range parser.Resources[*secrets.Secrets](appDesc.Parse) {
+		for _, key := range r.Keys {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func testServiceMap(appDesc *app.Desc) *Statement {
 	return Map(String()).String().Values(DictFunc(func(d Dict) {
 		for _, svc := range appDesc.Services {
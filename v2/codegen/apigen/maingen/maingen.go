@@ -12,6 +12,7 @@ import (
 	"encr.dev/v2/codegen"
 	"encr.dev/v2/internals/pkginfo"
 	"encr.dev/v2/parser/apis/api"
+	"encr.dev/v2/parser/apis/authhandler"
 	"encr.dev/v2/parser/apis/middleware"
 )
 
@@ -30,7 +31,7 @@ type GenParams struct {
 	AppUncommitted bool
 
 	APIHandlers    map[*api.Endpoint]*codegen.VarDecl
-	AuthHandler    option.Option[*codegen.VarDecl]
+	AuthHandlers   map[*authhandler.AuthHandler]*codegen.VarDecl
 	Middleware     map[*middleware.Middleware]*codegen.VarDecl
 	ServiceStructs map[*app.Service]*codegen.VarDecl
 
@@ -71,7 +72,7 @@ func genMain(p GenParams) {
 	}
 	// Make sure auth handlers and global middleware are imported as well so they get registered.
 	if fw, ok := p.Desc.Framework.Get(); ok {
-		if ah, ok := fw.AuthHandler.Get(); ok {
+		for _, ah := range fw.AuthHandlers {
 			f.Anon(ah.Decl.File.Pkg.ImportPath.String())
 		}
 
@@ -112,7 +113,7 @@ func genExecScriptMain(p GenParams, mainPkgPath paths.Pkg) {
 
 	// Make sure auth handlers and global middleware are imported as well so they get registered.
 	if fw, ok := p.Desc.Framework.Get(); ok {
-		if ah, ok := fw.AuthHandler.Get(); ok {
+		for _, ah := range fw.AuthHandlers {
 			f.Anon(ah.Decl.File.Pkg.ImportPath.String())
 		}
 		for _, mw := range fw.GlobalMiddleware {
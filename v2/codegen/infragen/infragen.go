@@ -9,10 +9,12 @@ import (
 	"encr.dev/v2/codegen/infragen/configgen"
 	"encr.dev/v2/codegen/infragen/metricsgen"
 	"encr.dev/v2/codegen/infragen/secretsgen"
+	"encr.dev/v2/codegen/infragen/sqldbgen"
 	"encr.dev/v2/parser/infra/caches"
 	"encr.dev/v2/parser/infra/config"
 	"encr.dev/v2/parser/infra/metrics"
 	"encr.dev/v2/parser/infra/secrets"
+	"encr.dev/v2/parser/infra/sqldb"
 	"encr.dev/v2/parser/resource"
 )
 
@@ -42,6 +44,10 @@ func Process(gg *codegen.Generator, appDesc *app.Desc) {
 			secretsgen.Gen(gg, pkg, fns.Map(resources, func(r resource.Resource) *secrets.Secrets {
 				return r.(*secrets.Secrets)
 			}))
+		case resource.SQLDatabase:
+			for _, r := range resources {
+				sqldbgen.Gen(gg, r.(*sqldb.Database))
+			}
 		case resource.ConfigLoad:
 			svc, ok := appDesc.ServiceForPath(pkg.FSPath)
 			if !ok {
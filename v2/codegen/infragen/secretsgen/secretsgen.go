@@ -32,7 +32,11 @@ func Gen(gen *codegen.Generator, pkg *pkginfo.Package, secrets []*secrets.Secret
 		var buf bytes.Buffer
 		buf.WriteString("{\n")
 		for _, key := range secret.Keys {
-			fmt.Fprintf(&buf, "\t%s: __encore_secrets.Load(%s),\n", key, strconv.Quote(key))
+			if typ, ok := secret.ValueKeys[key]; ok {
+				fmt.Fprintf(&buf, "\t%s: __encore_secrets.LoadValue[%s](%s),\n", key, typ, strconv.Quote(key))
+			} else {
+				fmt.Fprintf(&buf, "\t%s: __encore_secrets.Load(%s),\n", key, strconv.Quote(key))
+			}
 		}
 		ep := gen.FS.Position(spec.End())
 		fmt.Fprintf(&buf, "}/*line :%d:%d*/", ep.Line, ep.Column)
@@ -0,0 +1,274 @@
+package sqldbgen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	. "github.com/dave/jennifer/jen"
+
+	"encr.dev/pkg/idents"
+	"encr.dev/pkg/namealloc"
+	"encr.dev/pkg/option"
+	"encr.dev/v2/codegen"
+	"encr.dev/v2/parser/infra/sqldb"
+	"encr.dev/v2/parser/infra/sqldb/sqlcheck"
+)
+
+const sqldbPkg = "encore.dev/storage/sqldb"
+
+// Gen generates typed functions for the annotated queries of db.
+// They're written to the user-facing encore.gen.go file of the database package,
+// so they can be called like any other function in the package.
+// If the database has no queries it returns None.
+func Gen(gen *codegen.Generator, db *sqldb.Database) option.Option[*codegen.File] {
+	if len(db.Queries) == 0 {
+		return option.None[*codegen.File]()
+	}
+
+	f := gen.InjectFile(db.Pkg.ImportPath, db.Pkg.Name, db.Pkg.FSPath, "encore.gen.go", "encoregen")
+	f.Jen.Comment("These functions are automatically generated by Encore from the annotated queries")
+	f.Jen.Comment("in the queries directory, and are updated whenever the queries or migrations change.")
+	f.Jen.Line()
+
+	dbVar := "encoreQueriesDB"
+	f.Jen.Var().Id(dbVar).Op("=").Qual(sqldbPkg, "Named").Call(Lit(db.Name))
+	f.Jen.Line()
+
+	for _, q := range db.Queries {
+		genQuery(f, dbVar, q)
+	}
+	return option.Some(f)
+}
+
+func genQuery(f *codegen.File, dbVar string, q *sqldb.Query) {
+	var (
+		paramsName = q.Name + "Params"
+		rowName    = q.Name + "Row"
+		iterName   = q.Name + "Iter"
+	)
+
+	// Generate the params struct.
+	params := fieldNames(len(q.Params), func(i int) string {
+		return q.Params[i].Name
+	}, func(i int) string {
+		return "Arg" + strconv.Itoa(i+1)
+	})
+	if len(q.Params) > 0 {
+		f.Jen.Commentf("%s are the parameters of %s.", paramsName, q.Name)
+		f.Jen.Type().Id(paramsName).StructFunc(func(g *Group) {
+			for i, p := range q.Params {
+				g.Id(params[i]).Add(goType(p.Type)).Comment(fmt.Sprintf("$%d", i+1))
+			}
+		})
+		f.Jen.Line()
+	}
+
+	// Generate the row struct.
+	columns := fieldNames(len(q.Columns), func(i int) string {
+		return q.Columns[i].Name
+	}, func(i int) string {
+		return "Column" + strconv.Itoa(i+1)
+	})
+	if len(q.Columns) > 0 {
+		f.Jen.Commentf("%s is a row returned by %s.", rowName, q.Name)
+		f.Jen.Type().Id(rowName).StructFunc(func(g *Group) {
+			for i, c := range q.Columns {
+				g.Id(columns[i]).Add(goType(c.Type))
+			}
+		})
+		f.Jen.Line()
+	}
+
+	// Generate the function itself.
+	if q.Doc != "" {
+		for _, line := range strings.Split(q.Doc, "\n") {
+			f.Jen.Comment(line)
+		}
+	} else {
+		f.Jen.Commentf("%s runs the query %s defined in %s.", q.Name, q.Name, q.File.Base())
+	}
+
+	args := []Code{Id("ctx"), Lit(q.SQL)}
+	for _, name := range params {
+		args = append(args, Id("p").Dot(name))
+	}
+	scanDest := func(row Code) []Code {
+		dest := make([]Code, len(columns))
+		for i, name := range columns {
+			dest[i] = Op("&").Add(row).Dot(name)
+		}
+		return dest
+	}
+
+	fn := f.Jen.Func().Id(q.Name).ParamsFunc(func(g *Group) {
+		g.Id("ctx").Qual("context", "Context")
+		if len(q.Params) > 0 {
+			g.Id("p").Id(paramsName)
+		}
+	})
+
+	switch q.Kind {
+	case sqldb.QueryOne:
+		fn.Params(Op("*").Id(rowName), Error()).Block(
+			Var().Id("r").Id(rowName),
+			Err().Op(":=").Id(dbVar).Dot("QueryRow").Call(args...).Dot("Scan").Call(scanDest(Id("r"))...),
+			If(Err().Op("!=").Nil()).Block(Return(Nil(), Err())),
+			Return(Op("&").Id("r"), Nil()),
+		)
+
+	case sqldb.QueryMany:
+		fn.Params(Op("*").Id(iterName), Error()).Block(
+			List(Id("rows"), Err()).Op(":=").Id(dbVar).Dot("Query").Call(args...),
+			If(Err().Op("!=").Nil()).Block(Return(Nil(), Err())),
+			Return(Op("&").Id(iterName).Values(Dict{Id("rows"): Id("rows")}), Nil()),
+		)
+		f.Jen.Line()
+		genIter(f, q.Name, iterName, rowName, scanDest(Id("it").Dot("row")))
+
+	case sqldb.QueryExec:
+		fn.Error().Block(
+			List(Id("_"), Err()).Op(":=").Id(dbVar).Dot("Exec").Call(args...),
+			Return(Err()),
+		)
+
+	case sqldb.QueryExecRows:
+		fn.Params(Int64(), Error()).Block(
+			List(Id("res"), Err()).Op(":=").Id(dbVar).Dot("Exec").Call(args...),
+			If(Err().Op("!=").Nil()).Block(Return(Lit(0), Err())),
+			Return(Id("res").Dot("RowsAffected").Call(), Nil()),
+		)
+	}
+	f.Jen.Line()
+}
+
+// genIter generates the iterator over the rows returned by a :many query.
+func genIter(f *codegen.File, queryName, iterName, rowName string, scanDest []Code) {
+	f.Jen.Commentf("%s is an iterator over the rows returned by %s.", iterName, queryName)
+	f.Jen.Comment("It must be closed when no longer used, unless Next has returned false.")
+	f.Jen.Type().Id(iterName).Struct(
+		Id("rows").Op("*").Qual(sqldbPkg, "Rows"),
+		Id("row").Op("*").Id(rowName),
+		Err().Error(),
+	)
+	f.Jen.Line()
+
+	recv := Id("it").Op("*").Id(iterName)
+
+	f.Jen.Comment("Next advances to the next row, reporting whether there is one.")
+	f.Jen.Comment("When there are no more rows, or an error occurs, the iterator is closed.")
+	f.Jen.Func().Params(recv).Id("Next").Params().Bool().Block(
+		If(Id("it").Dot("err").Op("!=").Nil().Op("||").Op("!").Id("it").Dot("rows").Dot("Next").Call()).Block(
+			Return(False()),
+		),
+		Id("it").Dot("row").Op("=").New(Id(rowName)),
+		If(
+			Id("it").Dot("err").Op("=").Id("it").Dot("rows").Dot("Scan").Call(scanDest...),
+			Id("it").Dot("err").Op("!=").Nil(),
+		).Block(
+			Id("it").Dot("rows").Dot("Close").Call(),
+			Return(False()),
+		),
+		Return(True()),
+	)
+	f.Jen.Line()
+
+	f.Jen.Comment("Row returns the current row.")
+	f.Jen.Func().Params(recv).Id("Row").Params().Op("*").Id(rowName).Block(
+		Return(Id("it").Dot("row")),
+	)
+	f.Jen.Line()
+
+	f.Jen.Comment("Err returns the error, if any, encountered during iteration.")
+	f.Jen.Func().Params(recv).Id("Err").Params().Error().Block(
+		If(Id("it").Dot("err").Op("!=").Nil()).Block(Return(Id("it").Dot("err"))),
+		Return(Id("it").Dot("rows").Dot("Err").Call()),
+	)
+	f.Jen.Line()
+
+	f.Jen.Comment("Close closes the iterator. It is safe to call multiple times.")
+	f.Jen.Func().Params(recv).Id("Close").Params().Block(
+		Id("it").Dot("rows").Dot("Close").Call(),
+	)
+}
+
+// fieldNames computes unique exported field names for n struct fields
+// based on the given names, using fallback for fields without a usable name.
+func fieldNames(n int, name, fallback func(i int) string) []string {
+	var names namealloc.Allocator
+	fields := make([]string, n)
+	for i := range fields {
+		field := fieldName(name(i))
+		if field == "" || !unicode.IsLetter([]rune(field)[0]) {
+			field = fallback(i)
+		}
+		fields[i] = names.Get(field)
+	}
+	return fields
+}
+
+// fieldName converts a database name like "user_id" to a Go field name like "UserID".
+func fieldName(name string) string {
+	parts := strings.Split(idents.Convert(name, idents.SnakeCase), "_")
+	for i, part := range parts {
+		if initialisms[part] {
+			parts[i] = strings.ToUpper(part)
+		} else {
+			parts[i] = strings.Title(part)
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// initialisms are the common initialisms that are written in upper case in Go names.
+var initialisms = map[string]bool{
+	"api": true, "id": true, "ip": true, "json": true, "html": true, "http": true,
+	"https": true, "sql": true, "uid": true, "uri": true, "url": true, "uuid": true,
+}
+
+// goType returns the Go type used for a database type.
+// Nullable values are represented as pointers, except for
+// slice types where NULL is represented as nil.
+func goType(typ sqlcheck.Type) *Statement {
+	var t *Statement
+	slice := false
+	switch typ.Name {
+	case "int2":
+		t = Int16()
+	case "int4":
+		t = Int32()
+	case "int8":
+		t = Int64()
+	case "float4":
+		t = Float32()
+	case "float8", "numeric":
+		t = Float64()
+	case "bool":
+		t = Bool()
+	case "text", "varchar", "bpchar", "citext":
+		t = String()
+	case "bytea":
+		t, slice = Index().Byte(), true
+	case "date", "timestamp", "timestamptz":
+		t = Qual("time", "Time")
+	case "json", "jsonb":
+		t, slice = Qual("encoding/json", "RawMessage"), true
+	case "uuid":
+		t = Qual("encore.dev/types/uuid", "UUID")
+	default:
+		if typ.Enum {
+			t = String()
+		} else {
+			// The type is unknown; let the database driver decide.
+			return Any()
+		}
+	}
+
+	if typ.Array {
+		return Index().Add(t)
+	} else if !typ.NotNull && !slice {
+		return Op("*").Add(t)
+	}
+	return t
+}
@@ -0,0 +1,239 @@
+// Package configfile loads service configuration from YAML and JSON files,
+// as an alternative to CUE.
+//
+// A service's configuration is layered from a base file named config.yaml
+// (or config.yml or config.json) in the service directory, overridden by an
+// environment-specific file such as config.prod.yaml or config.dev.yaml.
+// Objects are merged recursively; any other value in an environment file
+// replaces the value in the base file.
+package configfile
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"encr.dev/pkg/cueutil"
+)
+
+// EnvTypes are the environment types a service's configuration
+// must be valid for, in the order they are reported.
+var EnvTypes = []cueutil.EnvType{
+	cueutil.EnvType_Production,
+	cueutil.EnvType_Development,
+	cueutil.EnvType_Ephemeral,
+	cueutil.EnvType_Test,
+}
+
+// envSuffixes are the file name suffixes used for environment-specific config files.
+var envSuffixes = map[cueutil.EnvType]string{
+	cueutil.EnvType_Production:  "prod",
+	cueutil.EnvType_Development: "dev",
+	cueutil.EnvType_Ephemeral:   "ephemeral",
+	cueutil.EnvType_Test:        "test",
+}
+
+// extensions are the supported config file extensions.
+var extensions = []string{".yaml", ".yml", ".json"}
+
+// IsConfigFile reports whether the file name is a config file
+// recognized by this package, like "config.yaml" or "config.prod.json".
+func IsConfigFile(name string) bool {
+	_, ok := parseName(name)
+	return ok
+}
+
+// parseName parses a config file name, reporting the environment type
+// it applies to. The base config file is reported with an empty environment type.
+func parseName(name string) (envType cueutil.EnvType, ok bool) {
+	ext := path.Ext(name)
+	if !isExtension(ext) {
+		return "", false
+	}
+	base := strings.TrimSuffix(name, ext)
+	if base == "config" {
+		return "", true
+	}
+	suffix, found := strings.CutPrefix(base, "config.")
+	if !found {
+		return "", false
+	}
+	for et, s := range envSuffixes {
+		if s == suffix {
+			return et, true
+		}
+	}
+	return "", false
+}
+
+func isExtension(ext string) bool {
+	for _, e := range extensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// File is a parsed config file.
+type File struct {
+	// Path is the path to the file within the filesystem it was loaded from.
+	Path string
+
+	// Data is the parsed contents of the file.
+	// Objects are represented as map[string]any.
+	Data any
+}
+
+// Set is the set of config files for a service.
+type Set struct {
+	// Base is the config file used by all environments, or nil if there is none.
+	Base *File
+
+	// Envs are the environment-specific config files, keyed by environment type.
+	Envs map[cueutil.EnvType]*File
+}
+
+// Find finds and parses the config files in dir within fsys.
+// It reports nil if dir contains no config files.
+func Find(fsys fs.FS, dir string) (*Set, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var set *Set
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		envType, ok := parseName(e.Name())
+		if !ok {
+			continue
+		}
+		if set == nil {
+			set = &Set{Envs: make(map[cueutil.EnvType]*File)}
+		}
+
+		filePath := path.Join(dir, e.Name())
+		existing := set.Base
+		if envType != "" {
+			existing = set.Envs[envType]
+		}
+		if existing != nil {
+			return nil, fmt.Errorf("multiple config files for the same environment: %s and %s", existing.Path, filePath)
+		}
+
+		f, err := parseFile(fsys, filePath)
+		if err != nil {
+			return nil, err
+		}
+		if envType == "" {
+			set.Base = f
+		} else {
+			set.Envs[envType] = f
+		}
+	}
+	return set, nil
+}
+
+// parseFile parses the config file at filePath.
+// Since JSON is a subset of YAML, all files are parsed as YAML.
+func parseFile(fsys fs.FS, filePath string) (*File, error) {
+	data, err := fs.ReadFile(fsys, filePath)
+	if err != nil {
+		return nil, err
+	}
+	var val any
+	if err := yaml.Unmarshal(data, &val); err != nil {
+		return nil, fmt.Errorf("unable to parse config file %s: %v", filePath, err)
+	}
+	if val == nil {
+		// An empty file.
+		val = map[string]any{}
+	}
+	val = normalize(val)
+	if _, ok := val.(map[string]any); !ok {
+		return nil, fmt.Errorf("invalid config file %s: expected an object at the top level", filePath)
+	}
+	return &File{Path: filePath, Data: val}, nil
+}
+
+// normalize converts YAML mappings with non-string keys into map[string]any,
+// so the values can be represented as JSON.
+func normalize(val any) any {
+	switch val := val.(type) {
+	case map[string]any:
+		for k, v := range val {
+			val[k] = normalize(v)
+		}
+		return val
+	case map[any]any:
+		m := make(map[string]any, len(val))
+		for k, v := range val {
+			m[fmt.Sprint(k)] = normalize(v)
+		}
+		return m
+	case []any:
+		for i, v := range val {
+			val[i] = normalize(v)
+		}
+		return val
+	default:
+		return val
+	}
+}
+
+// Files returns the paths of the files used for the given environment type,
+// in the order they are applied.
+func (s *Set) Files(envType cueutil.EnvType) []string {
+	var files []string
+	if s.Base != nil {
+		files = append(files, s.Base.Path)
+	}
+	if f := s.Envs[envType]; f != nil {
+		files = append(files, f.Path)
+	}
+	return files
+}
+
+// Value returns the configuration for the given environment type,
+// with the environment-specific file merged on top of the base file.
+func (s *Set) Value(envType cueutil.EnvType) map[string]any {
+	val := map[string]any{}
+	if s.Base != nil {
+		val = merge(val, s.Base.Data).(map[string]any)
+	}
+	if f := s.Envs[envType]; f != nil {
+		val = merge(val, f.Data).(map[string]any)
+	}
+	return val
+}
+
+// merge merges src on top of dst, returning the result.
+// Objects are merged recursively; any other value in src replaces dst.
+// Neither dst nor src are modified.
+func merge(dst, src any) any {
+	srcMap, srcOK := src.(map[string]any)
+	dstMap, dstOK := dst.(map[string]any)
+	if !srcOK {
+		return src
+	} else if !dstOK {
+		dstMap = nil
+	}
+
+	out := make(map[string]any, len(dstMap)+len(srcMap))
+	for k, v := range dstMap {
+		out[k] = v
+	}
+	for k, v := range srcMap {
+		out[k] = merge(out[k], v)
+	}
+	return out
+}
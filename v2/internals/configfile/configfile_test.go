@@ -0,0 +1,183 @@
+package configfile
+
+import (
+	"context"
+	"go/token"
+	"testing"
+	"testing/fstest"
+
+	"github.com/fatih/structtag"
+	qt "github.com/frankban/quicktest"
+
+	"encr.dev/pkg/cueutil"
+	"encr.dev/pkg/option"
+	"encr.dev/v2/internals/perr"
+	"encr.dev/v2/internals/schema"
+)
+
+func TestIsConfigFile(t *testing.T) {
+	c := qt.New(t)
+	for name, want := range map[string]bool{
+		"config.yaml":      true,
+		"config.yml":       true,
+		"config.json":      true,
+		"config.prod.yaml": true,
+		"config.dev.json":  true,
+		"config.test.yml":  true,
+		"config.cue":       false,
+		"config.go":        false,
+		"config.foo.yaml":  false,
+		"other.yaml":       false,
+		"myconfig.yaml":    false,
+	} {
+		c.Check(IsConfigFile(name), qt.Equals, want, qt.Commentf("name: %s", name))
+	}
+}
+
+func TestFind(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"svc/config.yaml": {Data: []byte(`
+host: localhost
+db:
+  name: app
+  pool: 5
+tags: [a, b]
+`)},
+		"svc/config.prod.json": {Data: []byte(`{"host": "prod.example.com", "db": {"pool": 20}, "tags": ["c"]}`)},
+		"svc/config.cue":       {Data: []byte(`host: "ignored"`)},
+		"other/config.yaml":    {Data: []byte(`host: other`)},
+	}
+
+	set, err := Find(fsys, "svc")
+	c.Assert(err, qt.IsNil)
+	c.Assert(set, qt.IsNotNil)
+	c.Assert(set.Files(cueutil.EnvType_Production), qt.DeepEquals, []string{"svc/config.yaml", "svc/config.prod.json"})
+	c.Assert(set.Files(cueutil.EnvType_Development), qt.DeepEquals, []string{"svc/config.yaml"})
+
+	c.Assert(set.Value(cueutil.EnvType_Production), qt.DeepEquals, map[string]any{
+		"host": "prod.example.com",
+		"db":   map[string]any{"name": "app", "pool": 20},
+		"tags": []any{"c"},
+	})
+	c.Assert(set.Value(cueutil.EnvType_Development), qt.DeepEquals, map[string]any{
+		"host": "localhost",
+		"db":   map[string]any{"name": "app", "pool": 5},
+		"tags": []any{"a", "b"},
+	})
+
+	// Merging must not modify the base file.
+	c.Assert(set.Base.Data.(map[string]any)["db"], qt.DeepEquals, map[string]any{"name": "app", "pool": 5})
+
+	// Directories without config files report nil.
+	set, err = Find(fsys, "missing")
+	c.Assert(err, qt.IsNil)
+	c.Assert(set, qt.IsNil)
+}
+
+func TestFind_Errors(t *testing.T) {
+	c := qt.New(t)
+	_, err := Find(fstest.MapFS{
+		"svc/config.yaml": {Data: []byte(`host: a`)},
+		"svc/config.json": {Data: []byte(`{"host": "b"}`)},
+	}, "svc")
+	c.Assert(err, qt.ErrorMatches, `multiple config files for the same environment: svc/config.json and svc/config.yaml`)
+
+	_, err = Find(fstest.MapFS{
+		"svc/config.yaml": {Data: []byte(`[1, 2]`)},
+	}, "svc")
+	c.Assert(err, qt.ErrorMatches, `invalid config file svc/config.yaml: expected an object at the top level`)
+
+	_, err = Find(fstest.MapFS{
+		"svc/config.dev.yaml": {Data: []byte(`host: [`)},
+	}, "svc")
+	c.Assert(err, qt.ErrorMatches, `unable to parse config file svc/config.dev.yaml: .*`)
+}
+
+func TestValidate(t *testing.T) {
+	field := func(name string, typ schema.Type, tag string) schema.StructField {
+		tags, err := structtag.Parse(tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return schema.StructField{Name: option.Some(name), Type: typ, Tag: *tags}
+	}
+	builtin := func(kind schema.BuiltinKind) schema.Type { return schema.BuiltinType{Kind: kind} }
+
+	typ := schema.StructType{Fields: []schema.StructField{
+		field("Host", builtin(schema.String), ""),
+		field("Port", builtin(schema.Uint16), `json:"port"`),
+		field("Debug", builtin(schema.Bool), `json:"debug,omitempty"`),
+		field("Ratio", builtin(schema.Float64), `cue:",opt"`),
+		field("Started", builtin(schema.Time), `json:",omitempty"`),
+		field("Limits", schema.MapType{Key: builtin(schema.Int), Value: builtin(schema.Int8)}, `json:",omitempty"`),
+		field("Servers", schema.ListType{Elem: schema.StructType{Fields: []schema.StructField{
+			field("Name", builtin(schema.String), ""),
+		}}}, ""),
+		field("Fallback", schema.PointerType{Elem: builtin(schema.String)}, ""),
+	}}
+
+	tests := []struct {
+		name  string
+		value map[string]any
+		want  []Problem
+	}{
+		{
+			name: "valid",
+			value: map[string]any{
+				"Host":     "localhost",
+				"port":     8080,
+				"debug":    true,
+				"Ratio":    1,
+				"Started":  "2023-01-02T15:04:05Z",
+				"Limits":   map[string]any{"1": 10},
+				"Servers":  []any{map[string]any{"Name": "a"}},
+				"Fallback": nil,
+			},
+		},
+		{
+			name: "missing",
+			value: map[string]any{
+				"Servers": []any{map[string]any{}},
+			},
+			want: []Problem{
+				{Path: "Fallback", Missing: true, Msg: "required field is not set"},
+				{Path: "Host", Missing: true, Msg: "required field is not set"},
+				{Path: "Servers.0.Name", Missing: true, Msg: "required field is not set"},
+				{Path: "port", Missing: true, Msg: "required field is not set"},
+			},
+		},
+		{
+			name: "invalid",
+			value: map[string]any{
+				"Host":     5,
+				"port":     70000,
+				"Started":  "yesterday",
+				"Limits":   map[string]any{"one": 300},
+				"Servers":  map[string]any{},
+				"Fallback": false,
+				"Extra":    "x",
+			},
+			want: []Problem{
+				{Path: "Extra", Msg: "unknown field"},
+				{Path: "Fallback", Msg: "expected a string, got a bool"},
+				{Path: "Host", Msg: "expected a string, got an integer"},
+				{Path: "Limits.one", Msg: `invalid map key "one" for key type Int`},
+				{Path: "Limits.one", Msg: "value 300 out of range for Int8"},
+				{Path: "Servers", Msg: "expected a list, got an object"},
+				{Path: "Started", Msg: `invalid time "yesterday": expected RFC 3339 format`},
+				{Path: "port", Msg: "value 70000 out of range for Uint16"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := qt.New(t)
+			errs := perr.NewList(context.Background(), token.NewFileSet())
+			got := Validate(errs, typ, tt.value)
+			c.Assert(got, qt.DeepEquals, tt.want)
+			c.Assert(errs.Len(), qt.Equals, 0)
+		})
+	}
+}
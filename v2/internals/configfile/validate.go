@@ -0,0 +1,300 @@
+package configfile
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"encr.dev/v2/internals/perr"
+	"encr.dev/v2/internals/schema"
+	"encr.dev/v2/internals/schema/schemautil"
+)
+
+// Problem describes a value in a config file that doesn't match the config type.
+type Problem struct {
+	// Path is the path to the value, like "db.host" or "servers.0".
+	Path string
+
+	// Missing reports whether the problem is that a required field is missing.
+	Missing bool
+
+	// Msg describes the problem.
+	Msg string
+}
+
+func (p Problem) String() string {
+	return p.Path + ": " + p.Msg
+}
+
+// Validate validates the value against the config type typ, following the same
+// rules as the generated CUE definitions: fields are required unless they are
+// tagged with `json:",omitempty"` or `cue:",opt"`, and unknown fields are not allowed.
+//
+// The problems are reported ordered by path.
+func Validate(errs *perr.List, typ schema.Type, value any) []Problem {
+	v := &validator{errs: errs}
+	v.validate(nil, typ, value)
+	sort.SliceStable(v.problems, func(i, j int) bool {
+		return v.problems[i].Path < v.problems[j].Path
+	})
+	return v.problems
+}
+
+type validator struct {
+	errs     *perr.List
+	problems []Problem
+}
+
+func (v *validator) addf(path []string, format string, args ...any) {
+	v.problems = append(v.problems, Problem{
+		Path: strings.Join(path, "."),
+		Msg:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) validate(path []string, typ schema.Type, value any) {
+	switch t := typ.(type) {
+	case schema.NamedType:
+		if underlying, isList, isConfig := schemautil.UnwrapConfigType(v.errs, t); isConfig {
+			if isList {
+				underlying = schema.ListType{Elem: underlying}
+			}
+			v.validate(path, underlying, value)
+			return
+		}
+		v.validate(path, schemautil.ConcretizeWithTypeArgs(t.Decl().Type, t.TypeArgs), value)
+
+	case schema.PointerType:
+		if value != nil {
+			v.validate(path, t.Elem, value)
+		}
+
+	case schema.StructType:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			v.addf(path, "expected an object, got %s", describe(value))
+			return
+		}
+		v.validateStruct(path, t, obj)
+
+	case schema.MapType:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			v.addf(path, "expected an object, got %s", describe(value))
+			return
+		}
+		for _, key := range sortedKeys(obj) {
+			keyPath := append(path[:len(path):len(path)], key)
+			if builtin, ok := t.Key.(schema.BuiltinType); ok && !validKey(builtin.Kind, key) {
+				v.addf(keyPath, "invalid map key %q for key type %s", key, builtin.Kind)
+			}
+			v.validate(keyPath, t.Value, obj[key])
+		}
+
+	case schema.ListType:
+		list, ok := value.([]any)
+		if !ok {
+			v.addf(path, "expected a list, got %s", describe(value))
+			return
+		}
+		for i, elem := range list {
+			v.validate(append(path[:len(path):len(path)], strconv.Itoa(i)), t.Elem, elem)
+		}
+
+	case schema.BuiltinType:
+		if msg := checkBuiltin(t.Kind, value); msg != "" {
+			v.addf(path, "%s", msg)
+		}
+
+	default:
+		v.addf(path, "unsupported config type %s", typ)
+	}
+}
+
+func (v *validator) validateStruct(path []string, st schema.StructType, obj map[string]any) {
+	known := make(map[string]bool, len(st.Fields))
+	for _, f := range st.Fields {
+		if f.IsAnonymous() {
+			continue
+		}
+		name, optional := fieldName(f)
+		known[name] = true
+
+		fieldPath := append(path[:len(path):len(path)], name)
+		val, ok := obj[name]
+		if !ok {
+			if !optional {
+				v.problems = append(v.problems, Problem{
+					Path:    strings.Join(fieldPath, "."),
+					Missing: true,
+					Msg:     "required field is not set",
+				})
+			}
+			continue
+		}
+		v.validate(fieldPath, f.Type, val)
+	}
+
+	for _, key := range sortedKeys(obj) {
+		if !known[key] {
+			v.addf(append(path[:len(path):len(path)], key), "unknown field")
+		}
+	}
+}
+
+// fieldName returns the name of the field in the config file,
+// and whether the field is optional.
+func fieldName(f schema.StructField) (name string, optional bool) {
+	name = f.Name.MustGet()
+	for _, tag := range f.Tag.Tags() {
+		switch tag.Key {
+		case "json":
+			if tag.Name != "" {
+				name = tag.Name
+			}
+			if tag.HasOption("omitempty") {
+				optional = true
+			}
+		case "cue":
+			if tag.HasOption("opt") {
+				optional = true
+			}
+		}
+	}
+	return name, optional
+}
+
+// checkBuiltin checks that value is valid for the builtin kind,
+// returning a description of the problem if not.
+func checkBuiltin(kind schema.BuiltinKind, value any) string {
+	switch kind {
+	case schema.Any:
+		return ""
+
+	case schema.Bool:
+		if _, ok := value.(bool); !ok {
+			return "expected a bool, got " + describe(value)
+		}
+
+	case schema.String, schema.Bytes, schema.UUID, schema.JSON, schema.UserID:
+		if _, ok := value.(string); !ok {
+			return "expected a string, got " + describe(value)
+		}
+
+	case schema.Time:
+		s, ok := value.(string)
+		if !ok {
+			return "expected a time string, got " + describe(value)
+		} else if _, err := time.Parse(time.RFC3339, s); err != nil {
+			return fmt.Sprintf("invalid time %q: expected RFC 3339 format", s)
+		}
+
+	case schema.Float32, schema.Float64:
+		switch value.(type) {
+		case int, int64, uint64, float64:
+		default:
+			return "expected a number, got " + describe(value)
+		}
+
+	case schema.Int, schema.Int8, schema.Int16, schema.Int32, schema.Int64,
+		schema.Uint, schema.Uint8, schema.Uint16, schema.Uint32, schema.Uint64:
+		min, max := intRange(kind)
+		switch n := value.(type) {
+		case int:
+			if int64(n) < min || (n > 0 && uint64(n) > max) {
+				return fmt.Sprintf("value %d out of range for %s", n, kind)
+			}
+		case int64:
+			if n < min || (n > 0 && uint64(n) > max) {
+				return fmt.Sprintf("value %d out of range for %s", n, kind)
+			}
+		case uint64:
+			if n > max {
+				return fmt.Sprintf("value %d out of range for %s", n, kind)
+			}
+		default:
+			return "expected an integer, got " + describe(value)
+		}
+
+	default:
+		return fmt.Sprintf("unsupported config type %s", kind)
+	}
+	return ""
+}
+
+// intRange returns the range of values for the integer kind.
+func intRange(kind schema.BuiltinKind) (min int64, max uint64) {
+	switch kind {
+	case schema.Int8:
+		return math.MinInt8, math.MaxInt8
+	case schema.Int16:
+		return math.MinInt16, math.MaxInt16
+	case schema.Int32:
+		return math.MinInt32, math.MaxInt32
+	case schema.Int, schema.Int64:
+		return math.MinInt64, math.MaxInt64
+	case schema.Uint8:
+		return 0, math.MaxUint8
+	case schema.Uint16:
+		return 0, math.MaxUint16
+	case schema.Uint32:
+		return 0, math.MaxUint32
+	default:
+		return 0, math.MaxUint64
+	}
+}
+
+// validKey reports whether key is a valid map key for the builtin kind.
+// All keys are strings in the config files, so non-string keys must be parseable.
+func validKey(kind schema.BuiltinKind, key string) bool {
+	switch kind {
+	case schema.Bool:
+		_, err := strconv.ParseBool(key)
+		return err == nil
+	case schema.Int, schema.Int8, schema.Int16, schema.Int32, schema.Int64:
+		_, err := strconv.ParseInt(key, 10, 64)
+		return err == nil
+	case schema.Uint, schema.Uint8, schema.Uint16, schema.Uint32, schema.Uint64:
+		_, err := strconv.ParseUint(key, 10, 64)
+		return err == nil
+	case schema.Float32, schema.Float64:
+		_, err := strconv.ParseFloat(key, 64)
+		return err == nil
+	default:
+		return true
+	}
+}
+
+// describe describes the type of a value parsed from a config file.
+func describe(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a bool"
+	case string:
+		return "a string"
+	case int, int64, uint64:
+		return "an integer"
+	case float64:
+		return "a number"
+	case []any:
+		return "a list"
+	case map[string]any:
+		return "an object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func sortedKeys(obj map[string]any) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -49,6 +49,21 @@ func (p *Path) String() string {
 	return b.String()
 }
 
+// WithVersion returns a copy of the path prefixed with
+// a literal segment for the given API version, like "/v2/foo".
+func (p *Path) WithVersion(version int) *Path {
+	segs := make([]Segment, 0, len(p.Segments)+1)
+	segs = append(segs, Segment{
+		Type:      Literal,
+		Value:     fmt.Sprintf("v%d", version),
+		ValueType: schema.String,
+		StartPos:  p.StartPos,
+		EndPos:    p.StartPos,
+	})
+	segs = append(segs, p.Segments...)
+	return &Path{StartPos: p.StartPos, Segments: segs}
+}
+
 // NumParams reports the number of parameterized (non-literal) segments in the path.
 func (p *Path) NumParams() int {
 	n := 0
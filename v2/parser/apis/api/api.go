@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"golang.org/x/exp/slices"
 
@@ -17,6 +19,7 @@ import (
 	"encr.dev/v2/internals/schema"
 	"encr.dev/v2/internals/schema/schemautil"
 	"encr.dev/v2/parser/apis/api/apienc"
+	"encr.dev/v2/parser/apis/authhandler"
 	"encr.dev/v2/parser/apis/internal/directive"
 	"encr.dev/v2/parser/apis/selector"
 	"encr.dev/v2/parser/resource"
@@ -47,6 +50,14 @@ type Endpoint struct {
 	Response    schema.Type // response data; nil for Raw Endpoints
 	Tags        selector.Set
 	Recv        option.Option[*schema.Receiver] // None if not a method
+	Version     int                             // API version; 0 if unversioned
+	Deprecation option.Option[Deprecation]      // None if not deprecated
+
+	// AuthSchemes are the auth schemes the endpoint accepts, in the order
+	// they are tried, as given by the directive's "auth" field.
+	// It's empty if the endpoint accepts all of the app's auth schemes.
+	AuthSchemes []string
+	AuthField   option.Option[directive.Field]
 
 	reqEncOnce  sync.Once
 	reqEncoding []*apienc.RequestEncoding
@@ -55,6 +66,12 @@ type Endpoint struct {
 	respEncoding *apienc.ResponseEncoding
 }
 
+// Deprecation describes the deprecation of an endpoint.
+type Deprecation struct {
+	Since  string // "YYYY-MM-DD", or "" if unspecified
+	Sunset string // "YYYY-MM-DD", or "" if unspecified
+}
+
 func (ep *Endpoint) GoString() string {
 	if ep == nil {
 		return "(*api.Endpoint)(nil)"
@@ -290,11 +307,14 @@ func validateDirective(errs *perr.List, dir *directive.Directive) (*Endpoint, bo
 
 	var accessField directive.Field
 	var rawTag directive.Field
+	var deprecated bool
+	var dep Deprecation
+	var sunsetField directive.Field
 
 	accessOptions := []string{"public", "private", "auth"}
 	ok := directive.Validate(errs, dir, directive.ValidateSpec{
-		AllowedOptions: append([]string{"raw"}, accessOptions...),
-		AllowedFields:  []string{"path", "method"},
+		AllowedOptions: append([]string{"raw", "deprecated"}, accessOptions...),
+		AllowedFields:  []string{"path", "method", "version", "deprecated", "sunset", "auth"},
 
 		ValidateOption: func(errs *perr.List, opt directive.Field) (ok bool) {
 			// If this is an access option, check for duplicates.
@@ -308,8 +328,11 @@ func validateDirective(errs *perr.List, dir *directive.Directive) (*Endpoint, bo
 				endpoint.AccessField = option.Some(opt)
 			}
 
-			if opt.Value == "raw" {
+			switch opt.Value {
+			case "raw":
 				rawTag = opt
+			case "deprecated":
+				deprecated = true
 			}
 
 			return true
@@ -343,6 +366,41 @@ func validateDirective(errs *perr.List, dir *directive.Directive) (*Endpoint, bo
 						}
 					}
 				}
+
+			case "version":
+				v, err := strconv.Atoi(f.Value)
+				if err != nil || v < 1 {
+					errs.Add(errInvalidVersion(f.Value).AtGoNode(f))
+					return false
+				}
+				endpoint.Version = v
+
+			case "deprecated":
+				if !validateDate(errs, f) {
+					return false
+				}
+				deprecated = true
+				dep.Since = f.Value
+
+			case "sunset":
+				if !validateDate(errs, f) {
+					return false
+				}
+				dep.Sunset = f.Value
+				sunsetField = f
+
+			case "auth":
+				for _, scheme := range f.List() {
+					if !authhandler.IsValidScheme(scheme) {
+						errs.Add(errInvalidAuthScheme(scheme).AtGoNode(f))
+						return false
+					} else if slices.Contains(endpoint.AuthSchemes, scheme) {
+						errs.Add(errDuplicateAuthScheme(scheme).AtGoNode(f))
+						return false
+					}
+					endpoint.AuthSchemes = append(endpoint.AuthSchemes, scheme)
+				}
+				endpoint.AuthField = option.Some(f)
 			}
 			return true
 		},
@@ -359,9 +417,18 @@ func validateDirective(errs *perr.List, dir *directive.Directive) (*Endpoint, bo
 		return nil, false
 	}
 
-	// Access defaults to private if not provided.
+	// Access defaults to private if not provided,
+	// or to requiring auth if the auth schemes are given.
 	if endpoint.Access == "" {
-		endpoint.Access = Private
+		if len(endpoint.AuthSchemes) > 0 {
+			endpoint.Access = Auth
+		} else {
+			endpoint.Access = Private
+		}
+	}
+	if endpoint.Access == Private && len(endpoint.AuthSchemes) > 0 {
+		errs.Add(errPrivateEndpointWithAuthSchemes.AtGoNode(endpoint.AuthField.MustGet(), errors.AsError("auth schemes given here")).AtGoNode(accessField, errors.AsError("set as private here")))
+		return nil, false
 	}
 	if endpoint.Access == Private && endpoint.Raw {
 		// We don't support private raw APIs for now.
@@ -369,5 +436,27 @@ func validateDirective(errs *perr.List, dir *directive.Directive) (*Endpoint, bo
 		return nil, false
 	}
 
+	if dep.Sunset != "" {
+		if !deprecated {
+			errs.Add(errSunsetWithoutDeprecation.AtGoNode(sunsetField))
+			return nil, false
+		} else if dep.Since != "" && dep.Sunset < dep.Since {
+			errs.Add(errSunsetBeforeDeprecation.AtGoNode(sunsetField))
+			return nil, false
+		}
+	}
+	if deprecated {
+		endpoint.Deprecation = option.Some(dep)
+	}
+
 	return endpoint, true
 }
+
+// validateDate validates that the field value is a date in the format "YYYY-MM-DD".
+func validateDate(errs *perr.List, f directive.Field) (ok bool) {
+	if _, err := time.Parse("2006-01-02", f.Value); err != nil {
+		errs.Add(errInvalidDate(f.Key, f.Value).AtGoNode(f))
+		return false
+	}
+	return true
+}
@@ -119,6 +119,67 @@ func Raw(w http.ResponseWriter, req *http.Request) {}
 				HTTPMethods: []string{"*"},
 			},
 		},
+		{
+			name: "versioned",
+			def: `
+//encore:api public path=/foo version=2 deprecated
+func Foo(ctx context.Context) error {}
+`,
+			want: &Endpoint{
+				Name:        "Foo",
+				Doc:         "",
+				Access:      Public,
+				AccessField: option.Some(directive.Field{Value: "public"}),
+				Path: &resourcepaths.Path{Segments: []resourcepaths.Segment{
+					{Type: resourcepaths.Literal, Value: "foo", ValueType: schema.String},
+				}},
+				HTTPMethods: []string{"GET", "POST"},
+				Version:     2,
+				Deprecation: option.Some(Deprecation{}),
+			},
+		},
+		{
+			name: "deprecated_with_dates",
+			def: `
+//encore:api public path=/foo deprecated=2023-01-15 sunset=2023-06-30
+func Foo(ctx context.Context) error {}
+`,
+			want: &Endpoint{
+				Name:        "Foo",
+				Doc:         "",
+				Access:      Public,
+				AccessField: option.Some(directive.Field{Value: "public"}),
+				Path: &resourcepaths.Path{Segments: []resourcepaths.Segment{
+					{Type: resourcepaths.Literal, Value: "foo", ValueType: schema.String},
+				}},
+				HTTPMethods: []string{"GET", "POST"},
+				Deprecation: option.Some(Deprecation{Since: "2023-01-15", Sunset: "2023-06-30"}),
+			},
+		},
+		{
+			name: "invalid_version",
+			def: `
+//encore:api public path=/foo version=0
+func Foo(ctx context.Context) error {}
+`,
+			wantErrs: []string{`(?s).*Invalid API version "0": must be a positive integer.*`},
+		},
+		{
+			name: "sunset_without_deprecation",
+			def: `
+//encore:api public path=/foo sunset=2023-06-30
+func Foo(ctx context.Context) error {}
+`,
+			wantErrs: []string{`(?s).*APIs with a sunset date must also be marked deprecated.*`},
+		},
+		{
+			name: "sunset_before_deprecation",
+			def: `
+//encore:api public path=/foo deprecated=2023-06-30 sunset=2023-01-15
+func Foo(ctx context.Context) error {}
+`,
+			wantErrs: []string{`(?s).*The sunset date cannot be before the deprecation date.*`},
+		},
 	}
 
 	// testArchive renders the txtar archive to use for a given test.
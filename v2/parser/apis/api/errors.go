@@ -44,6 +44,46 @@ For more information on how to use APIs, see https://encore.dev/docs/primitives/
 		"Private APIs cannot be declared as raw endpoints.",
 	)
 
+	errInvalidAuthScheme = errRange.Newf(
+		"Invalid API Directive",
+		"Invalid auth scheme name %q: it must start with a lowercase letter and only contain lowercase letters, digits, dashes and underscores.",
+	)
+
+	errDuplicateAuthScheme = errRange.Newf(
+		"Invalid API Directive",
+		"The auth scheme %q is listed multiple times.",
+	)
+
+	errPrivateEndpointWithAuthSchemes = errRange.New(
+		"Invalid API Directive",
+		"Private APIs cannot specify auth schemes, as they can only be called by other services.",
+	)
+
+	errInvalidVersion = errRange.Newf(
+		"Invalid API Directive",
+		"Invalid API version %q: must be a positive integer.",
+	)
+
+	errInvalidDate = errRange.Newf(
+		"Invalid API Directive",
+		"Invalid %s date %q: must be in the format YYYY-MM-DD.",
+	)
+
+	errSunsetWithoutDeprecation = errRange.New(
+		"Invalid API Directive",
+		"APIs with a sunset date must also be marked deprecated.",
+	)
+
+	errSunsetBeforeDeprecation = errRange.New(
+		"Invalid API Directive",
+		"The sunset date cannot be before the deprecation date.",
+	)
+
+	ErrVersionPathMismatch = errRange.New(
+		"Invalid API Path",
+		"Versions of the same API must use identical paths.",
+	)
+
 	errWrongNumberParams = errRange.Newf(
 		"Invalid API Function",
 		"API functions must have at least 1 parameter, found %d parameters.",
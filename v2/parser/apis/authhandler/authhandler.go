@@ -20,6 +20,11 @@ type AuthHandler struct {
 	Doc  string
 	Name string // the name of the auth handler.
 
+	// Scheme is the name of the auth scheme the handler implements,
+	// as given by the directive's "scheme" field, or "" if unnamed.
+	// Endpoints select the schemes they accept with "auth=scheme1,scheme2".
+	Scheme string
+
 	// Param is the auth parameters.
 	// It's either a builtin string for token-based authentication,
 	// or a named struct type for complex auth parameters.
@@ -63,6 +68,24 @@ func Parse(d ParseData) *AuthHandler {
 		Recv: decl.Recv,
 	}
 
+	ok = directive.Validate(d.Errs, d.Dir, directive.ValidateSpec{
+		AllowedFields: []string{"scheme"},
+		ValidateField: func(errs *perr.List, f directive.Field) (ok bool) {
+			switch f.Key {
+			case "scheme":
+				if !IsValidScheme(f.Value) {
+					errs.Add(errInvalidScheme(f.Value).AtGoNode(f))
+					return false
+				}
+				ah.Scheme = f.Value
+			}
+			return true
+		},
+	})
+	if !ok {
+		return ah
+	}
+
 	sig := decl.Type
 	numParams := len(sig.Params)
 
@@ -121,6 +144,20 @@ func Parse(d ParseData) *AuthHandler {
 	return ah
 }
 
+// IsValidScheme reports whether name is a valid auth scheme name:
+// a lowercase letter followed by lowercase letters, digits, dashes or underscores.
+func IsValidScheme(name string) bool {
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case i > 0 && ((c >= '0' && c <= '9') || c == '-' || c == '_'):
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
+
 // validateStructFields checks that the struct fields have the correct tags.
 // and all fields are either a header or query string
 func validateStructFields(errs *perr.List, ref schema.StructType) {
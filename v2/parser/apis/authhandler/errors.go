@@ -74,9 +74,39 @@ note: *Params and *UserData are custom data types you define
 		),
 	)
 
+	errInvalidScheme = errRange.Newf(
+		"Invalid auth handler directive",
+		"Invalid auth scheme name %q: it must start with a lowercase letter and only contain lowercase letters, digits, dashes and underscores.",
+	)
+
 	ErrMultipleAuthHandlers = errRange.New(
 		"Multiple auth handlers found",
-		"Multiple auth handlers were found in the application. Encore only allows one auth handler to be defined per application.",
+		"Multiple auth handlers were found in the application without a scheme name.",
+
+		errors.WithDetails(
+			"When an application defines multiple auth handlers, each of them must be given a unique scheme name, "+
+				"like `//encore:authhandler scheme=apikey`.\n\n"+
+				authLink,
+		),
+	)
+
+	ErrDuplicateAuthScheme = errRange.Newf(
+		"Duplicate auth scheme",
+		"Multiple auth handlers were found with the scheme name %q. Each auth handler must have a unique scheme name.",
+	)
+
+	ErrAuthHandlerDataMismatch = errRange.New(
+		"Mismatched auth data types",
+		"All auth handlers must return the same custom auth data type, or none at all.",
+
+		errors.WithDetails("This ensures auth.Data() returns the same type regardless of which auth scheme authenticated the request.\n\n"+authLink),
+	)
+
+	ErrUnknownAuthScheme = errRange.Newf(
+		"Unknown auth scheme",
+		"The auth scheme %q is not implemented by any auth handler.",
+
+		errors.WithDetails("Give an auth handler the scheme name using `//encore:authhandler scheme=name`.\n\n"+authLink),
 	)
 
 	ErrNoAuthHandlerDefined = errRange.New(
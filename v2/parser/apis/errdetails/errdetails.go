@@ -0,0 +1,76 @@
+// Package errdetails parses types implementing errs.ErrDetails,
+// so that they can be described in the app metadata and exposed to clients.
+package errdetails
+
+import (
+	"go/ast"
+	"go/token"
+
+	"encr.dev/v2/internals/perr"
+	"encr.dev/v2/internals/pkginfo"
+	"encr.dev/v2/internals/schema"
+	"encr.dev/v2/internals/schema/schemautil"
+	"encr.dev/v2/parser/resource"
+)
+
+// ErrDetails describes a type implementing errs.ErrDetails.
+type ErrDetails struct {
+	Name   string           // the name identifying the type on the wire ("pkgname.TypeName")
+	Type   schema.NamedType // the named type
+	Decl   *schema.TypeDecl // the type declaration
+	Method *schema.FuncDecl // the ErrDetails marker method
+}
+
+func (d *ErrDetails) Kind() resource.Kind       { return resource.ErrDetails }
+func (d *ErrDetails) Package() *pkginfo.Package { return d.Decl.File.Pkg }
+func (d *ErrDetails) Pos() token.Pos            { return d.Method.AST.Pos() }
+func (d *ErrDetails) End() token.Pos            { return d.Method.AST.End() }
+
+// IsMarkerMethod reports whether fd is a declaration of the
+// errs.ErrDetails marker method.
+func IsMarkerMethod(fd *ast.FuncDecl) bool {
+	return fd.Recv != nil && len(fd.Recv.List) == 1 &&
+		fd.Name.Name == "ErrDetails" &&
+		fd.Type.Params.NumFields() == 0 &&
+		fd.Type.Results.NumFields() == 0
+}
+
+type ParseData struct {
+	Errs   *perr.List
+	Schema *schema.Parser
+
+	File *pkginfo.File
+	Func *ast.FuncDecl
+}
+
+// Parse parses the error details type the marker method is declared on.
+// It returns nil if the declaration is not valid.
+func Parse(d ParseData) *ErrDetails {
+	decl, ok := d.Schema.ParseFuncDecl(d.File, d.Func)
+	if !ok {
+		return nil
+	}
+	recv, ok := decl.Recv.Get()
+	if !ok {
+		return nil
+	}
+
+	if len(recv.Decl.TypeParams) > 0 {
+		d.Errs.Add(errGenericErrDetails.AtGoNode(recv.AST))
+		return nil
+	}
+
+	// The receiver is a named type, possibly behind a pointer.
+	typ, _ := schemautil.Deref(recv.Type)
+	named, ok := typ.(schema.NamedType)
+	if !ok {
+		return nil
+	}
+
+	return &ErrDetails{
+		Name:   d.File.Pkg.Name + "." + recv.Decl.Name,
+		Type:   named,
+		Decl:   recv.Decl,
+		Method: decl,
+	}
+}
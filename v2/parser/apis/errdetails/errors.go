@@ -0,0 +1,24 @@
+package errdetails
+
+import (
+	"encr.dev/pkg/errors"
+)
+
+var (
+	errRange = errors.Range(
+		"errdetails",
+		"For more information on error details, see https://encore.dev/docs/develop/errors",
+
+		errors.WithRangeSize(20),
+	)
+
+	errGenericErrDetails = errRange.New(
+		"Invalid error details type",
+		"Error details types cannot be defined as generic types.",
+	)
+
+	ErrDuplicateName = errRange.Newf(
+		"Duplicate error details type",
+		"The error details type %s is already declared in another package with the same name.",
+	)
+)
@@ -6,6 +6,7 @@ import (
 
 	"encr.dev/v2/parser/apis/api"
 	"encr.dev/v2/parser/apis/authhandler"
+	"encr.dev/v2/parser/apis/errdetails"
 	"encr.dev/v2/parser/apis/internal/directive"
 	"encr.dev/v2/parser/apis/middleware"
 	"encr.dev/v2/parser/apis/servicestruct"
@@ -21,6 +22,18 @@ var Parser = &resourceparser.Parser{
 			for _, decl := range file.AST().Decls {
 				switch decl := decl.(type) {
 				case *ast.FuncDecl:
+					if errdetails.IsMarkerMethod(decl) && !file.TestFile {
+						det := errdetails.Parse(errdetails.ParseData{
+							Errs:   p.Errs,
+							Schema: p.SchemaParser,
+							File:   file,
+							Func:   decl,
+						})
+						if det != nil {
+							p.RegisterResource(det)
+						}
+					}
+
 					if decl.Doc == nil {
 						continue
 					}
@@ -56,4 +56,19 @@ var (
 		"Invalid config type",
 		"The type of config.Value[T] cannot be another config.Value[T]",
 	)
+
+	ErrInvalidConfigFile = errRange.Newf(
+		"Invalid config file",
+		"The config files for service %s could not be loaded: %v.",
+	)
+
+	ErrInvalidConfigFileValue = errRange.Newf(
+		"Invalid config value",
+		"The config value %s does not match the config type in the %s environment(s) (files: %s): %s.",
+	)
+
+	ErrMissingConfigFileValue = errRange.Newf(
+		"Missing config value",
+		"The config field %s is required by the config type but is not set in the %s environment(s) (files: %s).",
+	)
 )
@@ -34,6 +34,11 @@ var (
 
 	errSecretsMustBeString = errRange.New(
 		"Invalid secrets struct",
-		"Secrets must be of type string.",
+		"Secrets must be of type string or secret.Value.",
+	)
+
+	errSecretValueType = errRange.New(
+		"Invalid secrets struct",
+		"A secret.Value must be of type secret.Value[string] or secret.Value[[]byte].",
 	)
 )
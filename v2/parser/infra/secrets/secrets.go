@@ -22,6 +22,11 @@ type Secrets struct {
 	Ident *ast.Ident    // The identifier of the secrets struct
 	Keys  []string      // Secret keys to load
 
+	// ValueKeys are the keys declared as secret.Value fields, which are
+	// refreshed when the secret is rotated. It maps each such key to the
+	// Go type of the value, either "string" or "[]byte".
+	ValueKeys map[string]string
+
 	// Spec is the value spec that defines the 'secrets' variable.
 	Spec *ast.ValueSpec
 }
@@ -78,11 +83,30 @@ var SecretsParser = &resourceparser.Parser{
 				p.Errs.Add(errAnonymousFields.AtGoNode(f.AST))
 				continue
 			}
-			if !schemautil.IsBuiltinKind(f.Type, schema.String) {
+			key := f.Name.MustGet()
+			if schemautil.IsNamed(f.Type, "encore.dev/secret", "Value") {
+				named := f.Type.(schema.NamedType)
+				var typ string
+				if len(named.TypeArgs) == 1 {
+					if schemautil.IsBuiltinKind(named.TypeArgs[0], schema.String) {
+						typ = "string"
+					} else if schemautil.IsBuiltinKind(named.TypeArgs[0], schema.Bytes) {
+						typ = "[]byte"
+					}
+				}
+				if typ == "" {
+					p.Errs.Add(errSecretValueType.AtGoNode(f.AST.Type, errors.AsError(fmt.Sprintf("got %s", literals.PrettyPrint(f.Type.ASTExpr())))))
+					continue
+				}
+				if res.ValueKeys == nil {
+					res.ValueKeys = make(map[string]string)
+				}
+				res.ValueKeys[key] = typ
+			} else if !schemautil.IsBuiltinKind(f.Type, schema.String) {
 				p.Errs.Add(errSecretsMustBeString.AtGoNode(f.AST.Type, errors.AsError(fmt.Sprintf("got %s", literals.PrettyPrint(f.Type.ASTExpr())))))
 				continue
 			}
-			res.Keys = append(res.Keys, f.Name.MustGet())
+			res.Keys = append(res.Keys, key)
 		}
 
 		p.RegisterResource(res)
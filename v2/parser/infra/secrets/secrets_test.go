@@ -0,0 +1,60 @@
+package secrets
+
+import (
+	"testing"
+
+	"encr.dev/v2/parser/resource/resourcetest"
+)
+
+func TestParseSecrets(t *testing.T) {
+	tests := []resourcetest.Case[*Secrets]{
+		{
+			Name: "basic",
+			Code: `
+var secrets struct {
+	Foo string
+	Bar string
+}
+`,
+			Want: &Secrets{
+				Keys: []string{"Foo", "Bar"},
+			},
+		},
+		{
+			Name:    "values",
+			Imports: []string{"encore.dev/secret"},
+			Code: `
+var secrets struct {
+	Foo string
+	Bar secret.Value[string]
+	Baz secret.Value[[]byte]
+}
+`,
+			Want: &Secrets{
+				Keys:      []string{"Foo", "Bar", "Baz"},
+				ValueKeys: map[string]string{"Bar": "string", "Baz": "[]byte"},
+			},
+		},
+		{
+			Name: "non_string",
+			Code: `
+var secrets struct {
+	Foo int
+}
+`,
+			WantErrs: []string{`(?s).*Secrets must be of type string or secret.Value.*`},
+		},
+		{
+			Name:    "invalid_value",
+			Imports: []string{"encore.dev/secret"},
+			Code: `
+var secrets struct {
+	Foo secret.Value[int]
+}
+`,
+			WantErrs: []string{`(?s).*A secret.Value must be of type secret.Value\[string\] or secret.Value\[\[\]byte\].*`},
+		},
+	}
+
+	resourcetest.Run(t, SecretsParser, tests)
+}
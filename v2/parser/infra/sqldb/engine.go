@@ -0,0 +1,71 @@
+package sqldb
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"encr.dev/pkg/errors"
+	"encr.dev/v2/internals/perr"
+)
+
+// Engine is the database engine a database runs on.
+type Engine string
+
+const (
+	Postgres Engine = "postgres"
+	MySQL    Engine = "mysql"
+	SQLite   Engine = "sqlite"
+)
+
+// engineDirectiveRe matches engine directives like "-- encore:engine mysql".
+var engineDirectiveRe = regexp.MustCompile(`^--\s*encore:engine(?:\s+(\S+))?\s*$`)
+
+// parseEngine determines the engine of db from the "-- encore:engine" directive
+// in the leading comments of its first migration, defaulting to Postgres.
+// Directives in later migrations are reported as errors, as the engine
+// can't be changed once the database has been created.
+func parseEngine(errs *perr.List, db *Database) Engine {
+	engine := Postgres
+	for i, m := range db.Migrations {
+		path := db.MigrationDir.Join(m.Filename).ToIO()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			// Reading the migrations is reported elsewhere.
+			continue
+		}
+
+		for lineNum, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimRightFunc(line, unicode.IsSpace)
+			trimmed := strings.TrimSpace(line)
+			if trimmed != "" && !strings.HasPrefix(trimmed, "--") {
+				// The directive must be in the leading comments.
+				break
+			}
+			match := engineDirectiveRe.FindStringSubmatch(trimmed)
+			if match == nil {
+				continue
+			}
+
+			start := token.Position{Filename: path, Line: lineNum + 1, Column: 1}
+			end := start
+			end.Column += len(line)
+			switch e := Engine(match[1]); {
+			case i > 0:
+				errs.Add(errMisplacedEngineDirective.AtGoPosition(start, end))
+			case e == Postgres || e == MySQL || e == SQLite:
+				engine = e
+			case e == "":
+				errs.Add(errInvalidEngine.AtGoPosition(start, end,
+					errors.AsError("the directive must name the engine, like \"-- encore:engine mysql\"")))
+			default:
+				errs.Add(errInvalidEngine.AtGoPosition(start, end,
+					errors.AsError(fmt.Sprintf("unknown engine %q: must be one of postgres, mysql or sqlite", e))))
+			}
+		}
+	}
+	return engine
+}
@@ -26,4 +26,45 @@ var (
 		"Invalid call to sqldb.Named",
 		"sqldb.Named requires a database name to be passed as a string literal.",
 	)
+
+	errInvalidQuery = errRange.New(
+		"Invalid SQL query",
+		"The query does not match the database schema defined by the migrations.",
+	)
+
+	errUnableToParseQueries = errRange.New(
+		"Unable to parse queries",
+		"Encore was unable to parse the annotated queries in the queries directory.",
+	)
+
+	errInvalidQueryAnnotation = errRange.New(
+		"Invalid query annotation",
+		"Queries in the queries directory must be annotated with their name and what they return, "+
+			"like \"-- name: GetUser :one\".",
+	)
+
+	errDuplicateQueryName = errRange.Newf(
+		"Duplicate query name",
+		"The query name %q is used more than once. Query names must be unique within a database.",
+	)
+
+	errInvalidEngine = errRange.New(
+		"Invalid database engine",
+		"The database engine must be one of postgres, mysql or sqlite.",
+	)
+
+	errMisplacedEngineDirective = errRange.New(
+		"Misplaced database engine directive",
+		"The database engine can only be set in the first migration, since it can't be changed once the database has been created.",
+	)
+
+	errQueriesUnsupportedEngine = errRange.Newf(
+		"Typed queries are not supported",
+		"Typed queries are only supported for PostgreSQL databases, but the database uses %s.",
+	)
+
+	errUnableToDescribeQuery = errRange.Newf(
+		"Unable to generate query function",
+		"Encore was unable to determine the parameters and results of the query %q.",
+	)
 )
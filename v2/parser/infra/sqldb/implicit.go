@@ -82,7 +82,7 @@ func ComputeImplicitUsage(errs *perr.List, pkgs []*pkginfo.Package, binds []reso
 				}
 
 				switch qn.Name {
-				case "Exec", "ExecTx", "QueryRow", "QueryRowTx", "Query", "QueryTx", "Begin":
+				case "Exec", "ExecTx", "QueryRow", "QueryRowTx", "Query", "QueryTx", "Begin", "InTx":
 					if bind, ok := findBind(file.Pkg.ImportPath); ok {
 						if u := classifySQLDBUsage(file, bind, sel, stack); u != nil {
 							usages = append(usages, u)
@@ -0,0 +1,258 @@
+package sqldb
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"encr.dev/pkg/errors"
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/internals/perr"
+	"encr.dev/v2/parser/infra/sqldb/sqlcheck"
+)
+
+// QueryKind describes what a generated query function returns.
+type QueryKind string
+
+const (
+	QueryOne      QueryKind = "one"      // a single row
+	QueryMany     QueryKind = "many"     // an iterator over the rows
+	QueryExec     QueryKind = "exec"     // nothing but an error
+	QueryExecRows QueryKind = "execrows" // the number of affected rows
+)
+
+// Query is an annotated query in a .sql file in the queries directory
+// of a database, for which typed Go functions are generated.
+type Query struct {
+	Name string    // the name of the query, used for the generated function
+	Kind QueryKind // what the query returns
+	Doc  string    // the comment preceding the annotation, if any
+	SQL  string    // the query itself
+
+	File paths.FS // the file the query is defined in
+	Line int      // the line of the annotation
+
+	// Params are the query parameters, where Params[i] is the placeholder $<i+1>.
+	Params []sqlcheck.Param
+
+	// Columns are the columns of the rows returned by the query.
+	Columns []sqlcheck.Column
+
+	src        string // the contents of the file
+	offset     int    // the offset of the query in the file
+	annotation string // the annotation line
+}
+
+// queryAnnotationRe matches query annotations like "-- name: GetUser :one".
+var queryAnnotationRe = regexp.MustCompile(`^--\s*name:\s*(\S+)(?:\s+:(\S+))?\s*$`)
+
+// queryNameRe matches valid query names, which must be exported Go identifiers.
+var queryNameRe = regexp.MustCompile(`^[A-Z][a-zA-Z0-9_]*$`)
+
+// parseQueries parses the annotated queries in the .sql files in the queries
+// directory of db, checking them against the schema defined by its migrations.
+func parseQueries(errs *perr.List, db *Database, queryDir paths.FS) []*Query {
+	files, err := os.ReadDir(queryDir.ToIO())
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		errs.Add(errUnableToParseQueries.InFile(queryDir.ToIO()).Wrapping(err))
+		return nil
+	}
+
+	var queries []*Query
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(strings.ToLower(f.Name())) != ".sql" {
+			continue
+		}
+		path := queryDir.Join(f.Name())
+		if db.Engine != Postgres {
+			// The queries are described using the PostgreSQL dialect.
+			errs.Add(errQueriesUnsupportedEngine(db.Engine).InFile(path.ToIO()))
+			return nil
+		}
+		data, err := os.ReadFile(path.ToIO())
+		if err != nil {
+			errs.Add(errUnableToParseQueries.InFile(path.ToIO()).Wrapping(err))
+			continue
+		}
+		queries = append(queries, parseQueryFile(errs, path, string(data))...)
+	}
+	if len(queries) == 0 {
+		return nil
+	}
+
+	// Query names must be unique within the database.
+	sort.SliceStable(queries, func(i, j int) bool { return queries[i].Name < queries[j].Name })
+	for i := 1; i < len(queries); i++ {
+		if prev, q := queries[i-1], queries[i]; prev.Name == q.Name {
+			start, end := q.annotationPos()
+			prevStart, prevEnd := prev.annotationPos()
+			errs.Add(errDuplicateQueryName(q.Name).
+				AtGoPosition(start, end).
+				AtGoPosition(prevStart, prevEnd, errors.AsHelp("previously defined here")))
+		}
+	}
+
+	schema, ok := loadSchema(db)
+	if !ok {
+		errs.Add(errUnableToParseQueries.InFile(queryDir.ToIO()).
+			Wrapping(fmt.Errorf("the migrations of database %q could not be parsed to determine the query types", db.Name)))
+		return nil
+	}
+	for _, q := range queries {
+		describeQuery(errs, schema, q)
+	}
+	return queries
+}
+
+// parseQueryFile parses the annotated queries in a single file.
+// Each query starts with an annotation like "-- name: GetUser :one"
+// and runs until the next annotation or the end of the file.
+func parseQueryFile(errs *perr.List, path paths.FS, src string) []*Query {
+	var (
+		queries []*Query
+		cur     *Query
+		doc     []string // consecutive comment lines
+		docLine int      // the offset of the first line of doc
+		offset  int      // the offset of the current line
+		qStart  int      // the offset of the current query
+		skip    bool     // whether to skip the current query due to an invalid annotation
+	)
+
+	finish := func(end int) {
+		if cur == nil {
+			return
+		}
+		raw := src[qStart:end]
+		cur.src, cur.offset = src, qStart+len(raw)-len(strings.TrimLeftFunc(raw, unicode.IsSpace))
+		cur.SQL = strings.TrimSpace(raw)
+		cur.SQL = strings.TrimSpace(strings.TrimSuffix(cur.SQL, ";"))
+		if cur.SQL == "" {
+			start, end := cur.annotationPos()
+			errs.Add(errInvalidQueryAnnotation.AtGoPosition(start, end, errors.AsError("the query is empty")))
+		} else {
+			queries = append(queries, cur)
+		}
+		cur = nil
+	}
+
+	lines := strings.SplitAfter(src, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		lineStart := offset
+		offset += len(line)
+
+		if m := queryAnnotationRe.FindStringSubmatch(trimmed); m != nil {
+			if doc != nil {
+				finish(docLine)
+			} else {
+				finish(lineStart)
+			}
+			q := &Query{
+				Name: m[1],
+				Kind: QueryKind(m[2]),
+				Doc:  strings.Join(doc, "\n"),
+				File: path,
+				Line: i + 1,
+
+				annotation: strings.TrimRightFunc(line, unicode.IsSpace),
+			}
+			doc, skip = nil, false
+			qStart = offset
+
+			start, end := q.annotationPos()
+			switch {
+			case !queryNameRe.MatchString(q.Name):
+				skip = true
+				errs.Add(errInvalidQueryAnnotation.AtGoPosition(start, end,
+					errors.AsError(fmt.Sprintf("invalid query name %q: must be an exported Go identifier", q.Name))))
+			case q.Kind != QueryOne && q.Kind != QueryMany && q.Kind != QueryExec && q.Kind != QueryExecRows:
+				skip = true
+				errs.Add(errInvalidQueryAnnotation.AtGoPosition(start, end,
+					errors.AsError("the query must be annotated with one of :one, :many, :exec or :execrows")))
+			default:
+				cur = q
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(trimmed, "--"):
+			if doc == nil {
+				docLine = lineStart
+			}
+			doc = append(doc, strings.TrimSpace(strings.TrimPrefix(trimmed, "--")))
+		case trimmed == "":
+			doc = nil
+		case cur != nil || skip:
+			doc = nil
+		default:
+			start := token.Position{Filename: path.ToIO(), Offset: lineStart, Line: i + 1, Column: 1}
+			end := start
+			end.Column += len(strings.TrimRightFunc(line, unicode.IsSpace))
+			errs.Add(errInvalidQueryAnnotation.AtGoPosition(start, end,
+				errors.AsError("queries must be preceded by an annotation like \"-- name: GetUser :one\"")))
+			return nil
+		}
+	}
+	if doc != nil {
+		finish(docLine)
+	} else {
+		finish(len(src))
+	}
+	return queries
+}
+
+// annotationPos returns the start and end positions of the annotation of the query.
+func (q *Query) annotationPos() (start, end token.Position) {
+	start = token.Position{Filename: q.File.ToIO(), Line: q.Line, Column: 1}
+	end = start
+	end.Column += len(q.annotation)
+	return start, end
+}
+
+// position returns the position of the given offset in the query.
+func (q *Query) position(offset int) token.Position {
+	offset += q.offset
+	before := q.src[:offset]
+	line := strings.Count(before, "\n") + 1
+	col := offset - strings.LastIndexByte(before, '\n')
+	return token.Position{Filename: q.File.ToIO(), Offset: offset, Line: line, Column: col}
+}
+
+// describeQuery checks the query against the schema and determines
+// the types of its parameters and result columns.
+func describeQuery(errs *perr.List, schema *sqlcheck.Schema, q *Query) {
+	problems := sqlcheck.Check(schema, q.SQL, -1)
+	for _, p := range problems {
+		start, end := q.position(p.Pos), q.position(p.End)
+		errs.Add(errInvalidQuery.AtGoPosition(start, end, errors.AsError(p.Message)))
+	}
+	if len(problems) > 0 {
+		return
+	}
+
+	desc, err := sqlcheck.Describe(schema, q.SQL)
+	if err != nil {
+		start, end := q.annotationPos()
+		errs.Add(errUnableToDescribeQuery(q.Name).AtGoPosition(start, end, errors.AsError(err.Error())))
+		return
+	}
+	q.Params, q.Columns = desc.Params, desc.Columns
+
+	start, end := q.annotationPos()
+	switch {
+	case (q.Kind == QueryOne || q.Kind == QueryMany) && len(q.Columns) == 0:
+		errs.Add(errUnableToDescribeQuery(q.Name).AtGoPosition(start, end,
+			errors.AsError(fmt.Sprintf("queries annotated with :%s must return rows", q.Kind))))
+	case (q.Kind == QueryExec || q.Kind == QueryExecRows) && len(q.Columns) > 0:
+		errs.Add(errUnableToDescribeQuery(q.Name).AtGoPosition(start, end,
+			errors.AsError(fmt.Sprintf("queries annotated with :%s must not return rows; use :one or :many", q.Kind))))
+	}
+}
@@ -0,0 +1,163 @@
+package sqldb
+
+import (
+	"go/ast"
+	"go/token"
+	"os"
+	"strconv"
+
+	"encr.dev/pkg/errors"
+	"encr.dev/v2/internals/perr"
+	"encr.dev/v2/parser/infra/sqldb/sqlcheck"
+	"encr.dev/v2/parser/resource/usage"
+)
+
+// queryArgIndex is the index of the query argument of the sqldb
+// functions and methods that take a query.
+var queryArgIndex = map[string]int{
+	"Exec":       1,
+	"Query":      1,
+	"QueryRow":   1,
+	"ExecTx":     2,
+	"QueryTx":    2,
+	"QueryRowTx": 2,
+}
+
+// CheckQueries checks the constant queries made against the database
+// against the schema defined by its migrations.
+func CheckQueries(errs *perr.List, db *Database, usages []usage.Usage) {
+	if db.Engine != Postgres {
+		// The checks only understand the PostgreSQL dialect.
+		return
+	}
+
+	var schema *sqlcheck.Schema
+	for _, u := range usages {
+		du, ok := u.(*DatabaseUsage)
+		if !ok {
+			continue
+		}
+		call, ok := du.Expr.(*usage.MethodCall)
+		if !ok {
+			continue
+		}
+		idx, ok := queryArgIndex[call.Method]
+		if !ok || len(call.Args) <= idx {
+			continue
+		}
+		q, ok := parseQuery(call.Args[idx])
+		if !ok {
+			continue
+		}
+
+		if schema == nil {
+			if schema, ok = loadSchema(db); !ok {
+				// Don't report problems with migrations we can't follow.
+				return
+			}
+		}
+
+		numArgs := len(call.Args) - idx - 1
+		if call.Call.Ellipsis.IsValid() {
+			numArgs = -1
+		}
+		for _, p := range sqlcheck.Check(schema, q.text, numArgs) {
+			start, end := q.span(p.Pos, p.End)
+			errs.Add(errInvalidQuery.AtGoPos(start, end, errors.AsError(p.Message)))
+		}
+	}
+}
+
+// loadSchema replays the migrations of db into a schema.
+// It reports false if the migrations can't be read or parsed.
+func loadSchema(db *Database) (*sqlcheck.Schema, bool) {
+	schema := sqlcheck.NewSchema()
+	for _, m := range db.Migrations {
+		data, err := os.ReadFile(db.MigrationDir.Join(m.Filename).ToIO())
+		if err != nil {
+			return nil, false
+		}
+		if err := schema.Apply(string(data)); err != nil {
+			return nil, false
+		}
+	}
+	return schema, true
+}
+
+// query is a constant query string in the source code.
+type query struct {
+	expr     ast.Expr
+	text     string
+	segments []querySegment
+}
+
+// querySegment is a string literal making up part of a query.
+type querySegment struct {
+	offset int // the offset of the segment in the query
+	lit    *ast.BasicLit
+	exact  bool // whether offsets in the literal map directly to source positions
+}
+
+// parseQuery parses expr as a string literal or a concatenation of string literals.
+func parseQuery(expr ast.Expr) (*query, bool) {
+	q := &query{expr: expr}
+	var walk func(e ast.Expr) bool
+	walk = func(e ast.Expr) bool {
+		switch e := e.(type) {
+		case *ast.ParenExpr:
+			return walk(e.X)
+		case *ast.BinaryExpr:
+			return e.Op == token.ADD && walk(e.X) && walk(e.Y)
+		case *ast.BasicLit:
+			if e.Kind != token.STRING {
+				return false
+			}
+			s, err := strconv.Unquote(e.Value)
+			if err != nil {
+				return false
+			}
+			// Offsets map directly to the source unless the literal contains
+			// escape sequences, or carriage returns that are dropped from raw strings.
+			exact := len(s) == len(e.Value)-2
+			q.segments = append(q.segments, querySegment{offset: len(q.text), lit: e, exact: exact})
+			q.text += s
+			return true
+		}
+		return false
+	}
+	if !walk(expr) {
+		return nil, false
+	}
+	return q, true
+}
+
+// span returns the source positions of the given range of offsets in the query.
+// Ranges covering the whole query span the whole expression.
+func (q *query) span(start, end int) (token.Pos, token.Pos) {
+	if start == 0 && end == len(q.text) {
+		return q.expr.Pos(), q.expr.End()
+	}
+
+	// segment returns the segment containing the offset.
+	// Offsets at the boundary of two segments belong to the
+	// earlier segment for the end of the range.
+	segment := func(offset int, isEnd bool) querySegment {
+		for i := len(q.segments) - 1; i > 0; i-- {
+			seg := q.segments[i]
+			if offset > seg.offset || (offset == seg.offset && !isEnd) {
+				return seg
+			}
+		}
+		return q.segments[0]
+	}
+
+	s, e := segment(start, false), segment(end, true)
+	startPos, endPos := s.lit.Pos(), e.lit.End()
+	if s.exact {
+		startPos = s.lit.Pos() + 1 + token.Pos(start-s.offset)
+	}
+	if e.exact {
+		endPos = e.lit.Pos() + 1 + token.Pos(end-e.offset)
+	}
+	return startPos, endPos
+}
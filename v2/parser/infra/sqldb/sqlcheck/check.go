@@ -0,0 +1,564 @@
+package sqlcheck
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Problem is a problem found in a query.
+type Problem struct {
+	Pos, End int // byte offsets of the problem in the query
+	Message  string
+}
+
+// Check checks query against the schema. It reports references to tables
+// and columns that don't exist, and placeholders that don't match the arguments.
+// numArgs is the number of arguments given with the query, or -1 if unknown.
+func Check(s *Schema, query string, numArgs int) []Problem {
+	toks, err := lex(query)
+	if err != nil {
+		le := err.(*lexError)
+		return []Problem{{Pos: le.pos, End: le.end, Message: le.msg}}
+	}
+
+	problems := checkPlaceholders(toks, numArgs, len(query))
+	if !s.opaque {
+		for _, stmt := range splitStatements(toks) {
+			c := &stmtChecker{
+				s:        s,
+				toks:     stmt,
+				consumed: make([]bool, len(stmt)),
+				ctes:     make(map[string]bool),
+				aliases:  make(map[string]*Table),
+			}
+			problems = append(problems, c.check()...)
+		}
+	}
+	return problems
+}
+
+// checkPlaceholders checks that the placeholders $1, $2, ... are numbered
+// consecutively and match the number of arguments.
+func checkPlaceholders(toks []token, numArgs, queryLen int) []Problem {
+	var problems []Problem
+	used := make(map[int]bool)
+	max := 0
+	for _, t := range toks {
+		if t.kind != tokParam {
+			continue
+		}
+		n, err := strconv.Atoi(t.val[1:])
+		if err != nil || n == 0 {
+			problems = append(problems, Problem{t.pos, t.end, fmt.Sprintf("invalid placeholder %s", t.val)})
+			continue
+		}
+		if numArgs >= 0 && n > numArgs && !used[n] {
+			problems = append(problems, Problem{t.pos, t.end, fmt.Sprintf("placeholder %s has no argument (got %s)", t.val, plural(numArgs, "argument"))})
+		}
+		used[n] = true
+		if n > max {
+			max = n
+		}
+	}
+
+	for n := 1; n < max; n++ {
+		if !used[n] {
+			problems = append(problems, Problem{0, queryLen, fmt.Sprintf("placeholder $%d is missing (the query uses $%d)", n, max)})
+		}
+	}
+	if numArgs > max {
+		problems = append(problems, Problem{0, queryLen, fmt.Sprintf("the query expects %s but is given %d", plural(max, "argument"), numArgs)})
+	}
+	return problems
+}
+
+// stmtChecker checks a single statement.
+//
+// Rather than fully parsing the statement it looks for the table references
+// following FROM, JOIN, UPDATE and the like, and for identifiers in positions
+// where they can only be column references. The tables and columns are checked
+// once the whole statement has been scanned, as columns can be referenced
+// before the tables they belong to.
+type stmtChecker struct {
+	s        *Schema
+	toks     []token
+	consumed []bool // tokens that are part of table references
+
+	ctes          map[string]bool   // names of common table expressions
+	aliases       map[string]*Table // table references by alias; nil for unknown tables
+	tables        []*Table          // the known tables referenced
+	sources       []source          // the table references, in order
+	depth         int               // the nesting depth of the subquery being scanned
+	unknownSource bool              // whether unknown tables or subqueries are referenced
+
+	target     *Table  // the table targeted by INSERT or UPDATE, if known
+	targetCols []token // columns of the target referenced by INSERT or SET
+	qualified  [][2]token
+	columns    []token // unqualified column references
+
+	problems []Problem
+}
+
+// source is a table reference.
+type source struct {
+	alias    string
+	table    *Table // nil if unknown
+	nullable bool   // whether the table is on the nullable side of an outer join
+	depth    int    // the nesting depth of the subquery referencing the table
+}
+
+// frame is a parenthesized part of the statement.
+type frame struct {
+	subquery bool   // whether the parentheses contain a subquery (or the whole statement)
+	clause   string // the clause being scanned, like "select" or "where"
+}
+
+// checkedClauses are the clauses where unqualified column references are checked.
+// Clauses like ORDER BY are excluded as they can reference output column names.
+var checkedClauses = map[string]bool{
+	"select":    true,
+	"where":     true,
+	"on":        true,
+	"having":    true,
+	"returning": true,
+	"set":       true,
+	"conflict":  true,
+	"using":     true,
+}
+
+func (c *stmtChecker) check() []Problem {
+	if first := c.toks[0]; !first.is("select") && !first.is("with") && !first.is("insert") &&
+		!first.is("update") && !first.is("delete") && !first.is("truncate") && !first.is("(") {
+		// Not a statement we check, like DDL.
+		return nil
+	}
+
+	c.findCTEs()
+	frames := []*frame{{subquery: true}}
+	for i := 0; i < len(c.toks); i++ {
+		if c.consumed[i] {
+			continue
+		}
+		t, fr := c.toks[i], frames[len(frames)-1]
+		prev := c.tok(i - 1)
+
+		switch {
+		case t.is("("):
+			next := c.tok(i + 1)
+			sub := next.is("select") || next.is("with") || next.is("values")
+			clause := fr.clause
+			if sub {
+				clause = ""
+			}
+			frames = append(frames, &frame{subquery: sub, clause: clause})
+			if sub {
+				c.depth++
+			}
+			continue
+		case t.is(")"):
+			if len(frames) > 1 {
+				if fr.subquery {
+					c.depth--
+				}
+				frames = frames[:len(frames)-1]
+			}
+			continue
+		}
+
+		// Table references.
+		if fr.subquery {
+			switch {
+			case prev.is("from") && !c.tok(i-2).is("distinct"),
+				prev.is(",") && fr.clause == "from":
+				i = c.tableRef(i, refSource) - 1
+				continue
+			case prev.is("join"):
+				// Tables on the right side of LEFT and FULL joins can be NULL,
+				// as can the tables on the left side of RIGHT and FULL joins.
+				side := c.tok(i - 2)
+				if side.is("outer") {
+					side = c.tok(i - 3)
+				}
+				if side.is("right") || side.is("full") {
+					for x := range c.sources {
+						if c.sources[x].depth == c.depth {
+							c.sources[x].nullable = true
+						}
+					}
+				}
+				i = c.tableRef(i, refSource) - 1
+				if side.is("left") || side.is("full") {
+					c.sources[len(c.sources)-1].nullable = true
+				}
+				continue
+			case prev.is("using") && !t.is("("):
+				// DELETE ... USING
+				fr.clause = "from"
+				i = c.tableRef(i, refSource) - 1
+				continue
+			case prev.is("truncate"):
+				if t.is("table") {
+					continue
+				}
+				fr.clause = "from"
+				i = c.tableRef(i, refSource) - 1
+				continue
+			case prev.is("table") && c.tok(i-2).is("truncate"):
+				fr.clause = "from"
+				i = c.tableRef(i, refSource) - 1
+				continue
+			case prev.is("update") && !c.tok(i-2).is("for") && !c.tok(i-2).is("key") && !c.tok(i-2).is("do"):
+				i = c.tableRef(i, refUpdate) - 1
+				continue
+			case prev.is("into") && c.tok(i-2).is("insert"):
+				i = c.insertTarget(i) - 1
+				continue
+			}
+		}
+
+		if t.kind == tokIdent {
+			if clause, ok := clauseKeywords[t.val]; ok {
+				switch {
+				case t.is("from") && prev.is("distinct"):
+				case t.is("on") && prev.is("distinct"):
+				case t.is("on") && c.tok(i+1).is("conflict"):
+				default:
+					fr.clause = clause
+				}
+				continue
+			}
+		}
+
+		if t.isName() {
+			c.columnRef(i, fr)
+		}
+	}
+
+	c.checkColumns()
+	return c.problems
+}
+
+// clauseKeywords maps keywords to the clause they start.
+var clauseKeywords = map[string]string{
+	"select":    "select",
+	"from":      "from",
+	"join":      "from",
+	"where":     "where",
+	"group":     "group",
+	"order":     "order",
+	"having":    "having",
+	"window":    "window",
+	"partition": "partition",
+	"returning": "returning",
+	"set":       "set",
+	"values":    "values",
+	"on":        "on",
+	"using":     "using",
+	"limit":     "limit",
+	"offset":    "limit",
+	"fetch":     "limit",
+	"for":       "for",
+	"conflict":  "conflict",
+	"do":        "do",
+	"into":      "into",
+	"union":     "",
+	"intersect": "",
+	"except":    "",
+	"insert":    "",
+	"update":    "",
+	"delete":    "",
+	"truncate":  "",
+}
+
+// findCTEs finds the names of common table expressions, like x in "WITH x AS (...)".
+func (c *stmtChecker) findCTEs() {
+	for i, t := range c.toks {
+		if !t.isName() {
+			continue
+		} else if prev := c.tok(i - 1); !prev.is("with") && !prev.is("recursive") && !prev.is(",") {
+			continue
+		}
+
+		j := i + 1
+		if c.tok(j).is("(") {
+			j = c.matchingParen(j) + 1
+		}
+		if !c.tok(j).is("as") {
+			continue
+		}
+		k := j + 1
+		if c.tok(k).is("not") {
+			k++
+		}
+		if c.tok(k).is("materialized") {
+			k++
+		}
+		if !c.tok(k).is("(") {
+			continue
+		}
+
+		c.ctes[t.val] = true
+		for x := i; x < j; x++ {
+			c.consumed[x] = true
+		}
+	}
+}
+
+// refKind is the kind of a table reference.
+type refKind int
+
+const (
+	refSource refKind = iota // a table in FROM, JOIN and the like
+	refUpdate                // the target of an UPDATE
+	refInsert                // the target of an INSERT
+)
+
+// tableRef parses a table reference starting at toks[i],
+// returning the index of the first token following it.
+func (c *stmtChecker) tableRef(i int, kind refKind) int {
+	for c.tok(i).is("only") || c.tok(i).is("lateral") {
+		c.consumed[i] = true
+		i++
+	}
+	if !c.tok(i).isName() {
+		// A subquery or something we don't understand.
+		c.unknownSource = true
+		c.sources = append(c.sources, source{depth: c.depth})
+		return i
+	}
+
+	// Parse the possibly qualified name.
+	first := c.toks[i]
+	name, display, end := first.val, first.val, first.end
+	c.consumed[i] = true
+	i++
+	schema := ""
+	if c.tok(i).is(".") && c.tok(i+1).isName() {
+		schema, name, end = first.val, c.toks[i+1].val, c.toks[i+1].end
+		display = schema + "." + name
+		c.consumed[i], c.consumed[i+1] = true, true
+		i += 2
+	}
+	if c.tok(i).is("(") && kind == refSource {
+		// A function call, like generate_series(...).
+		c.unknownSource = true
+		c.sources = append(c.sources, source{depth: c.depth})
+		return i
+	}
+	if c.tok(i).is("*") {
+		c.consumed[i] = true
+		i++
+	}
+
+	var tbl *Table
+	switch {
+	case schema == "" && c.ctes[name]:
+	case schema != "" && !c.s.schemas[schema]:
+		// A schema not managed by the migrations, like pg_catalog.
+	default:
+		if t, ok := c.s.Table(qualify(or(schema, "public"), name)); ok {
+			tbl = t
+		} else if !c.s.openTables && !strings.HasPrefix(name, "pg_") {
+			c.problems = append(c.problems, Problem{first.pos, end, fmt.Sprintf("table %q does not exist", display)})
+		}
+	}
+
+	// Parse the alias, if any.
+	alias := name
+	if c.tok(i).is("as") && c.tok(i+1).isName() {
+		c.consumed[i] = true
+		i++
+	}
+	if t := c.tok(i); t.kind == tokQuotedIdent || (t.kind == tokIdent && !keywords[t.val]) {
+		alias = t.val
+		c.consumed[i] = true
+		i++
+		if c.tok(i).is("(") && kind == refSource {
+			// Column aliases rename the columns.
+			end := c.matchingParen(i)
+			for x := i; x <= end; x++ {
+				c.consumed[x] = true
+			}
+			i = end + 1
+			tbl = nil
+		}
+	}
+
+	if tbl == nil {
+		c.unknownSource = true
+	} else {
+		c.tables = append(c.tables, tbl)
+	}
+	c.aliases[alias] = tbl
+	c.sources = append(c.sources, source{alias: alias, table: tbl, depth: c.depth})
+	if kind != refSource {
+		c.target = tbl
+	}
+	return i
+}
+
+// insertTarget parses the target of an INSERT statement, starting at toks[i],
+// returning the index of the first token following it.
+func (c *stmtChecker) insertTarget(i int) int {
+	i = c.tableRef(i, refInsert)
+	if !c.tok(i).is("(") {
+		return i
+	} else if next := c.tok(i + 1); next.is("select") || next.is("with") || next.is("values") {
+		return i
+	}
+
+	end := c.matchingParen(i)
+	for x := i; x <= end; x++ {
+		c.consumed[x] = true
+		if c.toks[x].isName() {
+			c.targetCols = append(c.targetCols, c.toks[x])
+		}
+	}
+	return end + 1
+}
+
+// columnRef records the identifier at toks[i] if it's a column reference.
+func (c *stmtChecker) columnRef(i int, fr *frame) {
+	t, prev, next := c.toks[i], c.tok(i-1), c.tok(i+1)
+	switch {
+	case next.is("."):
+		// A qualified reference like alias.column.
+		if col := c.tok(i + 2); col.isName() && !c.tok(i+3).is("(") && !c.tok(i+3).is(".") && !prev.is(".") {
+			c.qualified = append(c.qualified, [2]token{t, col})
+			c.consumed[i+2] = true
+		}
+		return
+	case prev.is("."), next.is("("), next.kind == tokString:
+		// Part of a longer name, a function call, or a typed literal like interval '1 day'.
+		return
+	case t.kind == tokIdent && keywords[t.val]:
+		return
+	case prev.is("::"), prev.is("as"), prev.is("collate"), prev.is("over"), prev.is("constraint"),
+		prev.is("is"), prev.is("not") && c.tok(i-2).is("is"):
+		// A type, alias, collation, window or constraint name.
+		return
+	case prev.kind == tokIdent && !keywords[prev.val], prev.kind == tokQuotedIdent,
+		prev.kind == tokString, prev.kind == tokNumber, prev.kind == tokParam,
+		prev.is(")"), prev.is("]"):
+		// Following an expression, so it's an alias or part of a type name.
+		return
+	}
+
+	if fr.clause == "set" && (prev.is("set") || prev.is(",")) && next.is("=") {
+		c.targetCols = append(c.targetCols, t)
+	} else if checkedClauses[fr.clause] {
+		c.columns = append(c.columns, t)
+	}
+}
+
+// checkColumns checks the recorded column references against the referenced tables.
+func (c *stmtChecker) checkColumns() {
+	if c.target != nil {
+		for _, col := range c.targetCols {
+			if !c.target.HasColumn(col.val) {
+				c.problems = append(c.problems, Problem{col.pos, col.end, fmt.Sprintf("table %q has no column %q", c.target.Name, col.val)})
+			}
+		}
+	}
+
+	for _, ref := range c.qualified {
+		alias, col := ref[0], ref[1]
+		tbl, ok := c.aliases[alias.val]
+		if !ok && alias.val == "excluded" {
+			tbl, ok = c.target, true
+		}
+		if ok && tbl != nil && !tbl.HasColumn(col.val) {
+			c.problems = append(c.problems, Problem{alias.pos, col.end, fmt.Sprintf("table %q has no column %q", tbl.Name, col.val)})
+		}
+	}
+
+	if c.unknownSource || len(c.tables) == 0 {
+		return
+	}
+columns:
+	for _, col := range c.columns {
+		if _, isAlias := c.aliases[col.val]; isAlias || c.ctes[col.val] {
+			// A reference to a whole row.
+			continue
+		}
+		for _, tbl := range c.tables {
+			if tbl.HasColumn(col.val) {
+				continue columns
+			}
+		}
+
+		var msg string
+		if len(c.tables) == 1 {
+			msg = fmt.Sprintf("table %q has no column %q", c.tables[0].Name, col.val)
+		} else {
+			names := make([]string, len(c.tables))
+			for i, tbl := range c.tables {
+				names[i] = strconv.Quote(tbl.Name)
+			}
+			msg = fmt.Sprintf("column %q does not exist in any of the tables %s", col.val, strings.Join(names, ", "))
+		}
+		c.problems = append(c.problems, Problem{col.pos, col.end, msg})
+	}
+}
+
+// tok returns toks[i], or a semicolon if i is out of range.
+func (c *stmtChecker) tok(i int) token {
+	if i >= 0 && i < len(c.toks) {
+		return c.toks[i]
+	}
+	return token{kind: tokPunct, val: ";"}
+}
+
+// matchingParen returns the index of the parenthesis closing the one at toks[i].
+func (c *stmtChecker) matchingParen(i int) int {
+	depth := 0
+	for j := i; j < len(c.toks); j++ {
+		if c.toks[j].is("(") {
+			depth++
+		} else if c.toks[j].is(")") {
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return len(c.toks) - 1
+}
+
+func or(a, b string) string {
+	if a != "" {
+		return a
+	}
+	return b
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// keywords are keywords that are never column references or table aliases.
+var keywords = map[string]bool{}
+
+func init() {
+	for _, kw := range strings.Fields(`
+		all and any array as asc asymmetric at between both by case cast collate conflict
+		constraint cross current current_catalog current_date current_role current_schema
+		current_time current_timestamp current_user default delete desc distinct do else end
+		escape except exclude exists false fetch filter first following for from full group
+		groups having ilike in inner insert intersect interval into is isnull join key last
+		lateral leading left like limit local localtime localtimestamp locked natural no not
+		nothing notnull nowait null nulls of offset on only or order ordinality others outer
+		over overlaps overriding partition placing preceding range recursive returning right
+		row rows select session_user set share similar skip some symmetric table tablesample
+		then ties time to trailing true truncate unbounded union unknown update user using
+		values when where window with within zone
+		century day decade dow doy epoch hour isodow isoyear julian microseconds millennium
+		milliseconds minute month quarter second timezone timezone_hour timezone_minute week year
+	`) {
+		keywords[kw] = true
+	}
+}
@@ -0,0 +1,450 @@
+package sqlcheck
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Description describes the parameters and result columns of a query.
+type Description struct {
+	// Params are the query parameters, where Params[i] is the placeholder $<i+1>.
+	Params []Param
+
+	// Columns are the columns of the rows returned by the query,
+	// or nil if the query doesn't return rows.
+	Columns []Column
+}
+
+// Param is a query parameter.
+type Param struct {
+	// Name is a name for the parameter derived from how it's used,
+	// like the name of the column it's compared to. It's empty if unknown.
+	Name string
+
+	// Type is the type of the parameter. Its name is empty if unknown.
+	Type Type
+}
+
+// Describe describes the parameters and result columns of query,
+// which must be a single statement. The types of parameters are derived
+// from the columns they're compared to or inserted into, or from explicit
+// casts like $1::text. The types of result columns are derived from the
+// columns they select, or from explicit casts.
+//
+// The query is expected to have been checked with Check.
+func Describe(s *Schema, query string) (*Description, error) {
+	toks, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+	stmts := splitStatements(toks)
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("the query must be a single statement, got %d", len(stmts))
+	}
+
+	c := &stmtChecker{
+		s:        s,
+		toks:     stmts[0],
+		consumed: make([]bool, len(stmts[0])),
+		ctes:     make(map[string]bool),
+		aliases:  make(map[string]*Table),
+	}
+	c.check()
+
+	d := &Description{}
+	d.Params = c.describeParams()
+	if d.Columns, err = c.describeColumns(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// describeParams determines the names and types of the query parameters.
+func (c *stmtChecker) describeParams() []Param {
+	var params []Param
+	known := make(map[int]bool)
+	for i, t := range c.toks {
+		if t.kind != tokParam {
+			continue
+		}
+		n, err := strconv.Atoi(t.val[1:])
+		if err != nil || n <= 0 {
+			continue
+		}
+		for len(params) < n {
+			params = append(params, Param{})
+		}
+		if known[n] {
+			continue
+		}
+
+		p := c.describeParam(i)
+		if p.Name != "" || p.Type.Name != "" {
+			params[n-1] = p
+			known[n] = p.Type.Name != ""
+		}
+	}
+	return params
+}
+
+// comparisonOps are the operators whose operands have the same type.
+var comparisonOps = map[string]bool{
+	"=": true, "<>": true, "!=": true, "<": true, ">": true, "<=": true, ">=": true,
+}
+
+// describeParam describes the parameter at toks[i].
+func (c *stmtChecker) describeParam(i int) Param {
+	prev, next := c.tok(i-1), c.tok(i+1)
+
+	var p Param
+	switch {
+	case prev.is("limit"), prev.is("offset"):
+		return Param{Name: prev.val, Type: Type{Name: "int8", NotNull: true}}
+
+	case prev.kind == tokOp && comparisonOps[prev.val], prev.is("like"), prev.is("ilike"):
+		// Compared to a column, like "col = $1".
+		if col, ok := c.columnBefore(i - 1); ok {
+			p = Param{Name: col.Name, Type: col.Type}
+			p.Type.NotNull = true
+		}
+
+	case next.kind == tokOp && comparisonOps[next.val]:
+		// Compared to a column, like "$1 = col".
+		if col, ok := c.columnAfter(i + 1); ok {
+			p = Param{Name: col.Name, Type: col.Type}
+			p.Type.NotNull = true
+		}
+
+	case prev.is("(") && c.tok(i-2).is("any") && next.is(")"):
+		// Compared to any element of an array, like "col = ANY($1)".
+		if op := c.tok(i - 3); op.kind == tokOp && comparisonOps[op.val] {
+			if col, ok := c.columnBefore(i - 3); ok && !col.Type.Array {
+				p = Param{Name: col.Name, Type: col.Type}
+				p.Type.Array, p.Type.NotNull = true, true
+			}
+		}
+
+	default:
+		// Assigned to a column, as in "SET col = $1" or "INSERT ... VALUES ($1)".
+		if col, ok := c.assignedColumn(i); ok {
+			p = Param{Name: col.Name, Type: col.Type}
+		}
+	}
+
+	// Explicit casts take precedence.
+	if next.is("::") {
+		p.Type = c.s.parseType(&cursor{toks: c.toks, i: i + 2})
+		p.Type.NotNull = p.Type.Name != ""
+	}
+	return p
+}
+
+// columnBefore resolves the column reference ending at toks[i-1].
+func (c *stmtChecker) columnBefore(i int) (*Column, bool) {
+	name := c.tok(i - 1)
+	if !name.isName() {
+		return nil, false
+	}
+	if c.tok(i - 2).is(".") {
+		return c.findColumn(c.tok(i-3).val, name.val, true)
+	}
+	return c.findColumn("", name.val, true)
+}
+
+// columnAfter resolves the column reference starting at toks[i+1].
+func (c *stmtChecker) columnAfter(i int) (*Column, bool) {
+	name := c.tok(i + 1)
+	if !name.isName() {
+		return nil, false
+	}
+	if c.tok(i + 2).is(".") {
+		if col := c.tok(i + 3); col.isName() && !c.tok(i+4).is("(") {
+			return c.findColumn(name.val, col.val, true)
+		}
+		return nil, false
+	}
+	if c.tok(i + 2).is("(") {
+		return nil, false
+	}
+	return c.findColumn("", name.val, true)
+}
+
+// assignedColumn resolves the target column of the value at toks[i],
+// for values in "SET col = $1" and "INSERT INTO tbl (col) VALUES ($1)".
+func (c *stmtChecker) assignedColumn(i int) (*Column, bool) {
+	if c.target == nil {
+		return nil, false
+	}
+
+	// SET col = $1
+	if end := c.tok(i + 1); c.tok(i-1).is("=") && (end.is(",") || end.is("where") || end.is("returning") || end.is("from") || end.is(";")) {
+		if col := c.tok(i - 2); col.isName() && (c.tok(i-3).is("set") || c.tok(i-3).is(",")) {
+			return c.targetColumn(col.val)
+		}
+	}
+
+	// INSERT INTO tbl (col) VALUES ($1)
+	prev, next := c.tok(i-1), c.tok(i+1)
+	if len(c.targetCols) == 0 || !(prev.is("(") || prev.is(",")) || !(next.is(")") || next.is(",")) {
+		return nil, false
+	}
+	// Find the position of the value in its VALUES row.
+	idx, depth := 0, 0
+	for j := i - 1; j >= 0; j-- {
+		t := c.toks[j]
+		switch {
+		case t.is(")"):
+			depth++
+		case t.is("(") && depth > 0:
+			depth--
+		case t.is("("):
+			if row := c.tok(j - 1); row.is("values") || (row.is(",") && c.tok(j-2).is(")")) {
+				if idx < len(c.targetCols) {
+					return c.targetColumn(c.targetCols[idx].val)
+				}
+			}
+			return nil, false
+		case t.is(",") && depth == 0:
+			idx++
+		}
+	}
+	return nil, false
+}
+
+func (c *stmtChecker) targetColumn(name string) (*Column, bool) {
+	col := c.target.Column(name)
+	return col, col != nil
+}
+
+// resolveColumn resolves a column reference in the result list,
+// qualified by the given alias if not empty. Only tables referenced by the
+// top-level statement are considered, and columns of tables on the nullable
+// side of outer joins are nullable.
+func (c *stmtChecker) resolveColumn(alias, name string) (*Column, bool) {
+	return c.findColumn(alias, name, false)
+}
+
+// findColumn is like resolveColumn, but if anyDepth is set also considers
+// tables referenced by subqueries when no top-level table has the column.
+func (c *stmtChecker) findColumn(alias, name string, anyDepth bool) (*Column, bool) {
+	for _, src := range c.sources {
+		if src.depth > 0 || src.table == nil || (alias != "" && src.alias != alias) {
+			continue
+		}
+		if col := src.table.Column(name); col != nil {
+			if src.nullable {
+				nc := *col
+				nc.Type.NotNull = false
+				col = &nc
+			}
+			return col, true
+		}
+	}
+	if alias == "excluded" && c.target != nil {
+		return c.targetColumn(name)
+	}
+	if anyDepth {
+		for _, src := range c.sources {
+			if src.table == nil || (alias != "" && src.alias != alias) {
+				continue
+			}
+			if col := src.table.Column(name); col != nil {
+				return col, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// describeColumns determines the result columns of the statement
+// from its top-level SELECT or RETURNING list.
+func (c *stmtChecker) describeColumns() ([]Column, error) {
+	list, ok := c.resultList()
+	if !ok {
+		return nil, nil
+	}
+
+	var cols []Column
+	for n, item := range splitCommas(list) {
+		// Expand * and alias.* into the table columns.
+		if len(item) == 1 && item[0].is("*") {
+			for _, src := range c.sources {
+				if src.depth > 0 {
+					continue
+				}
+				expanded, err := expandStar(src)
+				if err != nil {
+					return nil, err
+				}
+				cols = append(cols, expanded...)
+			}
+			continue
+		} else if len(item) == 3 && item[0].isName() && item[1].is(".") && item[2].is("*") {
+			found := false
+			for _, src := range c.sources {
+				if src.depth == 0 && src.alias == item[0].val {
+					expanded, err := expandStar(src)
+					if err != nil {
+						return nil, err
+					}
+					cols = append(cols, expanded...)
+					found = true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("unable to determine the columns of %s.*", item[0].val)
+			}
+			continue
+		}
+
+		// Determine the name of the column.
+		var col Column
+		expr := item
+		if len(item) >= 2 && item[len(item)-2].is("as") {
+			col.Name, expr = item[len(item)-1].val, item[:len(item)-2]
+		} else if last := item[len(item)-1]; len(item) >= 2 && last.isName() && !keywords[last.val] && endsExpr(item[len(item)-2]) {
+			col.Name, expr = last.val, item[:len(item)-1]
+		}
+
+		// Determine the type, looking through casts.
+		// Only casts of the whole expression, like "expr::type", are considered.
+		castAt := -1
+		for j, t := range expr {
+			if t.is("::") {
+				castAt = j
+			}
+		}
+		base := expr
+		if castAt > 0 {
+			cur := &cursor{toks: expr, i: castAt + 1}
+			if typ := c.s.parseType(cur); cur.i == len(expr) {
+				base, col.Type = expr[:castAt], typ
+			} else {
+				castAt = -1
+			}
+		}
+
+		var baseName string
+		switch {
+		case len(base) == 1 && base[0].isName():
+			baseName = base[0].val
+			if ref, ok := c.resolveColumn("", baseName); ok && castAt < 0 {
+				col.Type = ref.Type
+			}
+		case len(base) == 3 && base[0].isName() && base[1].is(".") && base[2].isName():
+			baseName = base[2].val
+			if ref, ok := c.resolveColumn(base[0].val, baseName); ok && castAt < 0 {
+				col.Type = ref.Type
+			}
+		case len(base) >= 3 && base[0].kind == tokIdent && base[1].is("(") && base[len(base)-1].is(")"):
+			baseName = base[0].val
+			if castAt < 0 {
+				col.Type = functionType(base[0].val)
+			}
+		case len(base) == 1 && base[0].kind == tokIdent && functionType(base[0].val).Name != "":
+			// SQL-standard functions called without parentheses, like CURRENT_TIMESTAMP.
+			baseName = base[0].val
+			if castAt < 0 {
+				col.Type = functionType(base[0].val)
+			}
+		}
+		if castAt > 0 && col.Type.Name != "" {
+			// Casting NULL gives NULL, so only cast columns known to be non-null stay non-null.
+			if ref, ok := c.resolveColumn("", baseName); ok && len(base) == 1 {
+				col.Type.NotNull = ref.Type.NotNull
+			}
+		}
+
+		if col.Name == "" {
+			col.Name = baseName
+		}
+		if col.Name == "" {
+			return nil, fmt.Errorf("result column %d needs a name; add one with AS", n+1)
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+// resultList returns the tokens of the top-level SELECT or RETURNING list.
+func (c *stmtChecker) resultList() ([]token, bool) {
+	depth, start := 0, -1
+	for i, t := range c.toks {
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+		case depth > 0:
+		case start < 0 && t.is("returning"):
+			return c.toks[i+1:], true
+		case start < 0 && t.is("select"):
+			start = i + 1
+			// Skip DISTINCT, DISTINCT ON (...) and ALL.
+			if c.tok(start).is("all") {
+				start++
+			} else if c.tok(start).is("distinct") {
+				start++
+				if c.tok(start).is("on") && c.tok(start+1).is("(") {
+					start = c.matchingParen(start+1) + 1
+				}
+			}
+		case start >= 0 && resultListEnd[t.val] && t.kind == tokIdent:
+			return c.toks[start:i], true
+		}
+	}
+	if start >= 0 {
+		return c.toks[start:], true
+	}
+	return nil, false
+}
+
+// resultListEnd are the keywords ending a SELECT list.
+var resultListEnd = map[string]bool{
+	"from": true, "into": true, "where": true, "group": true, "having": true, "window": true,
+	"order": true, "limit": true, "offset": true, "fetch": true, "for": true,
+	"union": true, "intersect": true, "except": true,
+}
+
+// expandStar returns the columns of a source selected with *.
+func expandStar(src source) ([]Column, error) {
+	if src.table == nil || src.table.UnknownColumns {
+		return nil, fmt.Errorf("unable to determine the columns selected by *; list the columns explicitly")
+	}
+	cols := make([]Column, len(src.table.Columns))
+	for i, col := range src.table.Columns {
+		cols[i] = *col
+		if src.nullable {
+			cols[i].Type.NotNull = false
+		}
+	}
+	return cols, nil
+}
+
+// endsExpr reports whether t can be the last token of an expression.
+func endsExpr(t token) bool {
+	switch t.kind {
+	case tokQuotedIdent, tokString, tokNumber, tokParam:
+		return true
+	case tokIdent:
+		return !keywords[t.val]
+	}
+	return t.is(")") || t.is("]")
+}
+
+// functionType returns the result type of well-known functions.
+func functionType(name string) Type {
+	switch name {
+	case "count":
+		return Type{Name: "int8", NotNull: true}
+	case "exists":
+		return Type{Name: "bool", NotNull: true}
+	case "now", "current_timestamp":
+		return Type{Name: "timestamptz", NotNull: true}
+	case "current_date":
+		return Type{Name: "date", NotNull: true}
+	case "gen_random_uuid":
+		return Type{Name: "uuid", NotNull: true}
+	}
+	return Type{}
+}
@@ -0,0 +1,278 @@
+package sqlcheck
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokIdent       tokenKind = iota // unquoted identifier or keyword, lowercased
+	tokQuotedIdent                  // "quoted identifier"
+	tokString                       // string literal, including dollar-quoted strings
+	tokNumber
+	tokParam // positional parameter like $1
+	tokPunct // one of ( ) [ ] , ; . :
+	tokOp    // operator like = or ::
+)
+
+type token struct {
+	kind tokenKind
+	val  string // the value; for identifiers the (unquoted) name
+	pos  int    // byte offset of the start of the token
+	end  int    // byte offset of the end of the token
+}
+
+// is reports whether the token is the given punctuation, operator or unquoted keyword.
+func (t token) is(val string) bool {
+	return (t.kind == tokIdent || t.kind == tokPunct || t.kind == tokOp) && t.val == val
+}
+
+// isName reports whether the token is an identifier, quoted or not.
+func (t token) isName() bool {
+	return t.kind == tokIdent || t.kind == tokQuotedIdent
+}
+
+// lexError is reported for malformed input.
+type lexError struct {
+	pos, end int
+	msg      string
+}
+
+func (e *lexError) Error() string { return e.msg }
+
+// lex splits src into tokens, skipping whitespace and comments.
+func lex(src string) ([]token, error) {
+	var toks []token
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			i++
+
+		case c == '-' && strings.HasPrefix(src[i:], "--"):
+			if idx := strings.IndexByte(src[i:], '\n'); idx >= 0 {
+				i += idx + 1
+			} else {
+				i = len(src)
+			}
+
+		case c == '/' && strings.HasPrefix(src[i:], "/*"):
+			// Block comments nest in Postgres.
+			depth := 0
+			for i < len(src) {
+				if strings.HasPrefix(src[i:], "/*") {
+					depth++
+					i += 2
+				} else if strings.HasPrefix(src[i:], "*/") {
+					depth--
+					i += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					i++
+				}
+			}
+			if depth > 0 {
+				return nil, &lexError{start, len(src), "unterminated comment"}
+			}
+
+		case c == '\'':
+			end, err := lexString(src, i, false)
+			if err != nil {
+				return nil, err
+			}
+			toks = append(toks, token{kind: tokString, val: src[start:end], pos: start, end: end})
+			i = end
+
+		case c == '"':
+			end := i + 1
+			var name strings.Builder
+			for {
+				idx := strings.IndexByte(src[end:], '"')
+				if idx < 0 {
+					return nil, &lexError{start, len(src), "unterminated quoted identifier"}
+				}
+				name.WriteString(src[end : end+idx])
+				end += idx + 1
+				if end < len(src) && src[end] == '"' {
+					name.WriteByte('"')
+					end++
+					continue
+				}
+				break
+			}
+			toks = append(toks, token{kind: tokQuotedIdent, val: name.String(), pos: start, end: end})
+			i = end
+
+		case c == '$':
+			if i+1 < len(src) && isDigit(src[i+1]) {
+				end := i + 1
+				for end < len(src) && isDigit(src[end]) {
+					end++
+				}
+				toks = append(toks, token{kind: tokParam, val: src[start:end], pos: start, end: end})
+				i = end
+				break
+			}
+
+			// Dollar-quoted string like $$...$$ or $tag$...$tag$.
+			tagEnd := i + 1
+			for tagEnd < len(src) && isIdentChar(src[tagEnd]) && src[tagEnd] != '$' {
+				tagEnd++
+			}
+			if tagEnd >= len(src) || src[tagEnd] != '$' {
+				return nil, &lexError{start, start + 1, "unexpected '$'"}
+			}
+			tag := src[i : tagEnd+1]
+			idx := strings.Index(src[tagEnd+1:], tag)
+			if idx < 0 {
+				return nil, &lexError{start, len(src), "unterminated dollar-quoted string"}
+			}
+			end := tagEnd + 1 + idx + len(tag)
+			toks = append(toks, token{kind: tokString, val: src[start:end], pos: start, end: end})
+			i = end
+
+		case isDigit(c) || (c == '.' && i+1 < len(src) && isDigit(src[i+1])):
+			end := i
+			for end < len(src) && (isDigit(src[end]) || src[end] == '.' || src[end] == '_') {
+				end++
+			}
+			if end < len(src) && (src[end] == 'e' || src[end] == 'E') {
+				end++
+				if end < len(src) && (src[end] == '+' || src[end] == '-') {
+					end++
+				}
+				for end < len(src) && isDigit(src[end]) {
+					end++
+				}
+			}
+			toks = append(toks, token{kind: tokNumber, val: src[start:end], pos: start, end: end})
+			i = end
+
+		case isIdentStart(src[i:]):
+			end := i
+			for end < len(src) {
+				if src[end] < utf8.RuneSelf {
+					if !isIdentChar(src[end]) {
+						break
+					}
+					end++
+				} else {
+					r, size := utf8.DecodeRuneInString(src[end:])
+					if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+						break
+					}
+					end += size
+				}
+			}
+
+			// Handle prefixed string constants like E'...', B'...' and X'...'.
+			if end < len(src) && src[end] == '\'' && end-start == 1 && strings.ContainsRune("eEbBxXnN", rune(c)) {
+				strEnd, err := lexString(src, end, c == 'e' || c == 'E')
+				if err != nil {
+					return nil, err
+				}
+				toks = append(toks, token{kind: tokString, val: src[start:strEnd], pos: start, end: strEnd})
+				i = strEnd
+				break
+			}
+
+			toks = append(toks, token{kind: tokIdent, val: strings.ToLower(src[start:end]), pos: start, end: end})
+			i = end
+
+		case strings.IndexByte("()[],;.:", c) >= 0:
+			if c == ':' && i+1 < len(src) && src[i+1] == ':' {
+				toks = append(toks, token{kind: tokOp, val: "::", pos: start, end: i + 2})
+				i += 2
+				break
+			}
+			toks = append(toks, token{kind: tokPunct, val: string(c), pos: start, end: i + 1})
+			i++
+
+		case isOpChar(c):
+			end := i
+			for end < len(src) && isOpChar(src[end]) {
+				// Stop before the start of a comment.
+				if strings.HasPrefix(src[end:], "--") || strings.HasPrefix(src[end:], "/*") {
+					break
+				}
+				end++
+			}
+			toks = append(toks, token{kind: tokOp, val: src[start:end], pos: start, end: end})
+			i = end
+
+		default:
+			r, size := utf8.DecodeRuneInString(src[i:])
+			return nil, &lexError{start, start + size, fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+	return toks, nil
+}
+
+// lexString lexes a single-quoted string starting at src[start],
+// returning the offset of the end of the string.
+func lexString(src string, start int, backslashEscapes bool) (int, error) {
+	i := start + 1
+	for i < len(src) {
+		switch src[i] {
+		case '\\':
+			if backslashEscapes {
+				i++
+			}
+		case '\'':
+			if i+1 < len(src) && src[i+1] == '\'' {
+				i++
+			} else {
+				return i + 1, nil
+			}
+		}
+		i++
+	}
+	return 0, &lexError{start, len(src), "unterminated string literal"}
+}
+
+// splitStatements splits toks into statements separated by semicolons.
+func splitStatements(toks []token) [][]token {
+	var stmts [][]token
+	start := 0
+	for i, t := range toks {
+		if t.is(";") {
+			if i > start {
+				stmts = append(stmts, toks[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(toks) {
+		stmts = append(stmts, toks[start:])
+	}
+	return stmts
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || isDigit(c) || (c|0x20 >= 'a' && c|0x20 <= 'z')
+}
+
+func isIdentStart(s string) bool {
+	c := s[0]
+	if c < utf8.RuneSelf {
+		return c == '_' || (c|0x20 >= 'a' && c|0x20 <= 'z')
+	}
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
+func isOpChar(c byte) bool {
+	return strings.IndexByte("+-*/<>=~!@#%^&|`?", c) >= 0
+}
@@ -0,0 +1,569 @@
+// Package sqlcheck checks SQL queries against the schema of a database,
+// as defined by replaying its migrations into an in-memory model.
+//
+// The model only tracks tables, views and their columns, and the checks are
+// deliberately conservative: anything the checker doesn't understand is
+// assumed to be valid, so that valid queries are never reported.
+package sqlcheck
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Schema is a model of the tables in a database.
+type Schema struct {
+	tables  map[string]*Table // keyed by name, qualified unless in the public schema
+	schemas map[string]bool   // schemas created by the migrations
+	enums   map[string]bool   // enum types created by the migrations
+
+	// opaque is set when the migrations change the schema in ways
+	// that can't be followed, like in functions or DO blocks.
+	// Queries against an opaque schema are only checked for placeholders.
+	opaque bool
+
+	// openTables is set when tables may exist that aren't created by
+	// the migrations, like tables created by extensions.
+	// Unknown tables are then not reported.
+	openTables bool
+}
+
+// Table is a table or view.
+type Table struct {
+	Name    string
+	Columns []*Column
+
+	// UnknownColumns is set when the columns can't be determined,
+	// like for views defined by a query.
+	UnknownColumns bool
+}
+
+// Column is a column of a table.
+type Column struct {
+	Name string
+	Type Type
+}
+
+// Type is the type of a column or expression.
+type Type struct {
+	// Name is the canonical name of the type, like "int8" or "timestamptz".
+	// It's empty if the type is unknown.
+	Name string
+
+	// Array is whether the type is an array of Name.
+	Array bool
+
+	// NotNull is whether the value can't be NULL.
+	NotNull bool
+
+	// Enum is whether Name is an enum type created by the migrations.
+	Enum bool
+}
+
+// HasColumn reports whether the table has the given column.
+// It reports true if the columns are unknown.
+func (t *Table) HasColumn(name string) bool {
+	return t.UnknownColumns || t.Column(name) != nil || isSystemColumn(name)
+}
+
+// Column returns the column with the given name, or nil if there is none.
+func (t *Table) Column(name string) *Column {
+	for _, col := range t.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// columnIndex returns the index of the column with the given name, or -1.
+func (t *Table) columnIndex(name string) int {
+	return slices.IndexFunc(t.Columns, func(col *Column) bool { return col.Name == name })
+}
+
+// NewSchema returns an empty schema.
+func NewSchema() *Schema {
+	return &Schema{
+		tables:  make(map[string]*Table),
+		schemas: map[string]bool{"public": true},
+		enums:   make(map[string]bool),
+	}
+}
+
+// Table returns the table with the given name, which
+// is qualified with its schema unless it's in the public schema.
+func (s *Schema) Table(name string) (*Table, bool) {
+	t, ok := s.tables[name]
+	return t, ok
+}
+
+// Apply updates the schema with the effects of the statements in sql,
+// which is typically the contents of a migration file.
+func (s *Schema) Apply(sql string) error {
+	toks, err := lex(sql)
+	if err != nil {
+		return err
+	}
+	for _, t := range toks {
+		if t.kind == tokString && strings.HasPrefix(t.val, "$") && dynamicDDL.MatchString(t.val) {
+			s.opaque = true
+		}
+	}
+	for _, stmt := range splitStatements(toks) {
+		s.applyStmt(&cursor{toks: stmt})
+	}
+	return nil
+}
+
+// dynamicDDL matches schema changes in function bodies and DO blocks.
+var dynamicDDL = regexp.MustCompile(`(?i)\b(create|alter|drop)\s+(\w+\s+){0,3}(table|view)\b`)
+
+func (s *Schema) applyStmt(c *cursor) {
+	switch {
+	case c.keywords("create"):
+		c.keywords("or", "replace")
+		c.oneOf("global", "local")
+		c.oneOf("temp", "temporary", "unlogged")
+		switch {
+		case c.keywords("table"):
+			s.createTable(c)
+		case c.keywords("view"), c.keywords("recursive", "view"), c.keywords("materialized", "view"):
+			s.createView(c)
+		case c.keywords("schema"):
+			c.keywords("if", "not", "exists")
+			if t := c.next(); t.isName() {
+				s.schemas[t.val] = true
+			}
+		case c.keywords("extension"):
+			s.openTables = true
+		case c.keywords("type"):
+			if name, ok := c.name(); ok && c.keywords("as", "enum") {
+				s.enums[name] = true
+			}
+		}
+
+	case c.keywords("alter"):
+		switch {
+		case c.keywords("table"), c.keywords("view"), c.keywords("materialized", "view"):
+			s.alterTable(c)
+		}
+
+	case c.keywords("drop"):
+		switch {
+		case c.keywords("table"), c.keywords("view"), c.keywords("materialized", "view"):
+			c.keywords("if", "exists")
+			for {
+				if name, ok := c.name(); ok {
+					delete(s.tables, name)
+				}
+				if !c.keywords(",") {
+					break
+				}
+			}
+		}
+
+	case c.keywords("set", "search_path"), c.keywords("set", "session", "search_path"):
+		s.opaque = true
+	}
+}
+
+func (s *Schema) createTable(c *cursor) {
+	c.keywords("if", "not", "exists")
+	name, ok := c.name()
+	if !ok {
+		return
+	}
+	t := &Table{Name: name}
+	s.tables[name] = t
+	if !c.peek().is("(") {
+		// CREATE TABLE ... AS, PARTITION OF, OF type.
+		t.UnknownColumns = true
+		return
+	}
+
+	var primaryKey []token
+	for _, item := range c.parenList() {
+		first := item[0]
+		switch {
+		case first.is("primary"):
+			primaryKey = item
+		case first.is("constraint"):
+			if ic := (&cursor{toks: item, i: 2}); ic.peek().is("primary") {
+				primaryKey = item[2:]
+			}
+		case first.is("unique"), first.is("check"), first.is("foreign"), first.is("exclude"):
+			// Table constraint.
+		case first.is("like"):
+			t.UnknownColumns = true
+		case first.isName():
+			t.Columns = append(t.Columns, s.columnDef(item))
+		}
+	}
+
+	// Primary key columns can't be NULL.
+	for _, tok := range primaryKey {
+		if col := t.Column(tok.val); col != nil && tok.isName() {
+			col.Type.NotNull = true
+		}
+	}
+	if c.keywords("inherits") {
+		t.UnknownColumns = true
+	}
+}
+
+func (s *Schema) createView(c *cursor) {
+	c.keywords("if", "not", "exists")
+	name, ok := c.name()
+	if !ok {
+		return
+	}
+	t := &Table{Name: name, UnknownColumns: true}
+	if c.peek().is("(") {
+		t.UnknownColumns = false
+		for _, item := range c.parenList() {
+			if item[0].isName() {
+				t.Columns = append(t.Columns, &Column{Name: item[0].val})
+			}
+		}
+	}
+	s.tables[name] = t
+}
+
+func (s *Schema) alterTable(c *cursor) {
+	c.keywords("if", "exists")
+	c.keywords("only")
+	name, ok := c.name()
+	if !ok {
+		return
+	}
+	c.keywords("*")
+	t, ok := s.tables[name]
+	if !ok {
+		// The table isn't created by the migrations.
+		return
+	}
+
+	switch {
+	case c.keywords("rename", "to"):
+		if newName := c.next(); newName.isName() {
+			delete(s.tables, name)
+			// Renaming keeps the table in its schema.
+			if schema, _, ok := strings.Cut(name, "."); ok {
+				t.Name = schema + "." + newName.val
+			} else {
+				t.Name = newName.val
+			}
+			s.tables[t.Name] = t
+		}
+		return
+	case c.keywords("set", "schema"):
+		if schema := c.next(); schema.isName() {
+			delete(s.tables, name)
+			t.Name = qualify(schema.val, unqualified(name))
+			s.tables[t.Name] = t
+		}
+		return
+	case c.keywords("rename", "constraint"):
+		return
+	case c.keywords("rename"):
+		c.keywords("column")
+		from, to := c.next(), c.next()
+		if from.isName() && to.is("to") {
+			if newName := c.next(); newName.isName() {
+				if col := t.Column(from.val); col != nil {
+					col.Name = newName.val
+				}
+			}
+		}
+		return
+	}
+
+	for _, action := range c.commaList() {
+		ac := &cursor{toks: action}
+		switch {
+		case ac.keywords("add"):
+			if ac.oneOf("constraint", "primary", "unique", "check", "foreign", "exclude") {
+				continue
+			}
+			ac.keywords("column")
+			ac.keywords("if", "not", "exists")
+			if col := ac.peek(); col.isName() && t.Column(col.val) == nil {
+				t.Columns = append(t.Columns, s.columnDef(action[ac.i:]))
+			}
+		case ac.keywords("drop"):
+			if ac.keywords("constraint") {
+				continue
+			}
+			ac.keywords("column")
+			ac.keywords("if", "exists")
+			if col := ac.next(); col.isName() {
+				if idx := t.columnIndex(col.val); idx >= 0 {
+					t.Columns = slices.Delete(t.Columns, idx, idx+1)
+				}
+			}
+		case ac.keywords("alter"):
+			ac.keywords("column")
+			col := t.Column(ac.next().val)
+			if col == nil {
+				continue
+			}
+			switch {
+			case ac.keywords("set", "not", "null"):
+				col.Type.NotNull = true
+			case ac.keywords("drop", "not", "null"):
+				col.Type.NotNull = false
+			case ac.keywords("set", "data", "type"), ac.keywords("type"):
+				typ := s.parseType(&cursor{toks: action, i: ac.i})
+				typ.NotNull = col.Type.NotNull
+				col.Type = typ
+			}
+		}
+	}
+}
+
+// columnDef parses a column definition like "id BIGINT NOT NULL".
+func (s *Schema) columnDef(def []token) *Column {
+	c := &cursor{toks: def}
+	col := &Column{Name: c.next().val}
+	col.Type = s.parseType(c)
+
+	// Look for constraints making the column non-null.
+	depth := 0
+	for c.i < len(c.toks) {
+		t := c.next()
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+		case depth > 0:
+		case t.is("primary"), t.is("not") && c.peek().is("null"):
+			col.Type.NotNull = true
+		case t.is("generated") && c.keywords("always", "as", "identity"),
+			t.is("generated") && c.keywords("by", "default", "as", "identity"):
+			col.Type.NotNull = true
+		}
+	}
+	return col
+}
+
+// parseType parses a type name like "INT", "VARCHAR(255)", "TIMESTAMP WITH TIME ZONE" or "TEXT[]".
+// Unknown types are reported with an empty name.
+func (s *Schema) parseType(c *cursor) Type {
+	var words []string
+	var typ Type
+loop:
+	for c.i < len(c.toks) {
+		t := c.peek()
+		switch {
+		case t.is("("):
+			// Type modifiers like the length of varchar(255).
+			c.parenList()
+			continue
+		case t.is("["):
+			for c.i < len(c.toks) && !c.next().is("]") {
+			}
+			typ.Array = true
+			continue
+		case t.is("array"):
+			c.next()
+			typ.Array = true
+			continue
+		case t.is("."):
+			// A schema-qualified type.
+			c.next()
+			words = nil
+			continue
+		case t.isName() && !typeTerminators[t.val]:
+			c.next()
+			words = append(words, t.val)
+			continue
+		}
+		break loop
+	}
+
+	name := strings.Join(words, " ")
+	if canonical, ok := typeAliases[name]; ok {
+		typ.Name = canonical
+	} else if s.enums[name] {
+		typ.Name, typ.Enum = name, true
+	}
+	return typ
+}
+
+// typeTerminators are keywords that end a type name in a column definition.
+var typeTerminators = map[string]bool{
+	"not": true, "null": true, "primary": true, "unique": true, "references": true,
+	"default": true, "check": true, "constraint": true, "generated": true, "collate": true,
+	"using": true,
+}
+
+// typeAliases maps type names to their canonical names.
+var typeAliases = map[string]string{}
+
+func init() {
+	for canonical, aliases := range map[string][]string{
+		"int2":        {"int2", "smallint", "smallserial", "serial2"},
+		"int4":        {"int4", "int", "integer", "serial", "serial4"},
+		"int8":        {"int8", "bigint", "bigserial", "serial8"},
+		"float4":      {"float4", "real"},
+		"float8":      {"float8", "float", "double precision"},
+		"numeric":     {"numeric", "decimal"},
+		"bool":        {"bool", "boolean"},
+		"text":        {"text", "citext", "name"},
+		"varchar":     {"varchar", "character varying"},
+		"bpchar":      {"bpchar", "char", "character"},
+		"bytea":       {"bytea"},
+		"date":        {"date"},
+		"time":        {"time", "time without time zone"},
+		"timetz":      {"timetz", "time with time zone"},
+		"timestamp":   {"timestamp", "timestamp without time zone"},
+		"timestamptz": {"timestamptz", "timestamp with time zone"},
+		"interval":    {"interval"},
+		"uuid":        {"uuid"},
+		"json":        {"json"},
+		"jsonb":       {"jsonb"},
+	} {
+		for _, alias := range aliases {
+			typeAliases[alias] = canonical
+		}
+	}
+}
+
+// qualify returns the name of a table in the given schema,
+// which is unqualified for the public schema.
+func qualify(schema, name string) string {
+	if schema == "public" {
+		return name
+	}
+	return schema + "." + name
+}
+
+func unqualified(name string) string {
+	if idx := strings.LastIndexByte(name, '.'); idx >= 0 {
+		return name[idx+1:]
+	}
+	return name
+}
+
+// isSystemColumn reports whether name is one of the system columns all tables have.
+func isSystemColumn(name string) bool {
+	switch name {
+	case "ctid", "xmin", "xmax", "cmin", "cmax", "tableoid":
+		return true
+	}
+	return false
+}
+
+// cursor is a position in a list of tokens.
+type cursor struct {
+	toks []token
+	i    int
+}
+
+func (c *cursor) peek() token {
+	if c.i < len(c.toks) {
+		return c.toks[c.i]
+	}
+	return token{kind: tokPunct, val: ";"}
+}
+
+func (c *cursor) next() token {
+	t := c.peek()
+	if c.i < len(c.toks) {
+		c.i++
+	}
+	return t
+}
+
+// keywords consumes the given sequence of keywords if it's next.
+func (c *cursor) keywords(words ...string) bool {
+	if c.i+len(words) > len(c.toks) {
+		return false
+	}
+	for j, w := range words {
+		if !c.toks[c.i+j].is(w) {
+			return false
+		}
+	}
+	c.i += len(words)
+	return true
+}
+
+// oneOf consumes any one of the given keywords if it's next.
+func (c *cursor) oneOf(words ...string) bool {
+	for _, w := range words {
+		if c.keywords(w) {
+			return true
+		}
+	}
+	return false
+}
+
+// name consumes a possibly schema-qualified name.
+func (c *cursor) name() (string, bool) {
+	first := c.peek()
+	if !first.isName() {
+		return "", false
+	}
+	c.i++
+	if c.peek().is(".") && c.i+1 < len(c.toks) && c.toks[c.i+1].isName() {
+		second := c.toks[c.i+1]
+		c.i += 2
+		return qualify(first.val, second.val), true
+	}
+	return first.val, true
+}
+
+// parenList consumes a parenthesized list, returning its comma-separated items.
+func (c *cursor) parenList() [][]token {
+	if !c.peek().is("(") {
+		return nil
+	}
+	start := c.i + 1
+	depth := 0
+	for c.i < len(c.toks) {
+		t := c.next()
+		if t.is("(") || t.is("[") {
+			depth++
+		} else if t.is(")") || t.is("]") {
+			depth--
+			if depth == 0 {
+				return splitCommas(c.toks[start : c.i-1])
+			}
+		}
+	}
+	return splitCommas(c.toks[start:])
+}
+
+// commaList consumes the rest of the tokens, returning its comma-separated items.
+func (c *cursor) commaList() [][]token {
+	items := splitCommas(c.toks[c.i:])
+	c.i = len(c.toks)
+	return items
+}
+
+// splitCommas splits toks on top-level commas, omitting empty items.
+func splitCommas(toks []token) [][]token {
+	var items [][]token
+	depth, start := 0, 0
+	for i, t := range toks {
+		switch {
+		case t.is("(") || t.is("["):
+			depth++
+		case t.is(")") || t.is("]"):
+			depth--
+		case t.is(",") && depth == 0:
+			if i > start {
+				items = append(items, toks[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(toks) {
+		items = append(items, toks[start:])
+	}
+	return items
+}
@@ -0,0 +1,330 @@
+package sqlcheck
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+const testMigrations = `
+CREATE TABLE users (
+	id BIGSERIAL PRIMARY KEY,
+	email TEXT NOT NULL UNIQUE,
+	"displayName" TEXT,
+	CONSTRAINT email_lower CHECK (email = lower(email))
+);
+
+CREATE TABLE todo_item (
+	id BIGSERIAL PRIMARY KEY,
+	user_id BIGINT NOT NULL REFERENCES users (id),
+	title TEXT NOT NULL,
+	done BOOLEAN NOT NULL DEFAULT false -- the status
+);
+
+/* Obsolete tables. */
+CREATE TABLE legacy (id INT);
+DROP TABLE legacy;
+
+ALTER TABLE todo_item ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now(), ADD CONSTRAINT title_len CHECK (length(title) > 0);
+ALTER TABLE todo_item RENAME COLUMN done TO completed;
+ALTER TABLE users DROP COLUMN IF EXISTS "displayName";
+
+CREATE SCHEMA audit;
+CREATE TABLE audit.log (id INT, message TEXT);
+CREATE VIEW open_items AS SELECT * FROM todo_item WHERE NOT completed;
+
+CREATE FUNCTION set_created() RETURNS trigger AS $$
+BEGIN
+	NEW.created_at = now();
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+`
+
+func testSchema(c *qt.C) *Schema {
+	s := NewSchema()
+	c.Assert(s.Apply(testMigrations), qt.IsNil)
+	return s
+}
+
+func TestSchema(t *testing.T) {
+	c := qt.New(t)
+	s := testSchema(c)
+
+	users, ok := s.Table("users")
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(columnNames(users), qt.DeepEquals, []string{"id", "email"})
+
+	todo, ok := s.Table("todo_item")
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(columnNames(todo), qt.DeepEquals, []string{"id", "user_id", "title", "completed", "created_at"})
+
+	log, ok := s.Table("audit.log")
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(columnNames(log), qt.DeepEquals, []string{"id", "message"})
+
+	view, ok := s.Table("open_items")
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(view.UnknownColumns, qt.IsTrue)
+
+	_, ok = s.Table("legacy")
+	c.Assert(ok, qt.IsFalse)
+	c.Assert(s.opaque, qt.IsFalse)
+}
+
+func TestSchema_Opaque(t *testing.T) {
+	c := qt.New(t)
+	s := NewSchema()
+	c.Assert(s.Apply(`DO $$ BEGIN EXECUTE 'CREATE TABLE foo (id INT)'; END $$;`), qt.IsNil)
+	c.Assert(s.opaque, qt.IsTrue)
+
+	// Queries against opaque schemas are only checked for placeholders.
+	c.Assert(Check(s, "SELECT bar FROM foo WHERE id = $2", 2), qt.HasLen, 1)
+}
+
+func TestSchema_Invalid(t *testing.T) {
+	c := qt.New(t)
+	s := NewSchema()
+	c.Assert(s.Apply(`CREATE TABLE foo (name TEXT DEFAULT 'unterminated);`), qt.ErrorMatches, "unterminated string literal")
+}
+
+func TestCheck(t *testing.T) {
+	c := qt.New(t)
+	s := testSchema(c)
+
+	tests := []struct {
+		query   string
+		numArgs int
+		want    []string // the problem messages
+	}{
+		{query: "SELECT id, email FROM users WHERE id = $1", numArgs: 1},
+		{query: "SELECT u.id, u.email, t.title FROM users u JOIN todo_item AS t ON t.user_id = u.id", numArgs: 0},
+		{query: "SELECT count(*) AS n FROM todo_item WHERE completed AND created_at > now() - interval '1 day' ORDER BY n", numArgs: 0},
+		{query: "SELECT id FROM todo_item WHERE title ILIKE '%' || $1 || '%' FOR UPDATE SKIP LOCKED", numArgs: 1},
+		{query: "SELECT extract(epoch FROM created_at)::int FROM todo_item", numArgs: 0},
+		{query: "SELECT * FROM open_items WHERE anything = 1", numArgs: 0},
+		{query: "SELECT message FROM audit.log", numArgs: 0},
+		{query: "SELECT * FROM information_schema.tables", numArgs: 0},
+		{query: "SELECT * FROM pg_stat_activity", numArgs: 0},
+		{query: "SELECT x FROM generate_series(1, 10) AS g(x)", numArgs: 0},
+		{query: "WITH recent AS (SELECT id, title FROM todo_item) SELECT title FROM recent", numArgs: 0},
+		{query: "SELECT id FROM users WHERE id IN (SELECT user_id FROM todo_item WHERE NOT completed)", numArgs: 0},
+		{query: "SELECT id, ctid FROM users WHERE email IS NOT DISTINCT FROM $1", numArgs: 1},
+		{query: "SELECT id FROM users WHERE id = ANY($1::bigint[])", numArgs: 1},
+		{query: "SELECT id FROM users; SELECT id FROM todo_item", numArgs: 0},
+		{query: "INSERT INTO todo_item (user_id, title) VALUES ($1, $2) RETURNING id", numArgs: 2},
+		{query: "INSERT INTO users (id, email) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET email = EXCLUDED.email", numArgs: 2},
+		{query: "UPDATE todo_item SET completed = NOT completed, title = $2 WHERE id = $1", numArgs: 2},
+		{query: "UPDATE todo_item t SET title = u.email FROM users u WHERE u.id = t.user_id", numArgs: 0},
+		{query: "DELETE FROM todo_item USING users WHERE users.id = todo_item.user_id AND users.email = $1", numArgs: 1},
+		{query: "CREATE TEMP TABLE scratch (foo INT)", numArgs: 0},
+		{query: "SELECT id FROM users", numArgs: -1},
+		{query: "SELECT id FROM users WHERE id = $1", numArgs: -1},
+
+		{
+			query:   "SELECT id FROM todo_items",
+			numArgs: 0,
+			want:    []string{`table "todo_items" does not exist`},
+		},
+		{
+			query:   "SELECT id, titel FROM todo_item WHERE done = $1",
+			numArgs: 1,
+			want:    []string{`table "todo_item" has no column "titel"`, `table "todo_item" has no column "done"`},
+		},
+		{
+			query:   "SELECT u.name FROM users u JOIN todo_item t ON t.owner = u.id",
+			numArgs: 0,
+			want:    []string{`table "users" has no column "name"`, `table "todo_item" has no column "owner"`},
+		},
+		{
+			query:   `SELECT "displayName", Email FROM users`,
+			numArgs: 0,
+			want:    []string{`table "users" has no column "displayName"`},
+		},
+		{
+			query:   "SELECT title FROM todo_item JOIN users ON users.id = todo_item.user_id WHERE name = $1",
+			numArgs: 1,
+			want:    []string{`column "name" does not exist in any of the tables "todo_item", "users"`},
+		},
+		{
+			query:   "INSERT INTO todo_item (user_id, titel) VALUES ($1, $2)",
+			numArgs: 2,
+			want:    []string{`table "todo_item" has no column "titel"`},
+		},
+		{
+			query:   "UPDATE todo_item SET done = true WHERE id = $1",
+			numArgs: 1,
+			want:    []string{`table "todo_item" has no column "done"`},
+		},
+		{
+			query:   "SELECT message FROM audit.logs",
+			numArgs: 0,
+			want:    []string{`table "audit.logs" does not exist`},
+		},
+		{
+			query:   "SELECT id FROM users WHERE id = $1 AND email = $2",
+			numArgs: 1,
+			want:    []string{`placeholder $2 has no argument (got 1 argument)`},
+		},
+		{
+			query:   "SELECT id FROM users WHERE id = $1",
+			numArgs: 2,
+			want:    []string{`the query expects 1 argument but is given 2`},
+		},
+		{
+			query:   "SELECT id FROM users WHERE id = $1 OR id = $3",
+			numArgs: 3,
+			want:    []string{`placeholder $2 is missing (the query uses $3)`},
+		},
+		{
+			query:   "SELECT 'unterminated FROM users",
+			numArgs: 0,
+			want:    []string{`unterminated string literal`},
+		},
+	}
+
+	for _, test := range tests {
+		c.Run(test.query, func(c *qt.C) {
+			var got []string
+			for _, p := range Check(s, test.query, test.numArgs) {
+				got = append(got, p.Message)
+			}
+			c.Assert(got, qt.DeepEquals, test.want)
+		})
+	}
+}
+
+func TestCheck_Positions(t *testing.T) {
+	c := qt.New(t)
+	s := testSchema(c)
+
+	query := "SELECT t.titel FROM todo_items t"
+	problems := Check(s, query, 0)
+	c.Assert(problems, qt.HasLen, 1)
+	c.Assert(query[problems[0].Pos:problems[0].End], qt.Equals, "todo_items")
+
+	query = "SELECT t.titel FROM todo_item t"
+	problems = Check(s, query, 0)
+	c.Assert(problems, qt.HasLen, 1)
+	c.Assert(query[problems[0].Pos:problems[0].End], qt.Equals, "t.titel")
+}
+
+func columnNames(t *Table) []string {
+	var names []string
+	for _, col := range t.Columns {
+		names = append(names, col.Name)
+	}
+	return names
+}
+
+func TestSchema_Types(t *testing.T) {
+	c := qt.New(t)
+	s := NewSchema()
+	c.Assert(s.Apply(`
+CREATE TYPE mood AS ENUM ('happy', 'sad');
+CREATE TABLE person (
+	id INTEGER GENERATED ALWAYS AS IDENTITY,
+	name VARCHAR(100) NOT NULL,
+	tags TEXT[],
+	scores double precision ARRAY,
+	born timestamp with time zone,
+	feeling mood,
+	PRIMARY KEY (id)
+);
+ALTER TABLE person ALTER COLUMN tags SET NOT NULL, ALTER COLUMN name TYPE text;
+`), qt.IsNil)
+
+	person, ok := s.Table("person")
+	c.Assert(ok, qt.IsTrue)
+	var got []Type
+	for _, col := range person.Columns {
+		got = append(got, col.Type)
+	}
+	c.Assert(got, qt.DeepEquals, []Type{
+		{Name: "int4", NotNull: true},
+		{Name: "text", NotNull: true},
+		{Name: "text", Array: true, NotNull: true},
+		{Name: "float8", Array: true},
+		{Name: "timestamptz"},
+		{Name: "mood", Enum: true},
+	})
+}
+
+func TestDescribe(t *testing.T) {
+	c := qt.New(t)
+	s := testSchema(c)
+
+	text := Type{Name: "text", NotNull: true}
+	int8 := Type{Name: "int8", NotNull: true}
+	tests := []struct {
+		query   string
+		params  []Param
+		columns []Column
+		err     string
+	}{
+		{
+			query:   "SELECT id, email FROM users WHERE id = $1",
+			params:  []Param{{Name: "id", Type: int8}},
+			columns: []Column{{Name: "id", Type: int8}, {Name: "email", Type: text}},
+		},
+		{
+			query:   "SELECT * FROM users WHERE email LIKE $1 LIMIT $2",
+			params:  []Param{{Name: "email", Type: text}, {Name: "limit", Type: int8}},
+			columns: []Column{{Name: "id", Type: int8}, {Name: "email", Type: text}},
+		},
+		{
+			query:   "SELECT u.email, t.title AS todo FROM users u LEFT JOIN todo_item t ON t.user_id = u.id WHERE u.id = ANY($1)",
+			params:  []Param{{Name: "id", Type: Type{Name: "int8", Array: true, NotNull: true}}},
+			columns: []Column{{Name: "email", Type: text}, {Name: "todo", Type: Type{Name: "text"}}},
+		},
+		{
+			query:   "SELECT count(*) AS n, $1::text = 'x' AS matches FROM todo_item",
+			params:  []Param{{Type: text}},
+			columns: []Column{{Name: "n", Type: int8}, {Name: "matches"}},
+		},
+		{
+			query:   "INSERT INTO todo_item (user_id, title) VALUES ($1, $2) RETURNING id, created_at",
+			params:  []Param{{Name: "user_id", Type: int8}, {Name: "title", Type: text}},
+			columns: []Column{{Name: "id", Type: int8}, {Name: "created_at", Type: Type{Name: "timestamptz", NotNull: true}}},
+		},
+		{
+			query:  "UPDATE todo_item SET completed = $2 WHERE id = $1",
+			params: []Param{{Name: "id", Type: int8}, {Name: "completed", Type: Type{Name: "bool", NotNull: true}}},
+		},
+		{
+			query:   "SELECT * FROM users WHERE id IN (SELECT user_id FROM todo_item WHERE title = $1)",
+			params:  []Param{{Name: "title", Type: text}},
+			columns: []Column{{Name: "id", Type: int8}, {Name: "email", Type: text}},
+		},
+		{
+			query:   "SELECT u.id, t.id AS todo_id FROM users u RIGHT JOIN todo_item t ON t.user_id = u.id",
+			params:  nil,
+			columns: []Column{{Name: "id", Type: Type{Name: "int8"}}, {Name: "todo_id", Type: int8}},
+		},
+		{
+			query: "SELECT lower(email) || 'x' FROM users",
+			err:   "result column 1 needs a name; add one with AS",
+		},
+		{
+			query: "SELECT * FROM open_items",
+			err:   "unable to determine the columns selected by \\*; list the columns explicitly",
+		},
+		{
+			query: "SELECT 1; SELECT 2",
+			err:   "the query must be a single statement, got 2",
+		},
+	}
+
+	for _, test := range tests {
+		c.Run(test.query, func(c *qt.C) {
+			d, err := Describe(s, test.query)
+			if test.err != "" {
+				c.Assert(err, qt.ErrorMatches, test.err)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Assert(d.Params, qt.DeepEquals, test.params)
+			c.Assert(d.Columns, qt.DeepEquals, test.columns)
+		})
+	}
+}
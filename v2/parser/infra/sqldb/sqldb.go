@@ -22,6 +22,14 @@ type Database struct {
 	Name         string // The database name
 	MigrationDir paths.FS
 	Migrations   []MigrationFile
+
+	// Engine is the database engine, set by an "-- encore:engine"
+	// directive in the first migration. It defaults to Postgres.
+	Engine Engine
+
+	// Queries are the annotated queries in the queries directory,
+	// for which typed Go functions are generated.
+	Queries []*Query
 }
 
 func (d *Database) Kind() resource.Kind       { return resource.SQLDatabase }
@@ -39,7 +47,7 @@ type MigrationFile struct {
 var DatabaseParser = &resourceparser.Parser{
 	Name: "SQL Database",
 
-	InterestingSubdirs: []string{"migrations"},
+	InterestingSubdirs: []string{"migrations", "queries"},
 	Run: func(p *resourceparser.Pass) {
 		migrationDir := p.Pkg.FSPath.Join("migrations")
 		migrations, err := parseMigrations(p.Pkg, migrationDir)
@@ -69,6 +77,8 @@ var DatabaseParser = &resourceparser.Parser{
 			MigrationDir: migrationDir,
 			Migrations:   migrations,
 		}
+		res.Engine = parseEngine(p.Errs, res)
+		res.Queries = parseQueries(p.Errs, res, p.Pkg.FSPath.Join("queries"))
 		p.RegisterResource(res)
 		p.AddImplicitBind(res)
 	},
@@ -29,6 +29,7 @@ const (
 	AuthHandler
 	Middleware
 	ServiceStruct
+	ErrDetails
 )
 
 type Resource interface {
@@ -22,11 +22,12 @@ func _() {
 	_ = x[AuthHandler-11]
 	_ = x[Middleware-12]
 	_ = x[ServiceStruct-13]
+	_ = x[ErrDetails-14]
 }
 
-const _Kind_name = "UnknownPubSubTopicPubSubSubscriptionSQLDatabaseMetricCronJobCacheClusterCacheKeyspaceConfigLoadSecretsAPIEndpointAuthHandlerMiddlewareServiceStruct"
+const _Kind_name = "UnknownPubSubTopicPubSubSubscriptionSQLDatabaseMetricCronJobCacheClusterCacheKeyspaceConfigLoadSecretsAPIEndpointAuthHandlerMiddlewareServiceStructErrDetails"
 
-var _Kind_index = [...]uint8{0, 7, 18, 36, 47, 53, 60, 72, 85, 95, 102, 113, 124, 134, 147}
+var _Kind_index = [...]uint8{0, 7, 18, 36, 47, 53, 60, 72, 85, 95, 102, 113, 124, 134, 147, 157}
 
 func (i Kind) String() string {
 	if i < 0 || i >= Kind(len(_Kind_index)-1) {
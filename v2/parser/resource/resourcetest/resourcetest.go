@@ -31,6 +31,9 @@ func Run[R resource.Resource](t *testing.T, parser *resourceparser.Parser, tests
 	testArchive := func(test Case[R]) *txtar.Archive {
 		importList := []string{"context"}
 		for _, imp := range parser.InterestingImports {
+			if imp == resourceparser.RunAlways[0] {
+				continue
+			}
 			importList = append(importList, imp.String())
 		}
 		importList = append(importList, test.Imports...)
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"go/token"
 	"io/fs"
 	"os"
+	pathpkg "path"
 	"path/filepath"
 	"strings"
 	"time"
@@ -20,6 +22,7 @@ import (
 	"encr.dev/internal/version"
 	"encr.dev/pkg/builder"
 	"encr.dev/pkg/cueutil"
+	"encr.dev/pkg/errors"
 	"encr.dev/pkg/option"
 	"encr.dev/pkg/paths"
 	"encr.dev/pkg/promise"
@@ -31,12 +34,15 @@ import (
 	"encr.dev/v2/codegen/apigen/userfacinggen"
 	"encr.dev/v2/codegen/cuegen"
 	"encr.dev/v2/codegen/infragen"
+	"encr.dev/v2/codegen/infragen/sqldbgen"
 	"encr.dev/v2/compiler/build"
+	"encr.dev/v2/internals/configfile"
 	"encr.dev/v2/internals/parsectx"
 	"encr.dev/v2/internals/perr"
 	"encr.dev/v2/internals/pkginfo"
 	"encr.dev/v2/parser"
-	"encr.dev/v2/parser/resource"
+	"encr.dev/v2/parser/infra/config"
+	"encr.dev/v2/parser/infra/sqldb"
 )
 
 type BuilderImpl struct{}
@@ -92,6 +98,15 @@ func (BuilderImpl) Parse(ctx context.Context, p builder.ParseParams) (*builder.P
 			return nil, pc.Errs.AsError()
 		}
 
+		// Only link the (cgo-based) SQLite driver into apps that use it,
+		// and refuse to build those without cgo instead of failing to compile the driver.
+		if !usesSQLite(appDesc) {
+			pc.Build.BuildTags = append(slices.Clone(pc.Build.BuildTags), "encore_no_sqlite")
+		} else if !pc.Build.CgoEnabled {
+			pc.Errs.AddStd(fmt.Errorf("the app has SQLite databases, whose driver requires cgo: build with cgo enabled, such as with CGO_ENABLED=1"))
+			return nil, pc.Errs.AsError()
+		}
+
 		return &builder.ParseResult{
 			Meta: meta,
 			Data: &parseData{
@@ -105,6 +120,16 @@ func (BuilderImpl) Parse(ctx context.Context, p builder.ParseParams) (*builder.P
 	})
 }
 
+// usesSQLite reports whether any of the app's databases use the SQLite engine.
+func usesSQLite(desc *app.Desc) bool {
+	for _, db := range parser.Resources[*sqldb.Database](desc.Parse) {
+		if db.Engine == sqldb.SQLite {
+			return true
+		}
+	}
+	return false
+}
+
 type parseData struct {
 	pc            *parsectx.Context
 	appDesc       *app.Desc
@@ -274,25 +299,26 @@ type configResult struct {
 }
 
 func computeConfigs(errs *perr.List, desc *app.Desc, mainModule *pkginfo.Module, cueMeta *cueutil.Meta) configResult {
-	files := pickupConfigFiles(errs, mainModule)
+	files := pickupConfigFiles(errs, desc, mainModule)
 
 	// TODO this is technically different from the "app root"
 	// but it's close enough for now.
 	appRoot := mainModule.RootDir.ToIO()
 
 	// TODO this is a hack until we have proper resource usage tracking
-	serviceUsesConfig := make(map[string]bool, len(desc.Services))
+	serviceLoads := make(map[string][]*config.Load, len(desc.Services))
 	for _, r := range desc.Parse.Resources() {
-		if r.Kind() == resource.ConfigLoad {
+		if load, ok := r.(*config.Load); ok {
 			if svc, ok := desc.ServiceForPath(r.Package().FSPath); ok {
-				serviceUsesConfig[svc.Name] = true
+				serviceLoads[svc.Name] = append(serviceLoads[svc.Name], load)
 			}
 		}
 	}
 
 	configs := make(map[string]string, len(desc.Services))
 	for _, svc := range desc.Services {
-		if !serviceUsesConfig[svc.Name] {
+		loads := serviceLoads[svc.Name]
+		if len(loads) == 0 {
 			continue
 		}
 
@@ -301,6 +327,19 @@ func computeConfigs(errs *perr.List, desc *app.Desc, mainModule *pkginfo.Module,
 			errs.AddStd(err)
 			continue
 		}
+
+		// Services with YAML or JSON config files use those instead of CUE.
+		set, err := configfile.Find(files, filepath.ToSlash(rel))
+		if err != nil {
+			errs.Add(config.ErrInvalidConfigFile(svc.Name, err).AtGoNode(loads[0]))
+			continue
+		} else if set != nil {
+			if cfgData, ok := computeFileConfig(errs, set, loads, cueMeta); ok {
+				configs[svc.Name] = string(cfgData)
+			}
+			continue
+		}
+
 		cfg, err := cueutil.LoadFromFS(files, rel, cueMeta)
 		if err != nil {
 			errs.AddStd(err)
@@ -317,7 +356,79 @@ func computeConfigs(errs *perr.List, desc *app.Desc, mainModule *pkginfo.Module,
 	return configResult{configs, files}
 }
 
-func pickupConfigFiles(errs *perr.List, mainModule *pkginfo.Module) fs.FS {
+// computeFileConfig computes the config for the environment described by cueMeta
+// from a service's config files. To catch errors before deploying to an environment,
+// the config is validated against the config types for all environment types.
+func computeFileConfig(errs *perr.List, set *configfile.Set, loads []*config.Load, cueMeta *cueutil.Meta) (cfgData []byte, ok bool) {
+	ok = true
+	for _, load := range loads {
+		// Group the problems by environment type to avoid reporting
+		// the same problem in the base file once per environment.
+		type problemEnvs struct {
+			problem  configfile.Problem
+			envTypes []string
+			files    []string
+		}
+		var problems []*problemEnvs
+		byKey := make(map[configfile.Problem]*problemEnvs)
+		for _, envType := range configfile.EnvTypes {
+			for _, p := range configfile.Validate(errs, load.Type, set.Value(envType)) {
+				pe, exists := byKey[p]
+				if !exists {
+					pe = &problemEnvs{problem: p}
+					byKey[p] = pe
+					problems = append(problems, pe)
+				}
+				pe.envTypes = append(pe.envTypes, string(envType))
+				for _, f := range set.Files(envType) {
+					if !slices.Contains(pe.files, f) {
+						pe.files = append(pe.files, f)
+					}
+				}
+			}
+		}
+
+		for _, pe := range problems {
+			ok = false
+			envTypes := strings.Join(pe.envTypes, ", ")
+			files := strings.Join(pe.files, ", ")
+			if files == "" {
+				files = "none"
+			}
+			if pe.problem.Missing {
+				errs.Add(config.ErrMissingConfigFileValue(pe.problem.Path, envTypes, files).
+					AtGoNode(load, errors.AsHelp("config loaded here")))
+			} else {
+				errs.Add(config.ErrInvalidConfigFileValue(pe.problem.Path, envTypes, files, pe.problem.Msg).
+					AtGoNode(load, errors.AsHelp("config loaded here")))
+			}
+		}
+	}
+	if !ok {
+		return nil, false
+	}
+
+	envType := cueutil.EnvType_Development
+	if cueMeta != nil && cueMeta.EnvType != "" {
+		envType = cueMeta.EnvType
+	}
+	cfgData, err := json.Marshal(set.Value(envType))
+	if err != nil {
+		errs.AddStd(err)
+		return nil, false
+	}
+	return cfgData, true
+}
+
+func pickupConfigFiles(errs *perr.List, desc *app.Desc, mainModule *pkginfo.Module) fs.FS {
+	// Config files in YAML or JSON are only picked up from the service root directories.
+	serviceDirs := make(map[string]bool, len(desc.Services))
+	for _, svc := range desc.Services {
+		if rel, err := filepath.Rel(mainModule.RootDir.ToIO(), svc.FSRoot.ToIO()); err == nil {
+			serviceDirs[filepath.ToSlash(rel)] = true
+		}
+	}
+
 	// Create a virtual filesystem for the config files
 	configFiles, err := vfs.FromDir(mainModule.RootDir.ToIO(), func(path string, info fs.DirEntry) bool {
 		// any CUE files
@@ -329,6 +440,11 @@ func pickupConfigFiles(errs *perr.List, mainModule *pkginfo.Module) fs.FS {
 		if strings.Contains(path, "/cue.mod/") || strings.HasPrefix(path, "cue.mod/") {
 			return true
 		}
+
+		// YAML and JSON config files
+		if !info.IsDir() && configfile.IsConfigFile(info.Name()) && serviceDirs[pathpkg.Dir(path)] {
+			return true
+		}
 		return false
 	})
 	if err != nil {
@@ -357,7 +473,18 @@ func (i BuilderImpl) GenUserFacing(ctx context.Context, p builder.GenUserFacingP
 				// Service structs are not needed if there is no implementation to be generated
 				svcStruct := option.None[*codegen.VarDecl]()
 
-				if f, ok := userfacinggen.Gen(gg, svc, svcStruct).Get(); ok {
+				file := userfacinggen.Gen(gg, svc, svcStruct)
+
+				// Typed query functions are generated into the same file.
+				for _, db := range parser.Resources[*sqldb.Database](pd.appDesc.Parse) {
+					if db.Pkg.FSPath == svc.FSRoot {
+						if f, ok := sqldbgen.Gen(gg, db).Get(); ok {
+							file = option.Some(f)
+						}
+					}
+				}
+
+				if f, ok := file.Get(); ok {
 					buf.Reset()
 					if err := f.Render(&buf); err != nil {
 						errs.Addf(token.NoPos, "unable to render userfacing go code: %v", err)
@@ -0,0 +1,136 @@
+package v2builder
+
+import (
+	"encoding/json"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"encr.dev/pkg/cueutil"
+	"encr.dev/v2/app"
+	"encr.dev/v2/internals/testutil"
+	"encr.dev/v2/parser"
+)
+
+func TestComputeConfigs_ConfigFiles(t *testing.T) {
+	const code = `
+-- go.mod --
+module example.com
+
+go 1.20
+
+require encore.dev v1.13.4
+-- svc/svc.go --
+package svc
+
+import (
+	"context"
+
+	"encore.dev/config"
+)
+
+type Config struct {
+	Host    string
+	Port    int      ` + "`json:\"port\"`" + `
+	Debug   bool     ` + "`json:\"debug,omitempty\"`" + `
+	Servers []string
+}
+
+var cfg = config.Load[*Config]()
+
+//encore:api public
+func Foo(ctx context.Context) error { return nil }
+`
+
+	tests := []struct {
+		name       string
+		files      string
+		envType    cueutil.EnvType
+		want       map[string]any
+		wantErrors []string
+	}{
+		{
+			name: "layered",
+			files: `
+-- svc/config.yaml --
+Host: example.com
+port: 8080
+Servers: [a, b]
+-- svc/config.dev.yaml --
+Host: localhost
+debug: true
+`,
+			envType: cueutil.EnvType_Development,
+			want:    map[string]any{"Host": "localhost", "port": 8080.0, "debug": true, "Servers": []any{"a", "b"}},
+		},
+		{
+			name: "prod",
+			files: `
+-- svc/config.json --
+{"Host": "example.com", "port": 8080, "Servers": []}
+-- svc/config.prod.json --
+{"port": 443}
+`,
+			envType: cueutil.EnvType_Production,
+			want:    map[string]any{"Host": "example.com", "port": 443.0, "Servers": []any{}},
+		},
+		{
+			name: "missing in some environments",
+			files: `
+-- svc/config.yaml --
+port: 8080
+Servers: []
+-- svc/config.dev.yaml --
+Host: localhost
+-- svc/config.test.yaml --
+Host: localhost
+`,
+			envType: cueutil.EnvType_Development,
+			wantErrors: []string{
+				`(?s)The config field Host is required by the config type but is not set in the production, ephemeral\s+environment`,
+			},
+		},
+		{
+			name: "invalid value",
+			files: `
+-- svc/config.yaml --
+Host: localhost
+port: "8080"
+Servers: []
+Extra: true
+`,
+			envType: cueutil.EnvType_Development,
+			wantErrors: []string{
+				`(?s)The config value Extra does not match .*: unknown field`,
+				`(?s)The config value port does not match .*: expected an integer, got a\s+string`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := qt.New(t)
+			archive := testutil.ParseTxtar(code + tt.files)
+			tc := testutil.NewContext(c, false, archive)
+			tc.GoModDownload()
+			defer tc.FailTestOnBailout()
+
+			res := parser.NewParser(tc.Context).Parse()
+			desc := app.ValidateAndDescribe(tc.Context, res)
+			c.Assert(tc.Errs.Len(), qt.Equals, 0, qt.Commentf("errors: %s", tc.Errs.FormatErrors()))
+
+			mainModule := res.MainModule()
+			configs := computeConfigs(tc.Errs, desc, mainModule, &cueutil.Meta{EnvType: tt.envType})
+
+			if len(tt.wantErrors) > 0 {
+				tc.DeferExpectError(tt.wantErrors...)
+				return
+			}
+			c.Assert(tc.Errs.Len(), qt.Equals, 0, qt.Commentf("errors: %s", tc.Errs.FormatErrors()))
+
+			var got map[string]any
+			c.Assert(json.Unmarshal([]byte(configs.configs["svc"]), &got), qt.IsNil)
+			c.Assert(got, qt.DeepEquals, tt.want)
+		})
+	}
+}